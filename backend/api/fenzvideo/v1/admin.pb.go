@@ -107,9 +107,11 @@ func (x *AdminUserInfo) GetCreatedAt() string {
 }
 
 type AdminListUsersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque keyset cursor from a previous reply; overrides page when set.
+	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -158,10 +160,19 @@ func (x *AdminListUsersRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *AdminListUsersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type AdminListUsersReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*AdminUserInfo       `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Users []*AdminUserInfo       `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// Omitted when paging by cursor.
+	Total         *int64 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -204,12 +215,19 @@ func (x *AdminListUsersReply) GetUsers() []*AdminUserInfo {
 }
 
 func (x *AdminListUsersReply) GetTotal() int64 {
-	if x != nil {
-		return x.Total
+	if x != nil && x.Total != nil {
+		return *x.Total
 	}
 	return 0
 }
 
+func (x *AdminListUsersReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type AdminDeleteUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -291,18 +309,21 @@ func (*AdminDeleteUserReply) Descriptor() ([]byte, []int) {
 }
 
 type AdminVideoInfo struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Username       string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	UserId         uint64                 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	CategoryName   string                 `protobuf:"bytes,5,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
-	AccessTier     int32                  `protobuf:"varint,6,opt,name=access_tier,json=accessTier,proto3" json:"access_tier,omitempty"`
-	IsPublished    bool                   `protobuf:"varint,7,opt,name=is_published,json=isPublished,proto3" json:"is_published,omitempty"`
-	IsHidden       bool                   `protobuf:"varint,8,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
-	ViewsMember    uint64                 `protobuf:"varint,9,opt,name=views_member,json=viewsMember,proto3" json:"views_member,omitempty"`
-	ViewsNonMember uint64                 `protobuf:"varint,10,opt,name=views_non_member,json=viewsNonMember,proto3" json:"views_non_member,omitempty"`
-	CreatedAt      string                 `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title        string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Username     string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	UserId       uint64                 `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CategoryName string                 `protobuf:"bytes,5,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	AccessTier   int32                  `protobuf:"varint,6,opt,name=access_tier,json=accessTier,proto3" json:"access_tier,omitempty"`
+	// True when visibility is published.
+	IsPublished    bool   `protobuf:"varint,7,opt,name=is_published,json=isPublished,proto3" json:"is_published,omitempty"`
+	IsHidden       bool   `protobuf:"varint,8,opt,name=is_hidden,json=isHidden,proto3" json:"is_hidden,omitempty"`
+	ViewsMember    uint64 `protobuf:"varint,9,opt,name=views_member,json=viewsMember,proto3" json:"views_member,omitempty"`
+	ViewsNonMember uint64 `protobuf:"varint,10,opt,name=views_non_member,json=viewsNonMember,proto3" json:"views_non_member,omitempty"`
+	CreatedAt      string `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Status         string `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	Visibility     string `protobuf:"bytes,13,opt,name=visibility,proto3" json:"visibility,omitempty"`
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -414,10 +435,26 @@ func (x *AdminVideoInfo) GetCreatedAt() string {
 	return ""
 }
 
+func (x *AdminVideoInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminVideoInfo) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
 type AdminListVideosRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Page     int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque keyset cursor from a previous reply; overrides page when set.
+	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -466,10 +503,19 @@ func (x *AdminListVideosRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *AdminListVideosRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
 type AdminListVideosReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Videos        []*AdminVideoInfo      `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Videos []*AdminVideoInfo      `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	// Omitted when paging by cursor.
+	Total         *int64 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -512,12 +558,19 @@ func (x *AdminListVideosReply) GetVideos() []*AdminVideoInfo {
 }
 
 func (x *AdminListVideosReply) GetTotal() int64 {
-	if x != nil {
-		return x.Total
+	if x != nil && x.Total != nil {
+		return *x.Total
 	}
 	return 0
 }
 
+func (x *AdminListVideosReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type AdminDeleteVideoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -598,6 +651,102 @@ func (*AdminDeleteVideoReply) Descriptor() ([]byte, []int) {
 	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{9}
 }
 
+type AdminListDeletedVideosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListDeletedVideosRequest) Reset() {
+	*x = AdminListDeletedVideosRequest{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListDeletedVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListDeletedVideosRequest) ProtoMessage() {}
+
+func (x *AdminListDeletedVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListDeletedVideosRequest.ProtoReflect.Descriptor instead.
+func (*AdminListDeletedVideosRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AdminListDeletedVideosRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminListDeletedVideosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AdminRestoreVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminRestoreVideoRequest) Reset() {
+	*x = AdminRestoreVideoRequest{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminRestoreVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminRestoreVideoRequest) ProtoMessage() {}
+
+func (x *AdminRestoreVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminRestoreVideoRequest.ProtoReflect.Descriptor instead.
+func (*AdminRestoreVideoRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AdminRestoreVideoRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
 type AdminTagInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -609,7 +758,7 @@ type AdminTagInfo struct {
 
 func (x *AdminTagInfo) Reset() {
 	*x = AdminTagInfo{}
-	mi := &file_fenzvideo_v1_admin_proto_msgTypes[10]
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -621,7 +770,7 @@ func (x *AdminTagInfo) String() string {
 func (*AdminTagInfo) ProtoMessage() {}
 
 func (x *AdminTagInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_fenzvideo_v1_admin_proto_msgTypes[10]
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -634,7 +783,7 @@ func (x *AdminTagInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminTagInfo.ProtoReflect.Descriptor instead.
 func (*AdminTagInfo) Descriptor() ([]byte, []int) {
-	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{10}
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AdminTagInfo) GetId() uint64 {
@@ -668,7 +817,7 @@ type AdminCreateTagRequest struct {
 
 func (x *AdminCreateTagRequest) Reset() {
 	*x = AdminCreateTagRequest{}
-	mi := &file_fenzvideo_v1_admin_proto_msgTypes[11]
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -680,7 +829,7 @@ func (x *AdminCreateTagRequest) String() string {
 func (*AdminCreateTagRequest) ProtoMessage() {}
 
 func (x *AdminCreateTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_fenzvideo_v1_admin_proto_msgTypes[11]
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,7 +842,7 @@ func (x *AdminCreateTagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminCreateTagRequest.ProtoReflect.Descriptor instead.
 func (*AdminCreateTagRequest) Descriptor() ([]byte, []int) {
-	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{11}
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AdminCreateTagRequest) GetName() string {
@@ -719,7 +868,7 @@ type AdminCreateTagReply struct {
 
 func (x *AdminCreateTagReply) Reset() {
 	*x = AdminCreateTagReply{}
-	mi := &file_fenzvideo_v1_admin_proto_msgTypes[12]
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -731,7 +880,7 @@ func (x *AdminCreateTagReply) String() string {
 func (*AdminCreateTagReply) ProtoMessage() {}
 
 func (x *AdminCreateTagReply) ProtoReflect() protoreflect.Message {
-	mi := &file_fenzvideo_v1_admin_proto_msgTypes[12]
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -744,7 +893,7 @@ func (x *AdminCreateTagReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminCreateTagReply.ProtoReflect.Descriptor instead.
 func (*AdminCreateTagReply) Descriptor() ([]byte, []int) {
-	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{12}
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AdminCreateTagReply) GetTag() *AdminTagInfo {
@@ -765,7 +914,7 @@ type AdminUpdateTagRequest struct {
 
 func (x *AdminUpdateTagRequest) Reset() {
 	*x = AdminUpdateTagRequest{}
-	mi := &file_fenzvideo_v1_admin_proto_msgTypes[13]
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -777,7 +926,7 @@ func (x *AdminUpdateTagRequest) String() string {
 func (*AdminUpdateTagRequest) ProtoMessage() {}
 
 func (x *AdminUpdateTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_fenzvideo_v1_admin_proto_msgTypes[13]
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,7 +939,7 @@ func (x *AdminUpdateTagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUpdateTagRequest.ProtoReflect.Descriptor instead.
 func (*AdminUpdateTagRequest) Descriptor() ([]byte, []int) {
-	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{13}
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AdminUpdateTagRequest) GetId() uint64 {
@@ -823,7 +972,7 @@ type AdminUpdateTagReply struct {
 
 func (x *AdminUpdateTagReply) Reset() {
 	*x = AdminUpdateTagReply{}
-	mi := &file_fenzvideo_v1_admin_proto_msgTypes[14]
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -835,7 +984,7 @@ func (x *AdminUpdateTagReply) String() string {
 func (*AdminUpdateTagReply) ProtoMessage() {}
 
 func (x *AdminUpdateTagReply) ProtoReflect() protoreflect.Message {
-	mi := &file_fenzvideo_v1_admin_proto_msgTypes[14]
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -848,7 +997,7 @@ func (x *AdminUpdateTagReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUpdateTagReply.ProtoReflect.Descriptor instead.
 func (*AdminUpdateTagReply) Descriptor() ([]byte, []int) {
-	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{14}
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *AdminUpdateTagReply) GetTag() *AdminTagInfo {
@@ -867,7 +1016,7 @@ type AdminDeleteTagRequest struct {
 
 func (x *AdminDeleteTagRequest) Reset() {
 	*x = AdminDeleteTagRequest{}
-	mi := &file_fenzvideo_v1_admin_proto_msgTypes[15]
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -879,7 +1028,7 @@ func (x *AdminDeleteTagRequest) String() string {
 func (*AdminDeleteTagRequest) ProtoMessage() {}
 
 func (x *AdminDeleteTagRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_fenzvideo_v1_admin_proto_msgTypes[15]
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -892,7 +1041,7 @@ func (x *AdminDeleteTagRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminDeleteTagRequest.ProtoReflect.Descriptor instead.
 func (*AdminDeleteTagRequest) Descriptor() ([]byte, []int) {
-	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{15}
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AdminDeleteTagRequest) GetId() uint64 {
@@ -910,7 +1059,7 @@ type AdminDeleteTagReply struct {
 
 func (x *AdminDeleteTagReply) Reset() {
 	*x = AdminDeleteTagReply{}
-	mi := &file_fenzvideo_v1_admin_proto_msgTypes[16]
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -922,7 +1071,7 @@ func (x *AdminDeleteTagReply) String() string {
 func (*AdminDeleteTagReply) ProtoMessage() {}
 
 func (x *AdminDeleteTagReply) ProtoReflect() protoreflect.Message {
-	mi := &file_fenzvideo_v1_admin_proto_msgTypes[16]
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -935,81 +1084,1966 @@ func (x *AdminDeleteTagReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminDeleteTagReply.ProtoReflect.Descriptor instead.
 func (*AdminDeleteTagReply) Descriptor() ([]byte, []int) {
-	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{16}
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{18}
 }
 
-var File_fenzvideo_v1_admin_proto protoreflect.FileDescriptor
+type AdminSearchQueryStat struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Query           string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	Searches        int64                  `protobuf:"varint,2,opt,name=searches,proto3" json:"searches,omitempty"`
+	ZeroResults     int64                  `protobuf:"varint,3,opt,name=zero_results,json=zeroResults,proto3" json:"zero_results,omitempty"`
+	ClickedSearches int64                  `protobuf:"varint,4,opt,name=clicked_searches,json=clickedSearches,proto3" json:"clicked_searches,omitempty"`
+	Ctr             float64                `protobuf:"fixed64,5,opt,name=ctr,proto3" json:"ctr,omitempty"`
+	AvgLatencyMs    float64                `protobuf:"fixed64,6,opt,name=avg_latency_ms,json=avgLatencyMs,proto3" json:"avg_latency_ms,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
 
-const file_fenzvideo_v1_admin_proto_rawDesc = "" +
-	"\n" +
-	"\x18fenzvideo/v1/admin.proto\x12\ffenzvideo.v1\x1a\x1cgoogle/api/annotations.proto\"\xae\x01\n" +
-	"\rAdminUserInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1a\n" +
-	"\busername\x18\x02 \x01(\tR\busername\x12!\n" +
-	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x12\n" +
-	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1b\n" +
-	"\tis_hidden\x18\x05 \x01(\bR\bisHidden\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x06 \x01(\tR\tcreatedAt\"H\n" +
-	"\x15AdminListUsersRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"^\n" +
-	"\x13AdminListUsersReply\x121\n" +
-	"\x05users\x18\x01 \x03(\v2\x1b.fenzvideo.v1.AdminUserInfoR\x05users\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\"(\n" +
-	"\x16AdminDeleteUserRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"\x16\n" +
-	"\x14AdminDeleteUserReply\"\xdd\x02\n" +
-	"\x0eAdminVideoInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
-	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
-	"\busername\x18\x03 \x01(\tR\busername\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\x04R\x06userId\x12#\n" +
-	"\rcategory_name\x18\x05 \x01(\tR\fcategoryName\x12\x1f\n" +
-	"\vaccess_tier\x18\x06 \x01(\x05R\n" +
-	"accessTier\x12!\n" +
-	"\fis_published\x18\a \x01(\bR\visPublished\x12\x1b\n" +
-	"\tis_hidden\x18\b \x01(\bR\bisHidden\x12!\n" +
-	"\fviews_member\x18\t \x01(\x04R\vviewsMember\x12(\n" +
-	"\x10views_non_member\x18\n" +
-	" \x01(\x04R\x0eviewsNonMember\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\v \x01(\tR\tcreatedAt\"I\n" +
-	"\x16AdminListVideosRequest\x12\x12\n" +
-	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"b\n" +
-	"\x14AdminListVideosReply\x124\n" +
-	"\x06videos\x18\x01 \x03(\v2\x1c.fenzvideo.v1.AdminVideoInfoR\x06videos\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total\")\n" +
-	"\x17AdminDeleteVideoRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"\x17\n" +
-	"\x15AdminDeleteVideoReply\"F\n" +
-	"\fAdminTagInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04slug\x18\x03 \x01(\tR\x04slug\"?\n" +
-	"\x15AdminCreateTagRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
-	"\x04slug\x18\x02 \x01(\tR\x04slug\"C\n" +
-	"\x13AdminCreateTagReply\x12,\n" +
-	"\x03tag\x18\x01 \x01(\v2\x1a.fenzvideo.v1.AdminTagInfoR\x03tag\"O\n" +
-	"\x15AdminUpdateTagRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
-	"\x04slug\x18\x03 \x01(\tR\x04slug\"C\n" +
-	"\x13AdminUpdateTagReply\x12,\n" +
-	"\x03tag\x18\x01 \x01(\v2\x1a.fenzvideo.v1.AdminTagInfoR\x03tag\"'\n" +
-	"\x15AdminDeleteTagRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"\x15\n" +
-	"\x13AdminDeleteTagReply2\xf5\x06\n" +
+func (x *AdminSearchQueryStat) Reset() {
+	*x = AdminSearchQueryStat{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSearchQueryStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSearchQueryStat) ProtoMessage() {}
+
+func (x *AdminSearchQueryStat) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSearchQueryStat.ProtoReflect.Descriptor instead.
+func (*AdminSearchQueryStat) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AdminSearchQueryStat) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *AdminSearchQueryStat) GetSearches() int64 {
+	if x != nil {
+		return x.Searches
+	}
+	return 0
+}
+
+func (x *AdminSearchQueryStat) GetZeroResults() int64 {
+	if x != nil {
+		return x.ZeroResults
+	}
+	return 0
+}
+
+func (x *AdminSearchQueryStat) GetClickedSearches() int64 {
+	if x != nil {
+		return x.ClickedSearches
+	}
+	return 0
+}
+
+func (x *AdminSearchQueryStat) GetCtr() float64 {
+	if x != nil {
+		return x.Ctr
+	}
+	return 0
+}
+
+func (x *AdminSearchQueryStat) GetAvgLatencyMs() float64 {
+	if x != nil {
+		return x.AvgLatencyMs
+	}
+	return 0
+}
+
+type AdminTopSearchQueriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminTopSearchQueriesRequest) Reset() {
+	*x = AdminTopSearchQueriesRequest{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminTopSearchQueriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminTopSearchQueriesRequest) ProtoMessage() {}
+
+func (x *AdminTopSearchQueriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminTopSearchQueriesRequest.ProtoReflect.Descriptor instead.
+func (*AdminTopSearchQueriesRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AdminTopSearchQueriesRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *AdminTopSearchQueriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AdminTopSearchQueriesReply struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Queries       []*AdminSearchQueryStat `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminTopSearchQueriesReply) Reset() {
+	*x = AdminTopSearchQueriesReply{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminTopSearchQueriesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminTopSearchQueriesReply) ProtoMessage() {}
+
+func (x *AdminTopSearchQueriesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminTopSearchQueriesReply.ProtoReflect.Descriptor instead.
+func (*AdminTopSearchQueriesReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AdminTopSearchQueriesReply) GetQueries() []*AdminSearchQueryStat {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type AdminZeroResultQueriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminZeroResultQueriesRequest) Reset() {
+	*x = AdminZeroResultQueriesRequest{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminZeroResultQueriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminZeroResultQueriesRequest) ProtoMessage() {}
+
+func (x *AdminZeroResultQueriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminZeroResultQueriesRequest.ProtoReflect.Descriptor instead.
+func (*AdminZeroResultQueriesRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *AdminZeroResultQueriesRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *AdminZeroResultQueriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type AdminZeroResultQueriesReply struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Queries       []*AdminSearchQueryStat `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminZeroResultQueriesReply) Reset() {
+	*x = AdminZeroResultQueriesReply{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminZeroResultQueriesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminZeroResultQueriesReply) ProtoMessage() {}
+
+func (x *AdminZeroResultQueriesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminZeroResultQueriesReply.ProtoReflect.Descriptor instead.
+func (*AdminZeroResultQueriesReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *AdminZeroResultQueriesReply) GetQueries() []*AdminSearchQueryStat {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type AdminSearchCTRRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Days          int32                  `protobuf:"varint,1,opt,name=days,proto3" json:"days,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Query         *string                `protobuf:"bytes,3,opt,name=query,proto3,oneof" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSearchCTRRequest) Reset() {
+	*x = AdminSearchCTRRequest{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSearchCTRRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSearchCTRRequest) ProtoMessage() {}
+
+func (x *AdminSearchCTRRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSearchCTRRequest.ProtoReflect.Descriptor instead.
+func (*AdminSearchCTRRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AdminSearchCTRRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *AdminSearchCTRRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *AdminSearchCTRRequest) GetQuery() string {
+	if x != nil && x.Query != nil {
+		return *x.Query
+	}
+	return ""
+}
+
+type AdminSearchCTRReply struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Queries       []*AdminSearchQueryStat `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSearchCTRReply) Reset() {
+	*x = AdminSearchCTRReply{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSearchCTRReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSearchCTRReply) ProtoMessage() {}
+
+func (x *AdminSearchCTRReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSearchCTRReply.ProtoReflect.Descriptor instead.
+func (*AdminSearchCTRReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AdminSearchCTRReply) GetQueries() []*AdminSearchQueryStat {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
+type AdminSynonymGroupInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Terms         []string               `protobuf:"bytes,2,rep,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSynonymGroupInfo) Reset() {
+	*x = AdminSynonymGroupInfo{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSynonymGroupInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSynonymGroupInfo) ProtoMessage() {}
+
+func (x *AdminSynonymGroupInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSynonymGroupInfo.ProtoReflect.Descriptor instead.
+func (*AdminSynonymGroupInfo) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *AdminSynonymGroupInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminSynonymGroupInfo) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type AdminListSynonymGroupsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListSynonymGroupsRequest) Reset() {
+	*x = AdminListSynonymGroupsRequest{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListSynonymGroupsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListSynonymGroupsRequest) ProtoMessage() {}
+
+func (x *AdminListSynonymGroupsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListSynonymGroupsRequest.ProtoReflect.Descriptor instead.
+func (*AdminListSynonymGroupsRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AdminListSynonymGroupsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminListSynonymGroupsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AdminListSynonymGroupsReply struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Groups        []*AdminSynonymGroupInfo `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Total         int64                    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListSynonymGroupsReply) Reset() {
+	*x = AdminListSynonymGroupsReply{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListSynonymGroupsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListSynonymGroupsReply) ProtoMessage() {}
+
+func (x *AdminListSynonymGroupsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListSynonymGroupsReply.ProtoReflect.Descriptor instead.
+func (*AdminListSynonymGroupsReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AdminListSynonymGroupsReply) GetGroups() []*AdminSynonymGroupInfo {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *AdminListSynonymGroupsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AdminCreateSynonymGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terms         []string               `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminCreateSynonymGroupRequest) Reset() {
+	*x = AdminCreateSynonymGroupRequest{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreateSynonymGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateSynonymGroupRequest) ProtoMessage() {}
+
+func (x *AdminCreateSynonymGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreateSynonymGroupRequest.ProtoReflect.Descriptor instead.
+func (*AdminCreateSynonymGroupRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AdminCreateSynonymGroupRequest) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type AdminCreateSynonymGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *AdminSynonymGroupInfo `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminCreateSynonymGroupReply) Reset() {
+	*x = AdminCreateSynonymGroupReply{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreateSynonymGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateSynonymGroupReply) ProtoMessage() {}
+
+func (x *AdminCreateSynonymGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreateSynonymGroupReply.ProtoReflect.Descriptor instead.
+func (*AdminCreateSynonymGroupReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AdminCreateSynonymGroupReply) GetGroup() *AdminSynonymGroupInfo {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type AdminUpdateSynonymGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Terms         []string               `protobuf:"bytes,2,rep,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUpdateSynonymGroupRequest) Reset() {
+	*x = AdminUpdateSynonymGroupRequest{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateSynonymGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateSynonymGroupRequest) ProtoMessage() {}
+
+func (x *AdminUpdateSynonymGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateSynonymGroupRequest.ProtoReflect.Descriptor instead.
+func (*AdminUpdateSynonymGroupRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *AdminUpdateSynonymGroupRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminUpdateSynonymGroupRequest) GetTerms() []string {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type AdminUpdateSynonymGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Group         *AdminSynonymGroupInfo `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUpdateSynonymGroupReply) Reset() {
+	*x = AdminUpdateSynonymGroupReply{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateSynonymGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateSynonymGroupReply) ProtoMessage() {}
+
+func (x *AdminUpdateSynonymGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateSynonymGroupReply.ProtoReflect.Descriptor instead.
+func (*AdminUpdateSynonymGroupReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AdminUpdateSynonymGroupReply) GetGroup() *AdminSynonymGroupInfo {
+	if x != nil {
+		return x.Group
+	}
+	return nil
+}
+
+type AdminDeleteSynonymGroupRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminDeleteSynonymGroupRequest) Reset() {
+	*x = AdminDeleteSynonymGroupRequest{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDeleteSynonymGroupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDeleteSynonymGroupRequest) ProtoMessage() {}
+
+func (x *AdminDeleteSynonymGroupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDeleteSynonymGroupRequest.ProtoReflect.Descriptor instead.
+func (*AdminDeleteSynonymGroupRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AdminDeleteSynonymGroupRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminDeleteSynonymGroupReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminDeleteSynonymGroupReply) Reset() {
+	*x = AdminDeleteSynonymGroupReply{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDeleteSynonymGroupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDeleteSynonymGroupReply) ProtoMessage() {}
+
+func (x *AdminDeleteSynonymGroupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDeleteSynonymGroupReply.ProtoReflect.Descriptor instead.
+func (*AdminDeleteSynonymGroupReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{34}
+}
+
+type AdminSpellingCorrectionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Misspelling   string                 `protobuf:"bytes,2,opt,name=misspelling,proto3" json:"misspelling,omitempty"`
+	Correction    string                 `protobuf:"bytes,3,opt,name=correction,proto3" json:"correction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminSpellingCorrectionInfo) Reset() {
+	*x = AdminSpellingCorrectionInfo{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminSpellingCorrectionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSpellingCorrectionInfo) ProtoMessage() {}
+
+func (x *AdminSpellingCorrectionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSpellingCorrectionInfo.ProtoReflect.Descriptor instead.
+func (*AdminSpellingCorrectionInfo) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *AdminSpellingCorrectionInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminSpellingCorrectionInfo) GetMisspelling() string {
+	if x != nil {
+		return x.Misspelling
+	}
+	return ""
+}
+
+func (x *AdminSpellingCorrectionInfo) GetCorrection() string {
+	if x != nil {
+		return x.Correction
+	}
+	return ""
+}
+
+type AdminListSpellingCorrectionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListSpellingCorrectionsRequest) Reset() {
+	*x = AdminListSpellingCorrectionsRequest{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListSpellingCorrectionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListSpellingCorrectionsRequest) ProtoMessage() {}
+
+func (x *AdminListSpellingCorrectionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListSpellingCorrectionsRequest.ProtoReflect.Descriptor instead.
+func (*AdminListSpellingCorrectionsRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AdminListSpellingCorrectionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminListSpellingCorrectionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AdminListSpellingCorrectionsReply struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Corrections   []*AdminSpellingCorrectionInfo `protobuf:"bytes,1,rep,name=corrections,proto3" json:"corrections,omitempty"`
+	Total         int64                          `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListSpellingCorrectionsReply) Reset() {
+	*x = AdminListSpellingCorrectionsReply{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListSpellingCorrectionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListSpellingCorrectionsReply) ProtoMessage() {}
+
+func (x *AdminListSpellingCorrectionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListSpellingCorrectionsReply.ProtoReflect.Descriptor instead.
+func (*AdminListSpellingCorrectionsReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AdminListSpellingCorrectionsReply) GetCorrections() []*AdminSpellingCorrectionInfo {
+	if x != nil {
+		return x.Corrections
+	}
+	return nil
+}
+
+func (x *AdminListSpellingCorrectionsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type AdminCreateSpellingCorrectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Misspelling   string                 `protobuf:"bytes,1,opt,name=misspelling,proto3" json:"misspelling,omitempty"`
+	Correction    string                 `protobuf:"bytes,2,opt,name=correction,proto3" json:"correction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminCreateSpellingCorrectionRequest) Reset() {
+	*x = AdminCreateSpellingCorrectionRequest{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreateSpellingCorrectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateSpellingCorrectionRequest) ProtoMessage() {}
+
+func (x *AdminCreateSpellingCorrectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreateSpellingCorrectionRequest.ProtoReflect.Descriptor instead.
+func (*AdminCreateSpellingCorrectionRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AdminCreateSpellingCorrectionRequest) GetMisspelling() string {
+	if x != nil {
+		return x.Misspelling
+	}
+	return ""
+}
+
+func (x *AdminCreateSpellingCorrectionRequest) GetCorrection() string {
+	if x != nil {
+		return x.Correction
+	}
+	return ""
+}
+
+type AdminCreateSpellingCorrectionReply struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Correction    *AdminSpellingCorrectionInfo `protobuf:"bytes,1,opt,name=correction,proto3" json:"correction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminCreateSpellingCorrectionReply) Reset() {
+	*x = AdminCreateSpellingCorrectionReply{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminCreateSpellingCorrectionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminCreateSpellingCorrectionReply) ProtoMessage() {}
+
+func (x *AdminCreateSpellingCorrectionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminCreateSpellingCorrectionReply.ProtoReflect.Descriptor instead.
+func (*AdminCreateSpellingCorrectionReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AdminCreateSpellingCorrectionReply) GetCorrection() *AdminSpellingCorrectionInfo {
+	if x != nil {
+		return x.Correction
+	}
+	return nil
+}
+
+type AdminUpdateSpellingCorrectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Misspelling   string                 `protobuf:"bytes,2,opt,name=misspelling,proto3" json:"misspelling,omitempty"`
+	Correction    string                 `protobuf:"bytes,3,opt,name=correction,proto3" json:"correction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUpdateSpellingCorrectionRequest) Reset() {
+	*x = AdminUpdateSpellingCorrectionRequest{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateSpellingCorrectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateSpellingCorrectionRequest) ProtoMessage() {}
+
+func (x *AdminUpdateSpellingCorrectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateSpellingCorrectionRequest.ProtoReflect.Descriptor instead.
+func (*AdminUpdateSpellingCorrectionRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *AdminUpdateSpellingCorrectionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AdminUpdateSpellingCorrectionRequest) GetMisspelling() string {
+	if x != nil {
+		return x.Misspelling
+	}
+	return ""
+}
+
+func (x *AdminUpdateSpellingCorrectionRequest) GetCorrection() string {
+	if x != nil {
+		return x.Correction
+	}
+	return ""
+}
+
+type AdminUpdateSpellingCorrectionReply struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Correction    *AdminSpellingCorrectionInfo `protobuf:"bytes,1,opt,name=correction,proto3" json:"correction,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminUpdateSpellingCorrectionReply) Reset() {
+	*x = AdminUpdateSpellingCorrectionReply{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminUpdateSpellingCorrectionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminUpdateSpellingCorrectionReply) ProtoMessage() {}
+
+func (x *AdminUpdateSpellingCorrectionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminUpdateSpellingCorrectionReply.ProtoReflect.Descriptor instead.
+func (*AdminUpdateSpellingCorrectionReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AdminUpdateSpellingCorrectionReply) GetCorrection() *AdminSpellingCorrectionInfo {
+	if x != nil {
+		return x.Correction
+	}
+	return nil
+}
+
+type AdminDeleteSpellingCorrectionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminDeleteSpellingCorrectionRequest) Reset() {
+	*x = AdminDeleteSpellingCorrectionRequest{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDeleteSpellingCorrectionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDeleteSpellingCorrectionRequest) ProtoMessage() {}
+
+func (x *AdminDeleteSpellingCorrectionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDeleteSpellingCorrectionRequest.ProtoReflect.Descriptor instead.
+func (*AdminDeleteSpellingCorrectionRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AdminDeleteSpellingCorrectionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AdminDeleteSpellingCorrectionReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminDeleteSpellingCorrectionReply) Reset() {
+	*x = AdminDeleteSpellingCorrectionReply{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDeleteSpellingCorrectionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDeleteSpellingCorrectionReply) ProtoMessage() {}
+
+func (x *AdminDeleteSpellingCorrectionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDeleteSpellingCorrectionReply.ProtoReflect.Descriptor instead.
+func (*AdminDeleteSpellingCorrectionReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{43}
+}
+
+type AdminReconcileStorageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DryRun        bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminReconcileStorageRequest) Reset() {
+	*x = AdminReconcileStorageRequest{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminReconcileStorageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReconcileStorageRequest) ProtoMessage() {}
+
+func (x *AdminReconcileStorageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReconcileStorageRequest.ProtoReflect.Descriptor instead.
+func (*AdminReconcileStorageRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *AdminReconcileStorageRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type AdminStorageObject struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size          int64                  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	LastModified  string                 `protobuf:"bytes,3,opt,name=last_modified,json=lastModified,proto3" json:"last_modified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminStorageObject) Reset() {
+	*x = AdminStorageObject{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminStorageObject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminStorageObject) ProtoMessage() {}
+
+func (x *AdminStorageObject) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminStorageObject.ProtoReflect.Descriptor instead.
+func (*AdminStorageObject) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AdminStorageObject) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AdminStorageObject) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AdminStorageObject) GetLastModified() string {
+	if x != nil {
+		return x.LastModified
+	}
+	return ""
+}
+
+type AdminReconcileStorageReply struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	DryRun       bool                   `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Scanned      int64                  `protobuf:"varint,2,opt,name=scanned,proto3" json:"scanned,omitempty"`
+	ScannedBytes int64                  `protobuf:"varint,3,opt,name=scanned_bytes,json=scannedBytes,proto3" json:"scanned_bytes,omitempty"`
+	// Unreferenced objects past the grace period; deleted unless dry_run.
+	OrphanCount int64 `protobuf:"varint,4,opt,name=orphan_count,json=orphanCount,proto3" json:"orphan_count,omitempty"`
+	OrphanBytes int64 `protobuf:"varint,5,opt,name=orphan_bytes,json=orphanBytes,proto3" json:"orphan_bytes,omitempty"`
+	Deleted     int64 `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	// Unreferenced objects still inside the grace period.
+	Recent int64 `protobuf:"varint,7,opt,name=recent,proto3" json:"recent,omitempty"`
+	// The first 1000 orphans.
+	Orphans       []*AdminStorageObject `protobuf:"bytes,8,rep,name=orphans,proto3" json:"orphans,omitempty"`
+	StartedAt     string                `protobuf:"bytes,9,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	FinishedAt    string                `protobuf:"bytes,10,opt,name=finished_at,json=finishedAt,proto3" json:"finished_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminReconcileStorageReply) Reset() {
+	*x = AdminReconcileStorageReply{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminReconcileStorageReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminReconcileStorageReply) ProtoMessage() {}
+
+func (x *AdminReconcileStorageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminReconcileStorageReply.ProtoReflect.Descriptor instead.
+func (*AdminReconcileStorageReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *AdminReconcileStorageReply) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *AdminReconcileStorageReply) GetScanned() int64 {
+	if x != nil {
+		return x.Scanned
+	}
+	return 0
+}
+
+func (x *AdminReconcileStorageReply) GetScannedBytes() int64 {
+	if x != nil {
+		return x.ScannedBytes
+	}
+	return 0
+}
+
+func (x *AdminReconcileStorageReply) GetOrphanCount() int64 {
+	if x != nil {
+		return x.OrphanCount
+	}
+	return 0
+}
+
+func (x *AdminReconcileStorageReply) GetOrphanBytes() int64 {
+	if x != nil {
+		return x.OrphanBytes
+	}
+	return 0
+}
+
+func (x *AdminReconcileStorageReply) GetDeleted() int64 {
+	if x != nil {
+		return x.Deleted
+	}
+	return 0
+}
+
+func (x *AdminReconcileStorageReply) GetRecent() int64 {
+	if x != nil {
+		return x.Recent
+	}
+	return 0
+}
+
+func (x *AdminReconcileStorageReply) GetOrphans() []*AdminStorageObject {
+	if x != nil {
+		return x.Orphans
+	}
+	return nil
+}
+
+func (x *AdminReconcileStorageReply) GetStartedAt() string {
+	if x != nil {
+		return x.StartedAt
+	}
+	return ""
+}
+
+func (x *AdminReconcileStorageReply) GetFinishedAt() string {
+	if x != nil {
+		return x.FinishedAt
+	}
+	return ""
+}
+
+type AdminListDuplicateContentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListDuplicateContentRequest) Reset() {
+	*x = AdminListDuplicateContentRequest{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListDuplicateContentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListDuplicateContentRequest) ProtoMessage() {}
+
+func (x *AdminListDuplicateContentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListDuplicateContentRequest.ProtoReflect.Descriptor instead.
+func (*AdminListDuplicateContentRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AdminListDuplicateContentRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AdminListDuplicateContentRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type AdminDuplicateUpload struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UploadId   uint64                 `protobuf:"varint,1,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	UserId     uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username   string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	ObjectName string                 `protobuf:"bytes,4,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// pending, attached, deleted or rejected.
+	Status string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	// The upload kept instead of this one, if it was discarded as a copy.
+	DuplicateOf   string   `protobuf:"bytes,6,opt,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty"`
+	VideoIds      []uint64 `protobuf:"varint,7,rep,packed,name=video_ids,json=videoIds,proto3" json:"video_ids,omitempty"`
+	CreatedAt     string   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminDuplicateUpload) Reset() {
+	*x = AdminDuplicateUpload{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDuplicateUpload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDuplicateUpload) ProtoMessage() {}
+
+func (x *AdminDuplicateUpload) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDuplicateUpload.ProtoReflect.Descriptor instead.
+func (*AdminDuplicateUpload) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AdminDuplicateUpload) GetUploadId() uint64 {
+	if x != nil {
+		return x.UploadId
+	}
+	return 0
+}
+
+func (x *AdminDuplicateUpload) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AdminDuplicateUpload) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AdminDuplicateUpload) GetObjectName() string {
+	if x != nil {
+		return x.ObjectName
+	}
+	return ""
+}
+
+func (x *AdminDuplicateUpload) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AdminDuplicateUpload) GetDuplicateOf() string {
+	if x != nil {
+		return x.DuplicateOf
+	}
+	return ""
+}
+
+func (x *AdminDuplicateUpload) GetVideoIds() []uint64 {
+	if x != nil {
+		return x.VideoIds
+	}
+	return nil
+}
+
+func (x *AdminDuplicateUpload) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type AdminDuplicateGroup struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Sha256        string                  `protobuf:"bytes,1,opt,name=sha256,proto3" json:"sha256,omitempty"`
+	Size          int64                   `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Uploads       []*AdminDuplicateUpload `protobuf:"bytes,3,rep,name=uploads,proto3" json:"uploads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminDuplicateGroup) Reset() {
+	*x = AdminDuplicateGroup{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminDuplicateGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminDuplicateGroup) ProtoMessage() {}
+
+func (x *AdminDuplicateGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminDuplicateGroup.ProtoReflect.Descriptor instead.
+func (*AdminDuplicateGroup) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *AdminDuplicateGroup) GetSha256() string {
+	if x != nil {
+		return x.Sha256
+	}
+	return ""
+}
+
+func (x *AdminDuplicateGroup) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *AdminDuplicateGroup) GetUploads() []*AdminDuplicateUpload {
+	if x != nil {
+		return x.Uploads
+	}
+	return nil
+}
+
+type AdminListDuplicateContentReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Groups        []*AdminDuplicateGroup `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminListDuplicateContentReply) Reset() {
+	*x = AdminListDuplicateContentReply{}
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminListDuplicateContentReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminListDuplicateContentReply) ProtoMessage() {}
+
+func (x *AdminListDuplicateContentReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_admin_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminListDuplicateContentReply.ProtoReflect.Descriptor instead.
+func (*AdminListDuplicateContentReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_admin_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *AdminListDuplicateContentReply) GetGroups() []*AdminDuplicateGroup {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *AdminListDuplicateContentReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+var File_fenzvideo_v1_admin_proto protoreflect.FileDescriptor
+
+const file_fenzvideo_v1_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x18fenzvideo/v1/admin.proto\x12\ffenzvideo.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x18fenzvideo/v1/video.proto\"\xae\x01\n" +
+	"\rAdminUserInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\tR\x04role\x12\x1b\n" +
+	"\tis_hidden\x18\x05 \x01(\bR\bisHidden\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\tR\tcreatedAt\"`\n" +
+	"\x15AdminListUsersRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"\x8e\x01\n" +
+	"\x13AdminListUsersReply\x121\n" +
+	"\x05users\x18\x01 \x03(\v2\x1b.fenzvideo.v1.AdminUserInfoR\x05users\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x03H\x00R\x05total\x88\x01\x01\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursorB\b\n" +
+	"\x06_total\"(\n" +
+	"\x16AdminDeleteUserRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\x16\n" +
+	"\x14AdminDeleteUserReply\"\x95\x03\n" +
+	"\x0eAdminVideoInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x04R\x06userId\x12#\n" +
+	"\rcategory_name\x18\x05 \x01(\tR\fcategoryName\x12\x1f\n" +
+	"\vaccess_tier\x18\x06 \x01(\x05R\n" +
+	"accessTier\x12!\n" +
+	"\fis_published\x18\a \x01(\bR\visPublished\x12\x1b\n" +
+	"\tis_hidden\x18\b \x01(\bR\bisHidden\x12!\n" +
+	"\fviews_member\x18\t \x01(\x04R\vviewsMember\x12(\n" +
+	"\x10views_non_member\x18\n" +
+	" \x01(\x04R\x0eviewsNonMember\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\tR\tcreatedAt\x12\x16\n" +
+	"\x06status\x18\f \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\r \x01(\tR\n" +
+	"visibility\"a\n" +
+	"\x16AdminListVideosRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"\x92\x01\n" +
+	"\x14AdminListVideosReply\x124\n" +
+	"\x06videos\x18\x01 \x03(\v2\x1c.fenzvideo.v1.AdminVideoInfoR\x06videos\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x03H\x00R\x05total\x88\x01\x01\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursorB\b\n" +
+	"\x06_total\")\n" +
+	"\x17AdminDeleteVideoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\x17\n" +
+	"\x15AdminDeleteVideoReply\"P\n" +
+	"\x1dAdminListDeletedVideosRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"*\n" +
+	"\x18AdminRestoreVideoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"F\n" +
+	"\fAdminTagInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\"?\n" +
+	"\x15AdminCreateTagRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x02 \x01(\tR\x04slug\"C\n" +
+	"\x13AdminCreateTagReply\x12,\n" +
+	"\x03tag\x18\x01 \x01(\v2\x1a.fenzvideo.v1.AdminTagInfoR\x03tag\"O\n" +
+	"\x15AdminUpdateTagRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04slug\x18\x03 \x01(\tR\x04slug\"C\n" +
+	"\x13AdminUpdateTagReply\x12,\n" +
+	"\x03tag\x18\x01 \x01(\v2\x1a.fenzvideo.v1.AdminTagInfoR\x03tag\"'\n" +
+	"\x15AdminDeleteTagRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\x15\n" +
+	"\x13AdminDeleteTagReply\"\xce\x01\n" +
+	"\x14AdminSearchQueryStat\x12\x14\n" +
+	"\x05query\x18\x01 \x01(\tR\x05query\x12\x1a\n" +
+	"\bsearches\x18\x02 \x01(\x03R\bsearches\x12!\n" +
+	"\fzero_results\x18\x03 \x01(\x03R\vzeroResults\x12)\n" +
+	"\x10clicked_searches\x18\x04 \x01(\x03R\x0fclickedSearches\x12\x10\n" +
+	"\x03ctr\x18\x05 \x01(\x01R\x03ctr\x12$\n" +
+	"\x0eavg_latency_ms\x18\x06 \x01(\x01R\favgLatencyMs\"H\n" +
+	"\x1cAdminTopSearchQueriesRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"Z\n" +
+	"\x1aAdminTopSearchQueriesReply\x12<\n" +
+	"\aqueries\x18\x01 \x03(\v2\".fenzvideo.v1.AdminSearchQueryStatR\aqueries\"I\n" +
+	"\x1dAdminZeroResultQueriesRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\"[\n" +
+	"\x1bAdminZeroResultQueriesReply\x12<\n" +
+	"\aqueries\x18\x01 \x03(\v2\".fenzvideo.v1.AdminSearchQueryStatR\aqueries\"f\n" +
+	"\x15AdminSearchCTRRequest\x12\x12\n" +
+	"\x04days\x18\x01 \x01(\x05R\x04days\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x19\n" +
+	"\x05query\x18\x03 \x01(\tH\x00R\x05query\x88\x01\x01B\b\n" +
+	"\x06_query\"S\n" +
+	"\x13AdminSearchCTRReply\x12<\n" +
+	"\aqueries\x18\x01 \x03(\v2\".fenzvideo.v1.AdminSearchQueryStatR\aqueries\"=\n" +
+	"\x15AdminSynonymGroupInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05terms\x18\x02 \x03(\tR\x05terms\"P\n" +
+	"\x1dAdminListSynonymGroupsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"p\n" +
+	"\x1bAdminListSynonymGroupsReply\x12;\n" +
+	"\x06groups\x18\x01 \x03(\v2#.fenzvideo.v1.AdminSynonymGroupInfoR\x06groups\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"6\n" +
+	"\x1eAdminCreateSynonymGroupRequest\x12\x14\n" +
+	"\x05terms\x18\x01 \x03(\tR\x05terms\"Y\n" +
+	"\x1cAdminCreateSynonymGroupReply\x129\n" +
+	"\x05group\x18\x01 \x01(\v2#.fenzvideo.v1.AdminSynonymGroupInfoR\x05group\"F\n" +
+	"\x1eAdminUpdateSynonymGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x14\n" +
+	"\x05terms\x18\x02 \x03(\tR\x05terms\"Y\n" +
+	"\x1cAdminUpdateSynonymGroupReply\x129\n" +
+	"\x05group\x18\x01 \x01(\v2#.fenzvideo.v1.AdminSynonymGroupInfoR\x05group\"0\n" +
+	"\x1eAdminDeleteSynonymGroupRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"\x1e\n" +
+	"\x1cAdminDeleteSynonymGroupReply\"o\n" +
+	"\x1bAdminSpellingCorrectionInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12 \n" +
+	"\vmisspelling\x18\x02 \x01(\tR\vmisspelling\x12\x1e\n" +
+	"\n" +
+	"correction\x18\x03 \x01(\tR\n" +
+	"correction\"V\n" +
+	"#AdminListSpellingCorrectionsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\x86\x01\n" +
+	"!AdminListSpellingCorrectionsReply\x12K\n" +
+	"\vcorrections\x18\x01 \x03(\v2).fenzvideo.v1.AdminSpellingCorrectionInfoR\vcorrections\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"h\n" +
+	"$AdminCreateSpellingCorrectionRequest\x12 \n" +
+	"\vmisspelling\x18\x01 \x01(\tR\vmisspelling\x12\x1e\n" +
+	"\n" +
+	"correction\x18\x02 \x01(\tR\n" +
+	"correction\"o\n" +
+	"\"AdminCreateSpellingCorrectionReply\x12I\n" +
+	"\n" +
+	"correction\x18\x01 \x01(\v2).fenzvideo.v1.AdminSpellingCorrectionInfoR\n" +
+	"correction\"x\n" +
+	"$AdminUpdateSpellingCorrectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12 \n" +
+	"\vmisspelling\x18\x02 \x01(\tR\vmisspelling\x12\x1e\n" +
+	"\n" +
+	"correction\x18\x03 \x01(\tR\n" +
+	"correction\"o\n" +
+	"\"AdminUpdateSpellingCorrectionReply\x12I\n" +
+	"\n" +
+	"correction\x18\x01 \x01(\v2).fenzvideo.v1.AdminSpellingCorrectionInfoR\n" +
+	"correction\"6\n" +
+	"$AdminDeleteSpellingCorrectionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"$\n" +
+	"\"AdminDeleteSpellingCorrectionReply\"7\n" +
+	"\x1cAdminReconcileStorageRequest\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\"a\n" +
+	"\x12AdminStorageObject\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12#\n" +
+	"\rlast_modified\x18\x03 \x01(\tR\flastModified\"\xe8\x02\n" +
+	"\x1aAdminReconcileStorageReply\x12\x17\n" +
+	"\adry_run\x18\x01 \x01(\bR\x06dryRun\x12\x18\n" +
+	"\ascanned\x18\x02 \x01(\x03R\ascanned\x12#\n" +
+	"\rscanned_bytes\x18\x03 \x01(\x03R\fscannedBytes\x12!\n" +
+	"\forphan_count\x18\x04 \x01(\x03R\vorphanCount\x12!\n" +
+	"\forphan_bytes\x18\x05 \x01(\x03R\vorphanBytes\x12\x18\n" +
+	"\adeleted\x18\x06 \x01(\x03R\adeleted\x12\x16\n" +
+	"\x06recent\x18\a \x01(\x03R\x06recent\x12:\n" +
+	"\aorphans\x18\b \x03(\v2 .fenzvideo.v1.AdminStorageObjectR\aorphans\x12\x1d\n" +
+	"\n" +
+	"started_at\x18\t \x01(\tR\tstartedAt\x12\x1f\n" +
+	"\vfinished_at\x18\n" +
+	" \x01(\tR\n" +
+	"finishedAt\"S\n" +
+	" AdminListDuplicateContentRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\x80\x02\n" +
+	"\x14AdminDuplicateUpload\x12\x1b\n" +
+	"\tupload_id\x18\x01 \x01(\x04R\buploadId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1f\n" +
+	"\vobject_name\x18\x04 \x01(\tR\n" +
+	"objectName\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12!\n" +
+	"\fduplicate_of\x18\x06 \x01(\tR\vduplicateOf\x12\x1b\n" +
+	"\tvideo_ids\x18\a \x03(\x04R\bvideoIds\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\b \x01(\tR\tcreatedAt\"\x7f\n" +
+	"\x13AdminDuplicateGroup\x12\x16\n" +
+	"\x06sha256\x18\x01 \x01(\tR\x06sha256\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x03R\x04size\x12<\n" +
+	"\auploads\x18\x03 \x03(\v2\".fenzvideo.v1.AdminDuplicateUploadR\auploads\"q\n" +
+	"\x1eAdminListDuplicateContentReply\x129\n" +
+	"\x06groups\x18\x01 \x03(\v2!.fenzvideo.v1.AdminDuplicateGroupR\x06groups\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total2\xe0\x19\n" +
 	"\fAdminService\x12u\n" +
 	"\x0eAdminListUsers\x12#.fenzvideo.v1.AdminListUsersRequest\x1a!.fenzvideo.v1.AdminListUsersReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/admin/users\x12}\n" +
 	"\x0fAdminDeleteUser\x12$.fenzvideo.v1.AdminDeleteUserRequest\x1a\".fenzvideo.v1.AdminDeleteUserReply\" \x82\xd3\xe4\x93\x02\x1a*\x18/api/v1/admin/users/{id}\x12y\n" +
 	"\x0fAdminListVideos\x12$.fenzvideo.v1.AdminListVideosRequest\x1a\".fenzvideo.v1.AdminListVideosReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/api/v1/admin/videos\x12\x81\x01\n" +
-	"\x10AdminDeleteVideo\x12%.fenzvideo.v1.AdminDeleteVideoRequest\x1a#.fenzvideo.v1.AdminDeleteVideoReply\"!\x82\xd3\xe4\x93\x02\x1b*\x19/api/v1/admin/videos/{id}\x12w\n" +
+	"\x10AdminDeleteVideo\x12%.fenzvideo.v1.AdminDeleteVideoRequest\x1a#.fenzvideo.v1.AdminDeleteVideoReply\"!\x82\xd3\xe4\x93\x02\x1b*\x19/api/v1/admin/videos/{id}\x12\x8f\x01\n" +
+	"\x16AdminListDeletedVideos\x12+.fenzvideo.v1.AdminListDeletedVideosRequest\x1a$.fenzvideo.v1.ListDeletedVideosReply\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/v1/admin/videos/trash\x12\x83\x01\n" +
+	"\x11AdminRestoreVideo\x12&.fenzvideo.v1.AdminRestoreVideoRequest\x1a\x18.fenzvideo.v1.VideoReply\",\x82\xd3\xe4\x93\x02&:\x01*\"!/api/v1/admin/videos/{id}/restore\x12w\n" +
 	"\x0eAdminCreateTag\x12#.fenzvideo.v1.AdminCreateTagRequest\x1a!.fenzvideo.v1.AdminCreateTagReply\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/api/v1/admin/tags\x12|\n" +
 	"\x0eAdminUpdateTag\x12#.fenzvideo.v1.AdminUpdateTagRequest\x1a!.fenzvideo.v1.AdminUpdateTagReply\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\x1a\x17/api/v1/admin/tags/{id}\x12y\n" +
-	"\x0eAdminDeleteTag\x12#.fenzvideo.v1.AdminDeleteTagRequest\x1a!.fenzvideo.v1.AdminDeleteTagReply\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/admin/tags/{id}B\x1dZ\x1bbackend/api/fenzvideo/v1;v1b\x06proto3"
+	"\x0eAdminDeleteTag\x12#.fenzvideo.v1.AdminDeleteTagRequest\x1a!.fenzvideo.v1.AdminDeleteTagReply\"\x1f\x82\xd3\xe4\x93\x02\x19*\x17/api/v1/admin/tags/{id}\x12\x97\x01\n" +
+	"\x15AdminTopSearchQueries\x12*.fenzvideo.v1.AdminTopSearchQueriesRequest\x1a(.fenzvideo.v1.AdminTopSearchQueriesReply\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/admin/search/top-queries\x12\xa2\x01\n" +
+	"\x16AdminZeroResultQueries\x12+.fenzvideo.v1.AdminZeroResultQueriesRequest\x1a).fenzvideo.v1.AdminZeroResultQueriesReply\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/admin/search/zero-result-queries\x12z\n" +
+	"\x0eAdminSearchCTR\x12#.fenzvideo.v1.AdminSearchCTRRequest\x1a!.fenzvideo.v1.AdminSearchCTRReply\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/api/v1/admin/search/ctr\x12\x97\x01\n" +
+	"\x16AdminListSynonymGroups\x12+.fenzvideo.v1.AdminListSynonymGroupsRequest\x1a).fenzvideo.v1.AdminListSynonymGroupsReply\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/api/v1/admin/search/synonyms\x12\x9d\x01\n" +
+	"\x17AdminCreateSynonymGroup\x12,.fenzvideo.v1.AdminCreateSynonymGroupRequest\x1a*.fenzvideo.v1.AdminCreateSynonymGroupReply\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/v1/admin/search/synonyms\x12\xa2\x01\n" +
+	"\x17AdminUpdateSynonymGroup\x12,.fenzvideo.v1.AdminUpdateSynonymGroupRequest\x1a*.fenzvideo.v1.AdminUpdateSynonymGroupReply\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/api/v1/admin/search/synonyms/{id}\x12\x9f\x01\n" +
+	"\x17AdminDeleteSynonymGroup\x12,.fenzvideo.v1.AdminDeleteSynonymGroupRequest\x1a*.fenzvideo.v1.AdminDeleteSynonymGroupReply\"*\x82\xd3\xe4\x93\x02$*\"/api/v1/admin/search/synonyms/{id}\x12\xac\x01\n" +
+	"\x1cAdminListSpellingCorrections\x121.fenzvideo.v1.AdminListSpellingCorrectionsRequest\x1a/.fenzvideo.v1.AdminListSpellingCorrectionsReply\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/admin/search/corrections\x12\xb2\x01\n" +
+	"\x1dAdminCreateSpellingCorrection\x122.fenzvideo.v1.AdminCreateSpellingCorrectionRequest\x1a0.fenzvideo.v1.AdminCreateSpellingCorrectionReply\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/admin/search/corrections\x12\xb7\x01\n" +
+	"\x1dAdminUpdateSpellingCorrection\x122.fenzvideo.v1.AdminUpdateSpellingCorrectionRequest\x1a0.fenzvideo.v1.AdminUpdateSpellingCorrectionReply\"0\x82\xd3\xe4\x93\x02*:\x01*\x1a%/api/v1/admin/search/corrections/{id}\x12\xb4\x01\n" +
+	"\x1dAdminDeleteSpellingCorrection\x122.fenzvideo.v1.AdminDeleteSpellingCorrectionRequest\x1a0.fenzvideo.v1.AdminDeleteSpellingCorrectionReply\"-\x82\xd3\xe4\x93\x02'*%/api/v1/admin/search/corrections/{id}\x12\x99\x01\n" +
+	"\x15AdminReconcileStorage\x12*.fenzvideo.v1.AdminReconcileStorageRequest\x1a(.fenzvideo.v1.AdminReconcileStorageReply\"*\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/api/v1/admin/storage/reconcile\x12\xa3\x01\n" +
+	"\x19AdminListDuplicateContent\x12..fenzvideo.v1.AdminListDuplicateContentRequest\x1a,.fenzvideo.v1.AdminListDuplicateContentReply\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/admin/uploads/duplicatesB\x1dZ\x1bbackend/api/fenzvideo/v1;v1b\x06proto3"
 
 var (
 	file_fenzvideo_v1_admin_proto_rawDescOnce sync.Once
@@ -1023,50 +3057,128 @@ func file_fenzvideo_v1_admin_proto_rawDescGZIP() []byte {
 	return file_fenzvideo_v1_admin_proto_rawDescData
 }
 
-var file_fenzvideo_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_fenzvideo_v1_admin_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
 var file_fenzvideo_v1_admin_proto_goTypes = []any{
-	(*AdminUserInfo)(nil),           // 0: fenzvideo.v1.AdminUserInfo
-	(*AdminListUsersRequest)(nil),   // 1: fenzvideo.v1.AdminListUsersRequest
-	(*AdminListUsersReply)(nil),     // 2: fenzvideo.v1.AdminListUsersReply
-	(*AdminDeleteUserRequest)(nil),  // 3: fenzvideo.v1.AdminDeleteUserRequest
-	(*AdminDeleteUserReply)(nil),    // 4: fenzvideo.v1.AdminDeleteUserReply
-	(*AdminVideoInfo)(nil),          // 5: fenzvideo.v1.AdminVideoInfo
-	(*AdminListVideosRequest)(nil),  // 6: fenzvideo.v1.AdminListVideosRequest
-	(*AdminListVideosReply)(nil),    // 7: fenzvideo.v1.AdminListVideosReply
-	(*AdminDeleteVideoRequest)(nil), // 8: fenzvideo.v1.AdminDeleteVideoRequest
-	(*AdminDeleteVideoReply)(nil),   // 9: fenzvideo.v1.AdminDeleteVideoReply
-	(*AdminTagInfo)(nil),            // 10: fenzvideo.v1.AdminTagInfo
-	(*AdminCreateTagRequest)(nil),   // 11: fenzvideo.v1.AdminCreateTagRequest
-	(*AdminCreateTagReply)(nil),     // 12: fenzvideo.v1.AdminCreateTagReply
-	(*AdminUpdateTagRequest)(nil),   // 13: fenzvideo.v1.AdminUpdateTagRequest
-	(*AdminUpdateTagReply)(nil),     // 14: fenzvideo.v1.AdminUpdateTagReply
-	(*AdminDeleteTagRequest)(nil),   // 15: fenzvideo.v1.AdminDeleteTagRequest
-	(*AdminDeleteTagReply)(nil),     // 16: fenzvideo.v1.AdminDeleteTagReply
+	(*AdminUserInfo)(nil),                        // 0: fenzvideo.v1.AdminUserInfo
+	(*AdminListUsersRequest)(nil),                // 1: fenzvideo.v1.AdminListUsersRequest
+	(*AdminListUsersReply)(nil),                  // 2: fenzvideo.v1.AdminListUsersReply
+	(*AdminDeleteUserRequest)(nil),               // 3: fenzvideo.v1.AdminDeleteUserRequest
+	(*AdminDeleteUserReply)(nil),                 // 4: fenzvideo.v1.AdminDeleteUserReply
+	(*AdminVideoInfo)(nil),                       // 5: fenzvideo.v1.AdminVideoInfo
+	(*AdminListVideosRequest)(nil),               // 6: fenzvideo.v1.AdminListVideosRequest
+	(*AdminListVideosReply)(nil),                 // 7: fenzvideo.v1.AdminListVideosReply
+	(*AdminDeleteVideoRequest)(nil),              // 8: fenzvideo.v1.AdminDeleteVideoRequest
+	(*AdminDeleteVideoReply)(nil),                // 9: fenzvideo.v1.AdminDeleteVideoReply
+	(*AdminListDeletedVideosRequest)(nil),        // 10: fenzvideo.v1.AdminListDeletedVideosRequest
+	(*AdminRestoreVideoRequest)(nil),             // 11: fenzvideo.v1.AdminRestoreVideoRequest
+	(*AdminTagInfo)(nil),                         // 12: fenzvideo.v1.AdminTagInfo
+	(*AdminCreateTagRequest)(nil),                // 13: fenzvideo.v1.AdminCreateTagRequest
+	(*AdminCreateTagReply)(nil),                  // 14: fenzvideo.v1.AdminCreateTagReply
+	(*AdminUpdateTagRequest)(nil),                // 15: fenzvideo.v1.AdminUpdateTagRequest
+	(*AdminUpdateTagReply)(nil),                  // 16: fenzvideo.v1.AdminUpdateTagReply
+	(*AdminDeleteTagRequest)(nil),                // 17: fenzvideo.v1.AdminDeleteTagRequest
+	(*AdminDeleteTagReply)(nil),                  // 18: fenzvideo.v1.AdminDeleteTagReply
+	(*AdminSearchQueryStat)(nil),                 // 19: fenzvideo.v1.AdminSearchQueryStat
+	(*AdminTopSearchQueriesRequest)(nil),         // 20: fenzvideo.v1.AdminTopSearchQueriesRequest
+	(*AdminTopSearchQueriesReply)(nil),           // 21: fenzvideo.v1.AdminTopSearchQueriesReply
+	(*AdminZeroResultQueriesRequest)(nil),        // 22: fenzvideo.v1.AdminZeroResultQueriesRequest
+	(*AdminZeroResultQueriesReply)(nil),          // 23: fenzvideo.v1.AdminZeroResultQueriesReply
+	(*AdminSearchCTRRequest)(nil),                // 24: fenzvideo.v1.AdminSearchCTRRequest
+	(*AdminSearchCTRReply)(nil),                  // 25: fenzvideo.v1.AdminSearchCTRReply
+	(*AdminSynonymGroupInfo)(nil),                // 26: fenzvideo.v1.AdminSynonymGroupInfo
+	(*AdminListSynonymGroupsRequest)(nil),        // 27: fenzvideo.v1.AdminListSynonymGroupsRequest
+	(*AdminListSynonymGroupsReply)(nil),          // 28: fenzvideo.v1.AdminListSynonymGroupsReply
+	(*AdminCreateSynonymGroupRequest)(nil),       // 29: fenzvideo.v1.AdminCreateSynonymGroupRequest
+	(*AdminCreateSynonymGroupReply)(nil),         // 30: fenzvideo.v1.AdminCreateSynonymGroupReply
+	(*AdminUpdateSynonymGroupRequest)(nil),       // 31: fenzvideo.v1.AdminUpdateSynonymGroupRequest
+	(*AdminUpdateSynonymGroupReply)(nil),         // 32: fenzvideo.v1.AdminUpdateSynonymGroupReply
+	(*AdminDeleteSynonymGroupRequest)(nil),       // 33: fenzvideo.v1.AdminDeleteSynonymGroupRequest
+	(*AdminDeleteSynonymGroupReply)(nil),         // 34: fenzvideo.v1.AdminDeleteSynonymGroupReply
+	(*AdminSpellingCorrectionInfo)(nil),          // 35: fenzvideo.v1.AdminSpellingCorrectionInfo
+	(*AdminListSpellingCorrectionsRequest)(nil),  // 36: fenzvideo.v1.AdminListSpellingCorrectionsRequest
+	(*AdminListSpellingCorrectionsReply)(nil),    // 37: fenzvideo.v1.AdminListSpellingCorrectionsReply
+	(*AdminCreateSpellingCorrectionRequest)(nil), // 38: fenzvideo.v1.AdminCreateSpellingCorrectionRequest
+	(*AdminCreateSpellingCorrectionReply)(nil),   // 39: fenzvideo.v1.AdminCreateSpellingCorrectionReply
+	(*AdminUpdateSpellingCorrectionRequest)(nil), // 40: fenzvideo.v1.AdminUpdateSpellingCorrectionRequest
+	(*AdminUpdateSpellingCorrectionReply)(nil),   // 41: fenzvideo.v1.AdminUpdateSpellingCorrectionReply
+	(*AdminDeleteSpellingCorrectionRequest)(nil), // 42: fenzvideo.v1.AdminDeleteSpellingCorrectionRequest
+	(*AdminDeleteSpellingCorrectionReply)(nil),   // 43: fenzvideo.v1.AdminDeleteSpellingCorrectionReply
+	(*AdminReconcileStorageRequest)(nil),         // 44: fenzvideo.v1.AdminReconcileStorageRequest
+	(*AdminStorageObject)(nil),                   // 45: fenzvideo.v1.AdminStorageObject
+	(*AdminReconcileStorageReply)(nil),           // 46: fenzvideo.v1.AdminReconcileStorageReply
+	(*AdminListDuplicateContentRequest)(nil),     // 47: fenzvideo.v1.AdminListDuplicateContentRequest
+	(*AdminDuplicateUpload)(nil),                 // 48: fenzvideo.v1.AdminDuplicateUpload
+	(*AdminDuplicateGroup)(nil),                  // 49: fenzvideo.v1.AdminDuplicateGroup
+	(*AdminListDuplicateContentReply)(nil),       // 50: fenzvideo.v1.AdminListDuplicateContentReply
+	(*ListDeletedVideosReply)(nil),               // 51: fenzvideo.v1.ListDeletedVideosReply
+	(*VideoReply)(nil),                           // 52: fenzvideo.v1.VideoReply
 }
 var file_fenzvideo_v1_admin_proto_depIdxs = []int32{
 	0,  // 0: fenzvideo.v1.AdminListUsersReply.users:type_name -> fenzvideo.v1.AdminUserInfo
 	5,  // 1: fenzvideo.v1.AdminListVideosReply.videos:type_name -> fenzvideo.v1.AdminVideoInfo
-	10, // 2: fenzvideo.v1.AdminCreateTagReply.tag:type_name -> fenzvideo.v1.AdminTagInfo
-	10, // 3: fenzvideo.v1.AdminUpdateTagReply.tag:type_name -> fenzvideo.v1.AdminTagInfo
-	1,  // 4: fenzvideo.v1.AdminService.AdminListUsers:input_type -> fenzvideo.v1.AdminListUsersRequest
-	3,  // 5: fenzvideo.v1.AdminService.AdminDeleteUser:input_type -> fenzvideo.v1.AdminDeleteUserRequest
-	6,  // 6: fenzvideo.v1.AdminService.AdminListVideos:input_type -> fenzvideo.v1.AdminListVideosRequest
-	8,  // 7: fenzvideo.v1.AdminService.AdminDeleteVideo:input_type -> fenzvideo.v1.AdminDeleteVideoRequest
-	11, // 8: fenzvideo.v1.AdminService.AdminCreateTag:input_type -> fenzvideo.v1.AdminCreateTagRequest
-	13, // 9: fenzvideo.v1.AdminService.AdminUpdateTag:input_type -> fenzvideo.v1.AdminUpdateTagRequest
-	15, // 10: fenzvideo.v1.AdminService.AdminDeleteTag:input_type -> fenzvideo.v1.AdminDeleteTagRequest
-	2,  // 11: fenzvideo.v1.AdminService.AdminListUsers:output_type -> fenzvideo.v1.AdminListUsersReply
-	4,  // 12: fenzvideo.v1.AdminService.AdminDeleteUser:output_type -> fenzvideo.v1.AdminDeleteUserReply
-	7,  // 13: fenzvideo.v1.AdminService.AdminListVideos:output_type -> fenzvideo.v1.AdminListVideosReply
-	9,  // 14: fenzvideo.v1.AdminService.AdminDeleteVideo:output_type -> fenzvideo.v1.AdminDeleteVideoReply
-	12, // 15: fenzvideo.v1.AdminService.AdminCreateTag:output_type -> fenzvideo.v1.AdminCreateTagReply
-	14, // 16: fenzvideo.v1.AdminService.AdminUpdateTag:output_type -> fenzvideo.v1.AdminUpdateTagReply
-	16, // 17: fenzvideo.v1.AdminService.AdminDeleteTag:output_type -> fenzvideo.v1.AdminDeleteTagReply
-	11, // [11:18] is the sub-list for method output_type
-	4,  // [4:11] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	12, // 2: fenzvideo.v1.AdminCreateTagReply.tag:type_name -> fenzvideo.v1.AdminTagInfo
+	12, // 3: fenzvideo.v1.AdminUpdateTagReply.tag:type_name -> fenzvideo.v1.AdminTagInfo
+	19, // 4: fenzvideo.v1.AdminTopSearchQueriesReply.queries:type_name -> fenzvideo.v1.AdminSearchQueryStat
+	19, // 5: fenzvideo.v1.AdminZeroResultQueriesReply.queries:type_name -> fenzvideo.v1.AdminSearchQueryStat
+	19, // 6: fenzvideo.v1.AdminSearchCTRReply.queries:type_name -> fenzvideo.v1.AdminSearchQueryStat
+	26, // 7: fenzvideo.v1.AdminListSynonymGroupsReply.groups:type_name -> fenzvideo.v1.AdminSynonymGroupInfo
+	26, // 8: fenzvideo.v1.AdminCreateSynonymGroupReply.group:type_name -> fenzvideo.v1.AdminSynonymGroupInfo
+	26, // 9: fenzvideo.v1.AdminUpdateSynonymGroupReply.group:type_name -> fenzvideo.v1.AdminSynonymGroupInfo
+	35, // 10: fenzvideo.v1.AdminListSpellingCorrectionsReply.corrections:type_name -> fenzvideo.v1.AdminSpellingCorrectionInfo
+	35, // 11: fenzvideo.v1.AdminCreateSpellingCorrectionReply.correction:type_name -> fenzvideo.v1.AdminSpellingCorrectionInfo
+	35, // 12: fenzvideo.v1.AdminUpdateSpellingCorrectionReply.correction:type_name -> fenzvideo.v1.AdminSpellingCorrectionInfo
+	45, // 13: fenzvideo.v1.AdminReconcileStorageReply.orphans:type_name -> fenzvideo.v1.AdminStorageObject
+	48, // 14: fenzvideo.v1.AdminDuplicateGroup.uploads:type_name -> fenzvideo.v1.AdminDuplicateUpload
+	49, // 15: fenzvideo.v1.AdminListDuplicateContentReply.groups:type_name -> fenzvideo.v1.AdminDuplicateGroup
+	1,  // 16: fenzvideo.v1.AdminService.AdminListUsers:input_type -> fenzvideo.v1.AdminListUsersRequest
+	3,  // 17: fenzvideo.v1.AdminService.AdminDeleteUser:input_type -> fenzvideo.v1.AdminDeleteUserRequest
+	6,  // 18: fenzvideo.v1.AdminService.AdminListVideos:input_type -> fenzvideo.v1.AdminListVideosRequest
+	8,  // 19: fenzvideo.v1.AdminService.AdminDeleteVideo:input_type -> fenzvideo.v1.AdminDeleteVideoRequest
+	10, // 20: fenzvideo.v1.AdminService.AdminListDeletedVideos:input_type -> fenzvideo.v1.AdminListDeletedVideosRequest
+	11, // 21: fenzvideo.v1.AdminService.AdminRestoreVideo:input_type -> fenzvideo.v1.AdminRestoreVideoRequest
+	13, // 22: fenzvideo.v1.AdminService.AdminCreateTag:input_type -> fenzvideo.v1.AdminCreateTagRequest
+	15, // 23: fenzvideo.v1.AdminService.AdminUpdateTag:input_type -> fenzvideo.v1.AdminUpdateTagRequest
+	17, // 24: fenzvideo.v1.AdminService.AdminDeleteTag:input_type -> fenzvideo.v1.AdminDeleteTagRequest
+	20, // 25: fenzvideo.v1.AdminService.AdminTopSearchQueries:input_type -> fenzvideo.v1.AdminTopSearchQueriesRequest
+	22, // 26: fenzvideo.v1.AdminService.AdminZeroResultQueries:input_type -> fenzvideo.v1.AdminZeroResultQueriesRequest
+	24, // 27: fenzvideo.v1.AdminService.AdminSearchCTR:input_type -> fenzvideo.v1.AdminSearchCTRRequest
+	27, // 28: fenzvideo.v1.AdminService.AdminListSynonymGroups:input_type -> fenzvideo.v1.AdminListSynonymGroupsRequest
+	29, // 29: fenzvideo.v1.AdminService.AdminCreateSynonymGroup:input_type -> fenzvideo.v1.AdminCreateSynonymGroupRequest
+	31, // 30: fenzvideo.v1.AdminService.AdminUpdateSynonymGroup:input_type -> fenzvideo.v1.AdminUpdateSynonymGroupRequest
+	33, // 31: fenzvideo.v1.AdminService.AdminDeleteSynonymGroup:input_type -> fenzvideo.v1.AdminDeleteSynonymGroupRequest
+	36, // 32: fenzvideo.v1.AdminService.AdminListSpellingCorrections:input_type -> fenzvideo.v1.AdminListSpellingCorrectionsRequest
+	38, // 33: fenzvideo.v1.AdminService.AdminCreateSpellingCorrection:input_type -> fenzvideo.v1.AdminCreateSpellingCorrectionRequest
+	40, // 34: fenzvideo.v1.AdminService.AdminUpdateSpellingCorrection:input_type -> fenzvideo.v1.AdminUpdateSpellingCorrectionRequest
+	42, // 35: fenzvideo.v1.AdminService.AdminDeleteSpellingCorrection:input_type -> fenzvideo.v1.AdminDeleteSpellingCorrectionRequest
+	44, // 36: fenzvideo.v1.AdminService.AdminReconcileStorage:input_type -> fenzvideo.v1.AdminReconcileStorageRequest
+	47, // 37: fenzvideo.v1.AdminService.AdminListDuplicateContent:input_type -> fenzvideo.v1.AdminListDuplicateContentRequest
+	2,  // 38: fenzvideo.v1.AdminService.AdminListUsers:output_type -> fenzvideo.v1.AdminListUsersReply
+	4,  // 39: fenzvideo.v1.AdminService.AdminDeleteUser:output_type -> fenzvideo.v1.AdminDeleteUserReply
+	7,  // 40: fenzvideo.v1.AdminService.AdminListVideos:output_type -> fenzvideo.v1.AdminListVideosReply
+	9,  // 41: fenzvideo.v1.AdminService.AdminDeleteVideo:output_type -> fenzvideo.v1.AdminDeleteVideoReply
+	51, // 42: fenzvideo.v1.AdminService.AdminListDeletedVideos:output_type -> fenzvideo.v1.ListDeletedVideosReply
+	52, // 43: fenzvideo.v1.AdminService.AdminRestoreVideo:output_type -> fenzvideo.v1.VideoReply
+	14, // 44: fenzvideo.v1.AdminService.AdminCreateTag:output_type -> fenzvideo.v1.AdminCreateTagReply
+	16, // 45: fenzvideo.v1.AdminService.AdminUpdateTag:output_type -> fenzvideo.v1.AdminUpdateTagReply
+	18, // 46: fenzvideo.v1.AdminService.AdminDeleteTag:output_type -> fenzvideo.v1.AdminDeleteTagReply
+	21, // 47: fenzvideo.v1.AdminService.AdminTopSearchQueries:output_type -> fenzvideo.v1.AdminTopSearchQueriesReply
+	23, // 48: fenzvideo.v1.AdminService.AdminZeroResultQueries:output_type -> fenzvideo.v1.AdminZeroResultQueriesReply
+	25, // 49: fenzvideo.v1.AdminService.AdminSearchCTR:output_type -> fenzvideo.v1.AdminSearchCTRReply
+	28, // 50: fenzvideo.v1.AdminService.AdminListSynonymGroups:output_type -> fenzvideo.v1.AdminListSynonymGroupsReply
+	30, // 51: fenzvideo.v1.AdminService.AdminCreateSynonymGroup:output_type -> fenzvideo.v1.AdminCreateSynonymGroupReply
+	32, // 52: fenzvideo.v1.AdminService.AdminUpdateSynonymGroup:output_type -> fenzvideo.v1.AdminUpdateSynonymGroupReply
+	34, // 53: fenzvideo.v1.AdminService.AdminDeleteSynonymGroup:output_type -> fenzvideo.v1.AdminDeleteSynonymGroupReply
+	37, // 54: fenzvideo.v1.AdminService.AdminListSpellingCorrections:output_type -> fenzvideo.v1.AdminListSpellingCorrectionsReply
+	39, // 55: fenzvideo.v1.AdminService.AdminCreateSpellingCorrection:output_type -> fenzvideo.v1.AdminCreateSpellingCorrectionReply
+	41, // 56: fenzvideo.v1.AdminService.AdminUpdateSpellingCorrection:output_type -> fenzvideo.v1.AdminUpdateSpellingCorrectionReply
+	43, // 57: fenzvideo.v1.AdminService.AdminDeleteSpellingCorrection:output_type -> fenzvideo.v1.AdminDeleteSpellingCorrectionReply
+	46, // 58: fenzvideo.v1.AdminService.AdminReconcileStorage:output_type -> fenzvideo.v1.AdminReconcileStorageReply
+	50, // 59: fenzvideo.v1.AdminService.AdminListDuplicateContent:output_type -> fenzvideo.v1.AdminListDuplicateContentReply
+	38, // [38:60] is the sub-list for method output_type
+	16, // [16:38] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_fenzvideo_v1_admin_proto_init() }
@@ -1074,13 +3186,17 @@ func file_fenzvideo_v1_admin_proto_init() {
 	if File_fenzvideo_v1_admin_proto != nil {
 		return
 	}
+	file_fenzvideo_v1_video_proto_init()
+	file_fenzvideo_v1_admin_proto_msgTypes[2].OneofWrappers = []any{}
+	file_fenzvideo_v1_admin_proto_msgTypes[7].OneofWrappers = []any{}
+	file_fenzvideo_v1_admin_proto_msgTypes[24].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fenzvideo_v1_admin_proto_rawDesc), len(file_fenzvideo_v1_admin_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,13 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	AdminService_AdminListUsers_FullMethodName   = "/fenzvideo.v1.AdminService/AdminListUsers"
-	AdminService_AdminDeleteUser_FullMethodName  = "/fenzvideo.v1.AdminService/AdminDeleteUser"
-	AdminService_AdminListVideos_FullMethodName  = "/fenzvideo.v1.AdminService/AdminListVideos"
-	AdminService_AdminDeleteVideo_FullMethodName = "/fenzvideo.v1.AdminService/AdminDeleteVideo"
-	AdminService_AdminCreateTag_FullMethodName   = "/fenzvideo.v1.AdminService/AdminCreateTag"
-	AdminService_AdminUpdateTag_FullMethodName   = "/fenzvideo.v1.AdminService/AdminUpdateTag"
-	AdminService_AdminDeleteTag_FullMethodName   = "/fenzvideo.v1.AdminService/AdminDeleteTag"
+	AdminService_AdminListUsers_FullMethodName                = "/fenzvideo.v1.AdminService/AdminListUsers"
+	AdminService_AdminDeleteUser_FullMethodName               = "/fenzvideo.v1.AdminService/AdminDeleteUser"
+	AdminService_AdminListVideos_FullMethodName               = "/fenzvideo.v1.AdminService/AdminListVideos"
+	AdminService_AdminDeleteVideo_FullMethodName              = "/fenzvideo.v1.AdminService/AdminDeleteVideo"
+	AdminService_AdminListDeletedVideos_FullMethodName        = "/fenzvideo.v1.AdminService/AdminListDeletedVideos"
+	AdminService_AdminRestoreVideo_FullMethodName             = "/fenzvideo.v1.AdminService/AdminRestoreVideo"
+	AdminService_AdminCreateTag_FullMethodName                = "/fenzvideo.v1.AdminService/AdminCreateTag"
+	AdminService_AdminUpdateTag_FullMethodName                = "/fenzvideo.v1.AdminService/AdminUpdateTag"
+	AdminService_AdminDeleteTag_FullMethodName                = "/fenzvideo.v1.AdminService/AdminDeleteTag"
+	AdminService_AdminTopSearchQueries_FullMethodName         = "/fenzvideo.v1.AdminService/AdminTopSearchQueries"
+	AdminService_AdminZeroResultQueries_FullMethodName        = "/fenzvideo.v1.AdminService/AdminZeroResultQueries"
+	AdminService_AdminSearchCTR_FullMethodName                = "/fenzvideo.v1.AdminService/AdminSearchCTR"
+	AdminService_AdminListSynonymGroups_FullMethodName        = "/fenzvideo.v1.AdminService/AdminListSynonymGroups"
+	AdminService_AdminCreateSynonymGroup_FullMethodName       = "/fenzvideo.v1.AdminService/AdminCreateSynonymGroup"
+	AdminService_AdminUpdateSynonymGroup_FullMethodName       = "/fenzvideo.v1.AdminService/AdminUpdateSynonymGroup"
+	AdminService_AdminDeleteSynonymGroup_FullMethodName       = "/fenzvideo.v1.AdminService/AdminDeleteSynonymGroup"
+	AdminService_AdminListSpellingCorrections_FullMethodName  = "/fenzvideo.v1.AdminService/AdminListSpellingCorrections"
+	AdminService_AdminCreateSpellingCorrection_FullMethodName = "/fenzvideo.v1.AdminService/AdminCreateSpellingCorrection"
+	AdminService_AdminUpdateSpellingCorrection_FullMethodName = "/fenzvideo.v1.AdminService/AdminUpdateSpellingCorrection"
+	AdminService_AdminDeleteSpellingCorrection_FullMethodName = "/fenzvideo.v1.AdminService/AdminDeleteSpellingCorrection"
+	AdminService_AdminReconcileStorage_FullMethodName         = "/fenzvideo.v1.AdminService/AdminReconcileStorage"
+	AdminService_AdminListDuplicateContent_FullMethodName     = "/fenzvideo.v1.AdminService/AdminListDuplicateContent"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -35,10 +50,31 @@ type AdminServiceClient interface {
 	AdminListUsers(ctx context.Context, in *AdminListUsersRequest, opts ...grpc.CallOption) (*AdminListUsersReply, error)
 	AdminDeleteUser(ctx context.Context, in *AdminDeleteUserRequest, opts ...grpc.CallOption) (*AdminDeleteUserReply, error)
 	AdminListVideos(ctx context.Context, in *AdminListVideosRequest, opts ...grpc.CallOption) (*AdminListVideosReply, error)
+	// Deletes a video for good, skipping the trash.
 	AdminDeleteVideo(ctx context.Context, in *AdminDeleteVideoRequest, opts ...grpc.CallOption) (*AdminDeleteVideoReply, error)
+	// All users' deleted videos, most recently deleted first.
+	AdminListDeletedVideos(ctx context.Context, in *AdminListDeletedVideosRequest, opts ...grpc.CallOption) (*ListDeletedVideosReply, error)
+	AdminRestoreVideo(ctx context.Context, in *AdminRestoreVideoRequest, opts ...grpc.CallOption) (*VideoReply, error)
 	AdminCreateTag(ctx context.Context, in *AdminCreateTagRequest, opts ...grpc.CallOption) (*AdminCreateTagReply, error)
 	AdminUpdateTag(ctx context.Context, in *AdminUpdateTagRequest, opts ...grpc.CallOption) (*AdminUpdateTagReply, error)
 	AdminDeleteTag(ctx context.Context, in *AdminDeleteTagRequest, opts ...grpc.CallOption) (*AdminDeleteTagReply, error)
+	AdminTopSearchQueries(ctx context.Context, in *AdminTopSearchQueriesRequest, opts ...grpc.CallOption) (*AdminTopSearchQueriesReply, error)
+	AdminZeroResultQueries(ctx context.Context, in *AdminZeroResultQueriesRequest, opts ...grpc.CallOption) (*AdminZeroResultQueriesReply, error)
+	AdminSearchCTR(ctx context.Context, in *AdminSearchCTRRequest, opts ...grpc.CallOption) (*AdminSearchCTRReply, error)
+	AdminListSynonymGroups(ctx context.Context, in *AdminListSynonymGroupsRequest, opts ...grpc.CallOption) (*AdminListSynonymGroupsReply, error)
+	AdminCreateSynonymGroup(ctx context.Context, in *AdminCreateSynonymGroupRequest, opts ...grpc.CallOption) (*AdminCreateSynonymGroupReply, error)
+	AdminUpdateSynonymGroup(ctx context.Context, in *AdminUpdateSynonymGroupRequest, opts ...grpc.CallOption) (*AdminUpdateSynonymGroupReply, error)
+	AdminDeleteSynonymGroup(ctx context.Context, in *AdminDeleteSynonymGroupRequest, opts ...grpc.CallOption) (*AdminDeleteSynonymGroupReply, error)
+	AdminListSpellingCorrections(ctx context.Context, in *AdminListSpellingCorrectionsRequest, opts ...grpc.CallOption) (*AdminListSpellingCorrectionsReply, error)
+	AdminCreateSpellingCorrection(ctx context.Context, in *AdminCreateSpellingCorrectionRequest, opts ...grpc.CallOption) (*AdminCreateSpellingCorrectionReply, error)
+	AdminUpdateSpellingCorrection(ctx context.Context, in *AdminUpdateSpellingCorrectionRequest, opts ...grpc.CallOption) (*AdminUpdateSpellingCorrectionReply, error)
+	AdminDeleteSpellingCorrection(ctx context.Context, in *AdminDeleteSpellingCorrectionRequest, opts ...grpc.CallOption) (*AdminDeleteSpellingCorrectionReply, error)
+	// Reconciles the bucket against the database now, deleting objects no
+	// video or user references once past the grace period. With dry_run it
+	// only reports them.
+	AdminReconcileStorage(ctx context.Context, in *AdminReconcileStorageRequest, opts ...grpc.CallOption) (*AdminReconcileStorageReply, error)
+	// Lists content uploaded by more than one account, found by SHA-256.
+	AdminListDuplicateContent(ctx context.Context, in *AdminListDuplicateContentRequest, opts ...grpc.CallOption) (*AdminListDuplicateContentReply, error)
 }
 
 type adminServiceClient struct {
@@ -89,6 +125,26 @@ func (c *adminServiceClient) AdminDeleteVideo(ctx context.Context, in *AdminDele
 	return out, nil
 }
 
+func (c *adminServiceClient) AdminListDeletedVideos(ctx context.Context, in *AdminListDeletedVideosRequest, opts ...grpc.CallOption) (*ListDeletedVideosReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedVideosReply)
+	err := c.cc.Invoke(ctx, AdminService_AdminListDeletedVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdminRestoreVideo(ctx context.Context, in *AdminRestoreVideoRequest, opts ...grpc.CallOption) (*VideoReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VideoReply)
+	err := c.cc.Invoke(ctx, AdminService_AdminRestoreVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *adminServiceClient) AdminCreateTag(ctx context.Context, in *AdminCreateTagRequest, opts ...grpc.CallOption) (*AdminCreateTagReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AdminCreateTagReply)
@@ -119,6 +175,136 @@ func (c *adminServiceClient) AdminDeleteTag(ctx context.Context, in *AdminDelete
 	return out, nil
 }
 
+func (c *adminServiceClient) AdminTopSearchQueries(ctx context.Context, in *AdminTopSearchQueriesRequest, opts ...grpc.CallOption) (*AdminTopSearchQueriesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminTopSearchQueriesReply)
+	err := c.cc.Invoke(ctx, AdminService_AdminTopSearchQueries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdminZeroResultQueries(ctx context.Context, in *AdminZeroResultQueriesRequest, opts ...grpc.CallOption) (*AdminZeroResultQueriesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminZeroResultQueriesReply)
+	err := c.cc.Invoke(ctx, AdminService_AdminZeroResultQueries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdminSearchCTR(ctx context.Context, in *AdminSearchCTRRequest, opts ...grpc.CallOption) (*AdminSearchCTRReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminSearchCTRReply)
+	err := c.cc.Invoke(ctx, AdminService_AdminSearchCTR_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdminListSynonymGroups(ctx context.Context, in *AdminListSynonymGroupsRequest, opts ...grpc.CallOption) (*AdminListSynonymGroupsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListSynonymGroupsReply)
+	err := c.cc.Invoke(ctx, AdminService_AdminListSynonymGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdminCreateSynonymGroup(ctx context.Context, in *AdminCreateSynonymGroupRequest, opts ...grpc.CallOption) (*AdminCreateSynonymGroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminCreateSynonymGroupReply)
+	err := c.cc.Invoke(ctx, AdminService_AdminCreateSynonymGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdminUpdateSynonymGroup(ctx context.Context, in *AdminUpdateSynonymGroupRequest, opts ...grpc.CallOption) (*AdminUpdateSynonymGroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUpdateSynonymGroupReply)
+	err := c.cc.Invoke(ctx, AdminService_AdminUpdateSynonymGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdminDeleteSynonymGroup(ctx context.Context, in *AdminDeleteSynonymGroupRequest, opts ...grpc.CallOption) (*AdminDeleteSynonymGroupReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminDeleteSynonymGroupReply)
+	err := c.cc.Invoke(ctx, AdminService_AdminDeleteSynonymGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdminListSpellingCorrections(ctx context.Context, in *AdminListSpellingCorrectionsRequest, opts ...grpc.CallOption) (*AdminListSpellingCorrectionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListSpellingCorrectionsReply)
+	err := c.cc.Invoke(ctx, AdminService_AdminListSpellingCorrections_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdminCreateSpellingCorrection(ctx context.Context, in *AdminCreateSpellingCorrectionRequest, opts ...grpc.CallOption) (*AdminCreateSpellingCorrectionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminCreateSpellingCorrectionReply)
+	err := c.cc.Invoke(ctx, AdminService_AdminCreateSpellingCorrection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdminUpdateSpellingCorrection(ctx context.Context, in *AdminUpdateSpellingCorrectionRequest, opts ...grpc.CallOption) (*AdminUpdateSpellingCorrectionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminUpdateSpellingCorrectionReply)
+	err := c.cc.Invoke(ctx, AdminService_AdminUpdateSpellingCorrection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdminDeleteSpellingCorrection(ctx context.Context, in *AdminDeleteSpellingCorrectionRequest, opts ...grpc.CallOption) (*AdminDeleteSpellingCorrectionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminDeleteSpellingCorrectionReply)
+	err := c.cc.Invoke(ctx, AdminService_AdminDeleteSpellingCorrection_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdminReconcileStorage(ctx context.Context, in *AdminReconcileStorageRequest, opts ...grpc.CallOption) (*AdminReconcileStorageReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminReconcileStorageReply)
+	err := c.cc.Invoke(ctx, AdminService_AdminReconcileStorage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) AdminListDuplicateContent(ctx context.Context, in *AdminListDuplicateContentRequest, opts ...grpc.CallOption) (*AdminListDuplicateContentReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminListDuplicateContentReply)
+	err := c.cc.Invoke(ctx, AdminService_AdminListDuplicateContent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility.
@@ -126,10 +312,31 @@ type AdminServiceServer interface {
 	AdminListUsers(context.Context, *AdminListUsersRequest) (*AdminListUsersReply, error)
 	AdminDeleteUser(context.Context, *AdminDeleteUserRequest) (*AdminDeleteUserReply, error)
 	AdminListVideos(context.Context, *AdminListVideosRequest) (*AdminListVideosReply, error)
+	// Deletes a video for good, skipping the trash.
 	AdminDeleteVideo(context.Context, *AdminDeleteVideoRequest) (*AdminDeleteVideoReply, error)
+	// All users' deleted videos, most recently deleted first.
+	AdminListDeletedVideos(context.Context, *AdminListDeletedVideosRequest) (*ListDeletedVideosReply, error)
+	AdminRestoreVideo(context.Context, *AdminRestoreVideoRequest) (*VideoReply, error)
 	AdminCreateTag(context.Context, *AdminCreateTagRequest) (*AdminCreateTagReply, error)
 	AdminUpdateTag(context.Context, *AdminUpdateTagRequest) (*AdminUpdateTagReply, error)
 	AdminDeleteTag(context.Context, *AdminDeleteTagRequest) (*AdminDeleteTagReply, error)
+	AdminTopSearchQueries(context.Context, *AdminTopSearchQueriesRequest) (*AdminTopSearchQueriesReply, error)
+	AdminZeroResultQueries(context.Context, *AdminZeroResultQueriesRequest) (*AdminZeroResultQueriesReply, error)
+	AdminSearchCTR(context.Context, *AdminSearchCTRRequest) (*AdminSearchCTRReply, error)
+	AdminListSynonymGroups(context.Context, *AdminListSynonymGroupsRequest) (*AdminListSynonymGroupsReply, error)
+	AdminCreateSynonymGroup(context.Context, *AdminCreateSynonymGroupRequest) (*AdminCreateSynonymGroupReply, error)
+	AdminUpdateSynonymGroup(context.Context, *AdminUpdateSynonymGroupRequest) (*AdminUpdateSynonymGroupReply, error)
+	AdminDeleteSynonymGroup(context.Context, *AdminDeleteSynonymGroupRequest) (*AdminDeleteSynonymGroupReply, error)
+	AdminListSpellingCorrections(context.Context, *AdminListSpellingCorrectionsRequest) (*AdminListSpellingCorrectionsReply, error)
+	AdminCreateSpellingCorrection(context.Context, *AdminCreateSpellingCorrectionRequest) (*AdminCreateSpellingCorrectionReply, error)
+	AdminUpdateSpellingCorrection(context.Context, *AdminUpdateSpellingCorrectionRequest) (*AdminUpdateSpellingCorrectionReply, error)
+	AdminDeleteSpellingCorrection(context.Context, *AdminDeleteSpellingCorrectionRequest) (*AdminDeleteSpellingCorrectionReply, error)
+	// Reconciles the bucket against the database now, deleting objects no
+	// video or user references once past the grace period. With dry_run it
+	// only reports them.
+	AdminReconcileStorage(context.Context, *AdminReconcileStorageRequest) (*AdminReconcileStorageReply, error)
+	// Lists content uploaded by more than one account, found by SHA-256.
+	AdminListDuplicateContent(context.Context, *AdminListDuplicateContentRequest) (*AdminListDuplicateContentReply, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -152,6 +359,12 @@ func (UnimplementedAdminServiceServer) AdminListVideos(context.Context, *AdminLi
 func (UnimplementedAdminServiceServer) AdminDeleteVideo(context.Context, *AdminDeleteVideoRequest) (*AdminDeleteVideoReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method AdminDeleteVideo not implemented")
 }
+func (UnimplementedAdminServiceServer) AdminListDeletedVideos(context.Context, *AdminListDeletedVideosRequest) (*ListDeletedVideosReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListDeletedVideos not implemented")
+}
+func (UnimplementedAdminServiceServer) AdminRestoreVideo(context.Context, *AdminRestoreVideoRequest) (*VideoReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminRestoreVideo not implemented")
+}
 func (UnimplementedAdminServiceServer) AdminCreateTag(context.Context, *AdminCreateTagRequest) (*AdminCreateTagReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method AdminCreateTag not implemented")
 }
@@ -161,6 +374,45 @@ func (UnimplementedAdminServiceServer) AdminUpdateTag(context.Context, *AdminUpd
 func (UnimplementedAdminServiceServer) AdminDeleteTag(context.Context, *AdminDeleteTagRequest) (*AdminDeleteTagReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method AdminDeleteTag not implemented")
 }
+func (UnimplementedAdminServiceServer) AdminTopSearchQueries(context.Context, *AdminTopSearchQueriesRequest) (*AdminTopSearchQueriesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminTopSearchQueries not implemented")
+}
+func (UnimplementedAdminServiceServer) AdminZeroResultQueries(context.Context, *AdminZeroResultQueriesRequest) (*AdminZeroResultQueriesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminZeroResultQueries not implemented")
+}
+func (UnimplementedAdminServiceServer) AdminSearchCTR(context.Context, *AdminSearchCTRRequest) (*AdminSearchCTRReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminSearchCTR not implemented")
+}
+func (UnimplementedAdminServiceServer) AdminListSynonymGroups(context.Context, *AdminListSynonymGroupsRequest) (*AdminListSynonymGroupsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListSynonymGroups not implemented")
+}
+func (UnimplementedAdminServiceServer) AdminCreateSynonymGroup(context.Context, *AdminCreateSynonymGroupRequest) (*AdminCreateSynonymGroupReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminCreateSynonymGroup not implemented")
+}
+func (UnimplementedAdminServiceServer) AdminUpdateSynonymGroup(context.Context, *AdminUpdateSynonymGroupRequest) (*AdminUpdateSynonymGroupReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminUpdateSynonymGroup not implemented")
+}
+func (UnimplementedAdminServiceServer) AdminDeleteSynonymGroup(context.Context, *AdminDeleteSynonymGroupRequest) (*AdminDeleteSynonymGroupReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminDeleteSynonymGroup not implemented")
+}
+func (UnimplementedAdminServiceServer) AdminListSpellingCorrections(context.Context, *AdminListSpellingCorrectionsRequest) (*AdminListSpellingCorrectionsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListSpellingCorrections not implemented")
+}
+func (UnimplementedAdminServiceServer) AdminCreateSpellingCorrection(context.Context, *AdminCreateSpellingCorrectionRequest) (*AdminCreateSpellingCorrectionReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminCreateSpellingCorrection not implemented")
+}
+func (UnimplementedAdminServiceServer) AdminUpdateSpellingCorrection(context.Context, *AdminUpdateSpellingCorrectionRequest) (*AdminUpdateSpellingCorrectionReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminUpdateSpellingCorrection not implemented")
+}
+func (UnimplementedAdminServiceServer) AdminDeleteSpellingCorrection(context.Context, *AdminDeleteSpellingCorrectionRequest) (*AdminDeleteSpellingCorrectionReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminDeleteSpellingCorrection not implemented")
+}
+func (UnimplementedAdminServiceServer) AdminReconcileStorage(context.Context, *AdminReconcileStorageRequest) (*AdminReconcileStorageReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminReconcileStorage not implemented")
+}
+func (UnimplementedAdminServiceServer) AdminListDuplicateContent(context.Context, *AdminListDuplicateContentRequest) (*AdminListDuplicateContentReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method AdminListDuplicateContent not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
 
@@ -254,6 +506,42 @@ func _AdminService_AdminDeleteVideo_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_AdminListDeletedVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListDeletedVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdminListDeletedVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdminListDeletedVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdminListDeletedVideos(ctx, req.(*AdminListDeletedVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdminRestoreVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminRestoreVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdminRestoreVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdminRestoreVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdminRestoreVideo(ctx, req.(*AdminRestoreVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AdminService_AdminCreateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AdminCreateTagRequest)
 	if err := dec(in); err != nil {
@@ -308,6 +596,240 @@ func _AdminService_AdminDeleteTag_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_AdminTopSearchQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminTopSearchQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdminTopSearchQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdminTopSearchQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdminTopSearchQueries(ctx, req.(*AdminTopSearchQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdminZeroResultQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminZeroResultQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdminZeroResultQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdminZeroResultQueries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdminZeroResultQueries(ctx, req.(*AdminZeroResultQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdminSearchCTR_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminSearchCTRRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdminSearchCTR(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdminSearchCTR_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdminSearchCTR(ctx, req.(*AdminSearchCTRRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdminListSynonymGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListSynonymGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdminListSynonymGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdminListSynonymGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdminListSynonymGroups(ctx, req.(*AdminListSynonymGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdminCreateSynonymGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCreateSynonymGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdminCreateSynonymGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdminCreateSynonymGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdminCreateSynonymGroup(ctx, req.(*AdminCreateSynonymGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdminUpdateSynonymGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateSynonymGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdminUpdateSynonymGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdminUpdateSynonymGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdminUpdateSynonymGroup(ctx, req.(*AdminUpdateSynonymGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdminDeleteSynonymGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDeleteSynonymGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdminDeleteSynonymGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdminDeleteSynonymGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdminDeleteSynonymGroup(ctx, req.(*AdminDeleteSynonymGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdminListSpellingCorrections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListSpellingCorrectionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdminListSpellingCorrections(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdminListSpellingCorrections_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdminListSpellingCorrections(ctx, req.(*AdminListSpellingCorrectionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdminCreateSpellingCorrection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminCreateSpellingCorrectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdminCreateSpellingCorrection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdminCreateSpellingCorrection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdminCreateSpellingCorrection(ctx, req.(*AdminCreateSpellingCorrectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdminUpdateSpellingCorrection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminUpdateSpellingCorrectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdminUpdateSpellingCorrection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdminUpdateSpellingCorrection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdminUpdateSpellingCorrection(ctx, req.(*AdminUpdateSpellingCorrectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdminDeleteSpellingCorrection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminDeleteSpellingCorrectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdminDeleteSpellingCorrection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdminDeleteSpellingCorrection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdminDeleteSpellingCorrection(ctx, req.(*AdminDeleteSpellingCorrectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdminReconcileStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminReconcileStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdminReconcileStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdminReconcileStorage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdminReconcileStorage(ctx, req.(*AdminReconcileStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_AdminListDuplicateContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminListDuplicateContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).AdminListDuplicateContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_AdminListDuplicateContent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).AdminListDuplicateContent(ctx, req.(*AdminListDuplicateContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -331,6 +853,14 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminDeleteVideo",
 			Handler:    _AdminService_AdminDeleteVideo_Handler,
 		},
+		{
+			MethodName: "AdminListDeletedVideos",
+			Handler:    _AdminService_AdminListDeletedVideos_Handler,
+		},
+		{
+			MethodName: "AdminRestoreVideo",
+			Handler:    _AdminService_AdminRestoreVideo_Handler,
+		},
 		{
 			MethodName: "AdminCreateTag",
 			Handler:    _AdminService_AdminCreateTag_Handler,
@@ -343,6 +873,58 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AdminDeleteTag",
 			Handler:    _AdminService_AdminDeleteTag_Handler,
 		},
+		{
+			MethodName: "AdminTopSearchQueries",
+			Handler:    _AdminService_AdminTopSearchQueries_Handler,
+		},
+		{
+			MethodName: "AdminZeroResultQueries",
+			Handler:    _AdminService_AdminZeroResultQueries_Handler,
+		},
+		{
+			MethodName: "AdminSearchCTR",
+			Handler:    _AdminService_AdminSearchCTR_Handler,
+		},
+		{
+			MethodName: "AdminListSynonymGroups",
+			Handler:    _AdminService_AdminListSynonymGroups_Handler,
+		},
+		{
+			MethodName: "AdminCreateSynonymGroup",
+			Handler:    _AdminService_AdminCreateSynonymGroup_Handler,
+		},
+		{
+			MethodName: "AdminUpdateSynonymGroup",
+			Handler:    _AdminService_AdminUpdateSynonymGroup_Handler,
+		},
+		{
+			MethodName: "AdminDeleteSynonymGroup",
+			Handler:    _AdminService_AdminDeleteSynonymGroup_Handler,
+		},
+		{
+			MethodName: "AdminListSpellingCorrections",
+			Handler:    _AdminService_AdminListSpellingCorrections_Handler,
+		},
+		{
+			MethodName: "AdminCreateSpellingCorrection",
+			Handler:    _AdminService_AdminCreateSpellingCorrection_Handler,
+		},
+		{
+			MethodName: "AdminUpdateSpellingCorrection",
+			Handler:    _AdminService_AdminUpdateSpellingCorrection_Handler,
+		},
+		{
+			MethodName: "AdminDeleteSpellingCorrection",
+			Handler:    _AdminService_AdminDeleteSpellingCorrection_Handler,
+		},
+		{
+			MethodName: "AdminReconcileStorage",
+			Handler:    _AdminService_AdminReconcileStorage_Handler,
+		},
+		{
+			MethodName: "AdminListDuplicateContent",
+			Handler:    _AdminService_AdminListDuplicateContent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fenzvideo/v1/admin.proto",
@@ -19,22 +19,52 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationAdminServiceAdminCreateSpellingCorrection = "/fenzvideo.v1.AdminService/AdminCreateSpellingCorrection"
+const OperationAdminServiceAdminCreateSynonymGroup = "/fenzvideo.v1.AdminService/AdminCreateSynonymGroup"
 const OperationAdminServiceAdminCreateTag = "/fenzvideo.v1.AdminService/AdminCreateTag"
+const OperationAdminServiceAdminDeleteSpellingCorrection = "/fenzvideo.v1.AdminService/AdminDeleteSpellingCorrection"
+const OperationAdminServiceAdminDeleteSynonymGroup = "/fenzvideo.v1.AdminService/AdminDeleteSynonymGroup"
 const OperationAdminServiceAdminDeleteTag = "/fenzvideo.v1.AdminService/AdminDeleteTag"
 const OperationAdminServiceAdminDeleteUser = "/fenzvideo.v1.AdminService/AdminDeleteUser"
 const OperationAdminServiceAdminDeleteVideo = "/fenzvideo.v1.AdminService/AdminDeleteVideo"
+const OperationAdminServiceAdminListDeletedVideos = "/fenzvideo.v1.AdminService/AdminListDeletedVideos"
+const OperationAdminServiceAdminListDuplicateContent = "/fenzvideo.v1.AdminService/AdminListDuplicateContent"
+const OperationAdminServiceAdminListSpellingCorrections = "/fenzvideo.v1.AdminService/AdminListSpellingCorrections"
+const OperationAdminServiceAdminListSynonymGroups = "/fenzvideo.v1.AdminService/AdminListSynonymGroups"
 const OperationAdminServiceAdminListUsers = "/fenzvideo.v1.AdminService/AdminListUsers"
 const OperationAdminServiceAdminListVideos = "/fenzvideo.v1.AdminService/AdminListVideos"
+const OperationAdminServiceAdminReconcileStorage = "/fenzvideo.v1.AdminService/AdminReconcileStorage"
+const OperationAdminServiceAdminRestoreVideo = "/fenzvideo.v1.AdminService/AdminRestoreVideo"
+const OperationAdminServiceAdminSearchCTR = "/fenzvideo.v1.AdminService/AdminSearchCTR"
+const OperationAdminServiceAdminTopSearchQueries = "/fenzvideo.v1.AdminService/AdminTopSearchQueries"
+const OperationAdminServiceAdminUpdateSpellingCorrection = "/fenzvideo.v1.AdminService/AdminUpdateSpellingCorrection"
+const OperationAdminServiceAdminUpdateSynonymGroup = "/fenzvideo.v1.AdminService/AdminUpdateSynonymGroup"
 const OperationAdminServiceAdminUpdateTag = "/fenzvideo.v1.AdminService/AdminUpdateTag"
+const OperationAdminServiceAdminZeroResultQueries = "/fenzvideo.v1.AdminService/AdminZeroResultQueries"
 
 type AdminServiceHTTPServer interface {
+	AdminCreateSpellingCorrection(context.Context, *AdminCreateSpellingCorrectionRequest) (*AdminCreateSpellingCorrectionReply, error)
+	AdminCreateSynonymGroup(context.Context, *AdminCreateSynonymGroupRequest) (*AdminCreateSynonymGroupReply, error)
 	AdminCreateTag(context.Context, *AdminCreateTagRequest) (*AdminCreateTagReply, error)
+	AdminDeleteSpellingCorrection(context.Context, *AdminDeleteSpellingCorrectionRequest) (*AdminDeleteSpellingCorrectionReply, error)
+	AdminDeleteSynonymGroup(context.Context, *AdminDeleteSynonymGroupRequest) (*AdminDeleteSynonymGroupReply, error)
 	AdminDeleteTag(context.Context, *AdminDeleteTagRequest) (*AdminDeleteTagReply, error)
 	AdminDeleteUser(context.Context, *AdminDeleteUserRequest) (*AdminDeleteUserReply, error)
 	AdminDeleteVideo(context.Context, *AdminDeleteVideoRequest) (*AdminDeleteVideoReply, error)
+	AdminListDeletedVideos(context.Context, *AdminListDeletedVideosRequest) (*ListDeletedVideosReply, error)
+	AdminListDuplicateContent(context.Context, *AdminListDuplicateContentRequest) (*AdminListDuplicateContentReply, error)
+	AdminListSpellingCorrections(context.Context, *AdminListSpellingCorrectionsRequest) (*AdminListSpellingCorrectionsReply, error)
+	AdminListSynonymGroups(context.Context, *AdminListSynonymGroupsRequest) (*AdminListSynonymGroupsReply, error)
 	AdminListUsers(context.Context, *AdminListUsersRequest) (*AdminListUsersReply, error)
 	AdminListVideos(context.Context, *AdminListVideosRequest) (*AdminListVideosReply, error)
+	AdminReconcileStorage(context.Context, *AdminReconcileStorageRequest) (*AdminReconcileStorageReply, error)
+	AdminRestoreVideo(context.Context, *AdminRestoreVideoRequest) (*VideoReply, error)
+	AdminSearchCTR(context.Context, *AdminSearchCTRRequest) (*AdminSearchCTRReply, error)
+	AdminTopSearchQueries(context.Context, *AdminTopSearchQueriesRequest) (*AdminTopSearchQueriesReply, error)
+	AdminUpdateSpellingCorrection(context.Context, *AdminUpdateSpellingCorrectionRequest) (*AdminUpdateSpellingCorrectionReply, error)
+	AdminUpdateSynonymGroup(context.Context, *AdminUpdateSynonymGroupRequest) (*AdminUpdateSynonymGroupReply, error)
 	AdminUpdateTag(context.Context, *AdminUpdateTagRequest) (*AdminUpdateTagReply, error)
+	AdminZeroResultQueries(context.Context, *AdminZeroResultQueriesRequest) (*AdminZeroResultQueriesReply, error)
 }
 
 func RegisterAdminServiceHTTPServer(s *http.Server, srv AdminServiceHTTPServer) {
@@ -43,9 +73,24 @@ func RegisterAdminServiceHTTPServer(s *http.Server, srv AdminServiceHTTPServer)
 	r.DELETE("/api/v1/admin/users/{id}", _AdminService_AdminDeleteUser0_HTTP_Handler(srv))
 	r.GET("/api/v1/admin/videos", _AdminService_AdminListVideos0_HTTP_Handler(srv))
 	r.DELETE("/api/v1/admin/videos/{id}", _AdminService_AdminDeleteVideo0_HTTP_Handler(srv))
+	r.GET("/api/v1/admin/videos/trash", _AdminService_AdminListDeletedVideos0_HTTP_Handler(srv))
+	r.POST("/api/v1/admin/videos/{id}/restore", _AdminService_AdminRestoreVideo0_HTTP_Handler(srv))
 	r.POST("/api/v1/admin/tags", _AdminService_AdminCreateTag0_HTTP_Handler(srv))
 	r.PUT("/api/v1/admin/tags/{id}", _AdminService_AdminUpdateTag0_HTTP_Handler(srv))
 	r.DELETE("/api/v1/admin/tags/{id}", _AdminService_AdminDeleteTag0_HTTP_Handler(srv))
+	r.GET("/api/v1/admin/search/top-queries", _AdminService_AdminTopSearchQueries0_HTTP_Handler(srv))
+	r.GET("/api/v1/admin/search/zero-result-queries", _AdminService_AdminZeroResultQueries0_HTTP_Handler(srv))
+	r.GET("/api/v1/admin/search/ctr", _AdminService_AdminSearchCTR0_HTTP_Handler(srv))
+	r.GET("/api/v1/admin/search/synonyms", _AdminService_AdminListSynonymGroups0_HTTP_Handler(srv))
+	r.POST("/api/v1/admin/search/synonyms", _AdminService_AdminCreateSynonymGroup0_HTTP_Handler(srv))
+	r.PUT("/api/v1/admin/search/synonyms/{id}", _AdminService_AdminUpdateSynonymGroup0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/admin/search/synonyms/{id}", _AdminService_AdminDeleteSynonymGroup0_HTTP_Handler(srv))
+	r.GET("/api/v1/admin/search/corrections", _AdminService_AdminListSpellingCorrections0_HTTP_Handler(srv))
+	r.POST("/api/v1/admin/search/corrections", _AdminService_AdminCreateSpellingCorrection0_HTTP_Handler(srv))
+	r.PUT("/api/v1/admin/search/corrections/{id}", _AdminService_AdminUpdateSpellingCorrection0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/admin/search/corrections/{id}", _AdminService_AdminDeleteSpellingCorrection0_HTTP_Handler(srv))
+	r.POST("/api/v1/admin/storage/reconcile", _AdminService_AdminReconcileStorage0_HTTP_Handler(srv))
+	r.GET("/api/v1/admin/uploads/duplicates", _AdminService_AdminListDuplicateContent0_HTTP_Handler(srv))
 }
 
 func _AdminService_AdminListUsers0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
@@ -130,6 +175,50 @@ func _AdminService_AdminDeleteVideo0_HTTP_Handler(srv AdminServiceHTTPServer) fu
 	}
 }
 
+func _AdminService_AdminListDeletedVideos0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminListDeletedVideosRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAdminServiceAdminListDeletedVideos)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminListDeletedVideos(ctx, req.(*AdminListDeletedVideosRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDeletedVideosReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AdminService_AdminRestoreVideo0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminRestoreVideoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAdminServiceAdminRestoreVideo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminRestoreVideo(ctx, req.(*AdminRestoreVideoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VideoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _AdminService_AdminCreateTag0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in AdminCreateTagRequest
@@ -199,14 +288,303 @@ func _AdminService_AdminDeleteTag0_HTTP_Handler(srv AdminServiceHTTPServer) func
 	}
 }
 
+func _AdminService_AdminTopSearchQueries0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminTopSearchQueriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAdminServiceAdminTopSearchQueries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminTopSearchQueries(ctx, req.(*AdminTopSearchQueriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminTopSearchQueriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AdminService_AdminZeroResultQueries0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminZeroResultQueriesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAdminServiceAdminZeroResultQueries)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminZeroResultQueries(ctx, req.(*AdminZeroResultQueriesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminZeroResultQueriesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AdminService_AdminSearchCTR0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminSearchCTRRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAdminServiceAdminSearchCTR)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminSearchCTR(ctx, req.(*AdminSearchCTRRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminSearchCTRReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AdminService_AdminListSynonymGroups0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminListSynonymGroupsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAdminServiceAdminListSynonymGroups)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminListSynonymGroups(ctx, req.(*AdminListSynonymGroupsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminListSynonymGroupsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AdminService_AdminCreateSynonymGroup0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCreateSynonymGroupRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAdminServiceAdminCreateSynonymGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCreateSynonymGroup(ctx, req.(*AdminCreateSynonymGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCreateSynonymGroupReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AdminService_AdminUpdateSynonymGroup0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUpdateSynonymGroupRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAdminServiceAdminUpdateSynonymGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUpdateSynonymGroup(ctx, req.(*AdminUpdateSynonymGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminUpdateSynonymGroupReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AdminService_AdminDeleteSynonymGroup0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDeleteSynonymGroupRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAdminServiceAdminDeleteSynonymGroup)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDeleteSynonymGroup(ctx, req.(*AdminDeleteSynonymGroupRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDeleteSynonymGroupReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AdminService_AdminListSpellingCorrections0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminListSpellingCorrectionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAdminServiceAdminListSpellingCorrections)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminListSpellingCorrections(ctx, req.(*AdminListSpellingCorrectionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminListSpellingCorrectionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AdminService_AdminCreateSpellingCorrection0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminCreateSpellingCorrectionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAdminServiceAdminCreateSpellingCorrection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminCreateSpellingCorrection(ctx, req.(*AdminCreateSpellingCorrectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminCreateSpellingCorrectionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AdminService_AdminUpdateSpellingCorrection0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminUpdateSpellingCorrectionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAdminServiceAdminUpdateSpellingCorrection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminUpdateSpellingCorrection(ctx, req.(*AdminUpdateSpellingCorrectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminUpdateSpellingCorrectionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AdminService_AdminDeleteSpellingCorrection0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminDeleteSpellingCorrectionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAdminServiceAdminDeleteSpellingCorrection)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminDeleteSpellingCorrection(ctx, req.(*AdminDeleteSpellingCorrectionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminDeleteSpellingCorrectionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AdminService_AdminReconcileStorage0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminReconcileStorageRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAdminServiceAdminReconcileStorage)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminReconcileStorage(ctx, req.(*AdminReconcileStorageRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminReconcileStorageReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _AdminService_AdminListDuplicateContent0_HTTP_Handler(srv AdminServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AdminListDuplicateContentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAdminServiceAdminListDuplicateContent)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AdminListDuplicateContent(ctx, req.(*AdminListDuplicateContentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AdminListDuplicateContentReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type AdminServiceHTTPClient interface {
+	AdminCreateSpellingCorrection(ctx context.Context, req *AdminCreateSpellingCorrectionRequest, opts ...http.CallOption) (rsp *AdminCreateSpellingCorrectionReply, err error)
+	AdminCreateSynonymGroup(ctx context.Context, req *AdminCreateSynonymGroupRequest, opts ...http.CallOption) (rsp *AdminCreateSynonymGroupReply, err error)
 	AdminCreateTag(ctx context.Context, req *AdminCreateTagRequest, opts ...http.CallOption) (rsp *AdminCreateTagReply, err error)
+	AdminDeleteSpellingCorrection(ctx context.Context, req *AdminDeleteSpellingCorrectionRequest, opts ...http.CallOption) (rsp *AdminDeleteSpellingCorrectionReply, err error)
+	AdminDeleteSynonymGroup(ctx context.Context, req *AdminDeleteSynonymGroupRequest, opts ...http.CallOption) (rsp *AdminDeleteSynonymGroupReply, err error)
 	AdminDeleteTag(ctx context.Context, req *AdminDeleteTagRequest, opts ...http.CallOption) (rsp *AdminDeleteTagReply, err error)
 	AdminDeleteUser(ctx context.Context, req *AdminDeleteUserRequest, opts ...http.CallOption) (rsp *AdminDeleteUserReply, err error)
 	AdminDeleteVideo(ctx context.Context, req *AdminDeleteVideoRequest, opts ...http.CallOption) (rsp *AdminDeleteVideoReply, err error)
+	AdminListDeletedVideos(ctx context.Context, req *AdminListDeletedVideosRequest, opts ...http.CallOption) (rsp *ListDeletedVideosReply, err error)
+	AdminListDuplicateContent(ctx context.Context, req *AdminListDuplicateContentRequest, opts ...http.CallOption) (rsp *AdminListDuplicateContentReply, err error)
+	AdminListSpellingCorrections(ctx context.Context, req *AdminListSpellingCorrectionsRequest, opts ...http.CallOption) (rsp *AdminListSpellingCorrectionsReply, err error)
+	AdminListSynonymGroups(ctx context.Context, req *AdminListSynonymGroupsRequest, opts ...http.CallOption) (rsp *AdminListSynonymGroupsReply, err error)
 	AdminListUsers(ctx context.Context, req *AdminListUsersRequest, opts ...http.CallOption) (rsp *AdminListUsersReply, err error)
 	AdminListVideos(ctx context.Context, req *AdminListVideosRequest, opts ...http.CallOption) (rsp *AdminListVideosReply, err error)
+	AdminReconcileStorage(ctx context.Context, req *AdminReconcileStorageRequest, opts ...http.CallOption) (rsp *AdminReconcileStorageReply, err error)
+	AdminRestoreVideo(ctx context.Context, req *AdminRestoreVideoRequest, opts ...http.CallOption) (rsp *VideoReply, err error)
+	AdminSearchCTR(ctx context.Context, req *AdminSearchCTRRequest, opts ...http.CallOption) (rsp *AdminSearchCTRReply, err error)
+	AdminTopSearchQueries(ctx context.Context, req *AdminTopSearchQueriesRequest, opts ...http.CallOption) (rsp *AdminTopSearchQueriesReply, err error)
+	AdminUpdateSpellingCorrection(ctx context.Context, req *AdminUpdateSpellingCorrectionRequest, opts ...http.CallOption) (rsp *AdminUpdateSpellingCorrectionReply, err error)
+	AdminUpdateSynonymGroup(ctx context.Context, req *AdminUpdateSynonymGroupRequest, opts ...http.CallOption) (rsp *AdminUpdateSynonymGroupReply, err error)
 	AdminUpdateTag(ctx context.Context, req *AdminUpdateTagRequest, opts ...http.CallOption) (rsp *AdminUpdateTagReply, err error)
+	AdminZeroResultQueries(ctx context.Context, req *AdminZeroResultQueriesRequest, opts ...http.CallOption) (rsp *AdminZeroResultQueriesReply, err error)
 }
 
 type AdminServiceHTTPClientImpl struct {
@@ -217,6 +595,32 @@ func NewAdminServiceHTTPClient(client *http.Client) AdminServiceHTTPClient {
 	return &AdminServiceHTTPClientImpl{client}
 }
 
+func (c *AdminServiceHTTPClientImpl) AdminCreateSpellingCorrection(ctx context.Context, in *AdminCreateSpellingCorrectionRequest, opts ...http.CallOption) (*AdminCreateSpellingCorrectionReply, error) {
+	var out AdminCreateSpellingCorrectionReply
+	pattern := "/api/v1/admin/search/corrections"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAdminServiceAdminCreateSpellingCorrection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AdminServiceHTTPClientImpl) AdminCreateSynonymGroup(ctx context.Context, in *AdminCreateSynonymGroupRequest, opts ...http.CallOption) (*AdminCreateSynonymGroupReply, error) {
+	var out AdminCreateSynonymGroupReply
+	pattern := "/api/v1/admin/search/synonyms"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAdminServiceAdminCreateSynonymGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AdminServiceHTTPClientImpl) AdminCreateTag(ctx context.Context, in *AdminCreateTagRequest, opts ...http.CallOption) (*AdminCreateTagReply, error) {
 	var out AdminCreateTagReply
 	pattern := "/api/v1/admin/tags"
@@ -230,6 +634,32 @@ func (c *AdminServiceHTTPClientImpl) AdminCreateTag(ctx context.Context, in *Adm
 	return &out, nil
 }
 
+func (c *AdminServiceHTTPClientImpl) AdminDeleteSpellingCorrection(ctx context.Context, in *AdminDeleteSpellingCorrectionRequest, opts ...http.CallOption) (*AdminDeleteSpellingCorrectionReply, error) {
+	var out AdminDeleteSpellingCorrectionReply
+	pattern := "/api/v1/admin/search/corrections/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAdminServiceAdminDeleteSpellingCorrection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AdminServiceHTTPClientImpl) AdminDeleteSynonymGroup(ctx context.Context, in *AdminDeleteSynonymGroupRequest, opts ...http.CallOption) (*AdminDeleteSynonymGroupReply, error) {
+	var out AdminDeleteSynonymGroupReply
+	pattern := "/api/v1/admin/search/synonyms/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAdminServiceAdminDeleteSynonymGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AdminServiceHTTPClientImpl) AdminDeleteTag(ctx context.Context, in *AdminDeleteTagRequest, opts ...http.CallOption) (*AdminDeleteTagReply, error) {
 	var out AdminDeleteTagReply
 	pattern := "/api/v1/admin/tags/{id}"
@@ -269,6 +699,58 @@ func (c *AdminServiceHTTPClientImpl) AdminDeleteVideo(ctx context.Context, in *A
 	return &out, nil
 }
 
+func (c *AdminServiceHTTPClientImpl) AdminListDeletedVideos(ctx context.Context, in *AdminListDeletedVideosRequest, opts ...http.CallOption) (*ListDeletedVideosReply, error) {
+	var out ListDeletedVideosReply
+	pattern := "/api/v1/admin/videos/trash"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAdminServiceAdminListDeletedVideos))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AdminServiceHTTPClientImpl) AdminListDuplicateContent(ctx context.Context, in *AdminListDuplicateContentRequest, opts ...http.CallOption) (*AdminListDuplicateContentReply, error) {
+	var out AdminListDuplicateContentReply
+	pattern := "/api/v1/admin/uploads/duplicates"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAdminServiceAdminListDuplicateContent))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AdminServiceHTTPClientImpl) AdminListSpellingCorrections(ctx context.Context, in *AdminListSpellingCorrectionsRequest, opts ...http.CallOption) (*AdminListSpellingCorrectionsReply, error) {
+	var out AdminListSpellingCorrectionsReply
+	pattern := "/api/v1/admin/search/corrections"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAdminServiceAdminListSpellingCorrections))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AdminServiceHTTPClientImpl) AdminListSynonymGroups(ctx context.Context, in *AdminListSynonymGroupsRequest, opts ...http.CallOption) (*AdminListSynonymGroupsReply, error) {
+	var out AdminListSynonymGroupsReply
+	pattern := "/api/v1/admin/search/synonyms"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAdminServiceAdminListSynonymGroups))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AdminServiceHTTPClientImpl) AdminListUsers(ctx context.Context, in *AdminListUsersRequest, opts ...http.CallOption) (*AdminListUsersReply, error) {
 	var out AdminListUsersReply
 	pattern := "/api/v1/admin/users"
@@ -295,6 +777,84 @@ func (c *AdminServiceHTTPClientImpl) AdminListVideos(ctx context.Context, in *Ad
 	return &out, nil
 }
 
+func (c *AdminServiceHTTPClientImpl) AdminReconcileStorage(ctx context.Context, in *AdminReconcileStorageRequest, opts ...http.CallOption) (*AdminReconcileStorageReply, error) {
+	var out AdminReconcileStorageReply
+	pattern := "/api/v1/admin/storage/reconcile"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAdminServiceAdminReconcileStorage))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AdminServiceHTTPClientImpl) AdminRestoreVideo(ctx context.Context, in *AdminRestoreVideoRequest, opts ...http.CallOption) (*VideoReply, error) {
+	var out VideoReply
+	pattern := "/api/v1/admin/videos/{id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAdminServiceAdminRestoreVideo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AdminServiceHTTPClientImpl) AdminSearchCTR(ctx context.Context, in *AdminSearchCTRRequest, opts ...http.CallOption) (*AdminSearchCTRReply, error) {
+	var out AdminSearchCTRReply
+	pattern := "/api/v1/admin/search/ctr"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAdminServiceAdminSearchCTR))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AdminServiceHTTPClientImpl) AdminTopSearchQueries(ctx context.Context, in *AdminTopSearchQueriesRequest, opts ...http.CallOption) (*AdminTopSearchQueriesReply, error) {
+	var out AdminTopSearchQueriesReply
+	pattern := "/api/v1/admin/search/top-queries"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAdminServiceAdminTopSearchQueries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AdminServiceHTTPClientImpl) AdminUpdateSpellingCorrection(ctx context.Context, in *AdminUpdateSpellingCorrectionRequest, opts ...http.CallOption) (*AdminUpdateSpellingCorrectionReply, error) {
+	var out AdminUpdateSpellingCorrectionReply
+	pattern := "/api/v1/admin/search/corrections/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAdminServiceAdminUpdateSpellingCorrection))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AdminServiceHTTPClientImpl) AdminUpdateSynonymGroup(ctx context.Context, in *AdminUpdateSynonymGroupRequest, opts ...http.CallOption) (*AdminUpdateSynonymGroupReply, error) {
+	var out AdminUpdateSynonymGroupReply
+	pattern := "/api/v1/admin/search/synonyms/{id}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAdminServiceAdminUpdateSynonymGroup))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *AdminServiceHTTPClientImpl) AdminUpdateTag(ctx context.Context, in *AdminUpdateTagRequest, opts ...http.CallOption) (*AdminUpdateTagReply, error) {
 	var out AdminUpdateTagReply
 	pattern := "/api/v1/admin/tags/{id}"
@@ -307,3 +867,16 @@ func (c *AdminServiceHTTPClientImpl) AdminUpdateTag(ctx context.Context, in *Adm
 	}
 	return &out, nil
 }
+
+func (c *AdminServiceHTTPClientImpl) AdminZeroResultQueries(ctx context.Context, in *AdminZeroResultQueriesRequest, opts ...http.CallOption) (*AdminZeroResultQueriesReply, error) {
+	var out AdminZeroResultQueriesReply
+	pattern := "/api/v1/admin/search/zero-result-queries"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAdminServiceAdminZeroResultQueries))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -67,6 +67,61 @@ const (
 	// Paddle
 	ErrorReason_PADDLE_WEBHOOK_INVALID ErrorReason = 29
 	ErrorReason_PADDLE_API_ERROR       ErrorReason = 30
+	// Search
+	ErrorReason_SEARCH_ID_INVALID             ErrorReason = 31
+	ErrorReason_SYNONYM_GROUP_NOT_FOUND       ErrorReason = 32
+	ErrorReason_SYNONYM_GROUP_INVALID         ErrorReason = 33
+	ErrorReason_SYNONYM_TERM_EXISTS           ErrorReason = 34
+	ErrorReason_SPELLING_CORRECTION_NOT_FOUND ErrorReason = 35
+	ErrorReason_SPELLING_CORRECTION_INVALID   ErrorReason = 36
+	ErrorReason_SPELLING_CORRECTION_EXISTS    ErrorReason = 37
+	// Pagination
+	ErrorReason_CURSOR_INVALID ErrorReason = 38
+	// Upload
+	ErrorReason_UPLOAD_NOT_FOUND        ErrorReason = 39
+	ErrorReason_UPLOAD_INVALID          ErrorReason = 40
+	ErrorReason_UPLOAD_TOO_LARGE        ErrorReason = 41
+	ErrorReason_UPLOAD_TYPE_UNSUPPORTED ErrorReason = 42
+	ErrorReason_UPLOAD_OFFSET_MISMATCH  ErrorReason = 43
+	ErrorReason_UPLOAD_INCOMPLETE       ErrorReason = 44
+	ErrorReason_UPLOAD_LOCKED           ErrorReason = 45
+	// Media
+	ErrorReason_VIDEO_UNDECODABLE        ErrorReason = 46
+	ErrorReason_VIDEO_SOURCE_INVALID     ErrorReason = 47
+	ErrorReason_VIDEO_INVALID_TRANSITION ErrorReason = 48
+	ErrorReason_VIDEO_INVALID_VISIBILITY ErrorReason = 49
+	// Thumbnails
+	ErrorReason_THUMBNAIL_NOT_FOUND ErrorReason = 50
+	ErrorReason_THUMBNAIL_INVALID   ErrorReason = 51
+	ErrorReason_THUMBNAIL_CONFLICT  ErrorReason = 52
+	// Captions
+	ErrorReason_CAPTION_NOT_FOUND        ErrorReason = 53
+	ErrorReason_CAPTION_INVALID          ErrorReason = 54
+	ErrorReason_CAPTION_LANGUAGE_INVALID ErrorReason = 55
+	// Chapters
+	ErrorReason_CHAPTER_INVALID ErrorReason = 56
+	// Quotas
+	ErrorReason_STORAGE_QUOTA_EXCEEDED ErrorReason = 57
+	ErrorReason_UPLOAD_RATE_LIMITED    ErrorReason = 58
+	// Upload verification
+	ErrorReason_UPLOAD_CONTENT_MISMATCH ErrorReason = 59
+	ErrorReason_UPLOAD_REJECTED         ErrorReason = 60
+	ErrorReason_UPLOAD_DUPLICATE        ErrorReason = 61
+	// Scheduled publishing
+	ErrorReason_VIDEO_INVALID_SCHEDULE ErrorReason = 62
+	// Share links
+	ErrorReason_VIDEO_SHARE_LIMIT     ErrorReason = 63
+	ErrorReason_VIDEO_SHARE_NOT_FOUND ErrorReason = 64
+	ErrorReason_VIDEO_SHARE_INVALID   ErrorReason = 65
+	// Partial updates
+	ErrorReason_VIDEO_INVALID_UPDATE   ErrorReason = 66
+	ErrorReason_VIDEO_VERSION_CONFLICT ErrorReason = 67
+	// Revisions
+	ErrorReason_VIDEO_REVISION_NOT_FOUND ErrorReason = 68
+	// Trash
+	ErrorReason_VIDEO_NOT_RESTORABLE ErrorReason = 69
+	// Source replacement
+	ErrorReason_VIDEO_SOURCE_CHANGED ErrorReason = 70
 )
 
 // Enum value maps for ErrorReason.
@@ -103,39 +158,119 @@ var (
 		28: "NOTIFICATION_NOT_FOUND",
 		29: "PADDLE_WEBHOOK_INVALID",
 		30: "PADDLE_API_ERROR",
+		31: "SEARCH_ID_INVALID",
+		32: "SYNONYM_GROUP_NOT_FOUND",
+		33: "SYNONYM_GROUP_INVALID",
+		34: "SYNONYM_TERM_EXISTS",
+		35: "SPELLING_CORRECTION_NOT_FOUND",
+		36: "SPELLING_CORRECTION_INVALID",
+		37: "SPELLING_CORRECTION_EXISTS",
+		38: "CURSOR_INVALID",
+		39: "UPLOAD_NOT_FOUND",
+		40: "UPLOAD_INVALID",
+		41: "UPLOAD_TOO_LARGE",
+		42: "UPLOAD_TYPE_UNSUPPORTED",
+		43: "UPLOAD_OFFSET_MISMATCH",
+		44: "UPLOAD_INCOMPLETE",
+		45: "UPLOAD_LOCKED",
+		46: "VIDEO_UNDECODABLE",
+		47: "VIDEO_SOURCE_INVALID",
+		48: "VIDEO_INVALID_TRANSITION",
+		49: "VIDEO_INVALID_VISIBILITY",
+		50: "THUMBNAIL_NOT_FOUND",
+		51: "THUMBNAIL_INVALID",
+		52: "THUMBNAIL_CONFLICT",
+		53: "CAPTION_NOT_FOUND",
+		54: "CAPTION_INVALID",
+		55: "CAPTION_LANGUAGE_INVALID",
+		56: "CHAPTER_INVALID",
+		57: "STORAGE_QUOTA_EXCEEDED",
+		58: "UPLOAD_RATE_LIMITED",
+		59: "UPLOAD_CONTENT_MISMATCH",
+		60: "UPLOAD_REJECTED",
+		61: "UPLOAD_DUPLICATE",
+		62: "VIDEO_INVALID_SCHEDULE",
+		63: "VIDEO_SHARE_LIMIT",
+		64: "VIDEO_SHARE_NOT_FOUND",
+		65: "VIDEO_SHARE_INVALID",
+		66: "VIDEO_INVALID_UPDATE",
+		67: "VIDEO_VERSION_CONFLICT",
+		68: "VIDEO_REVISION_NOT_FOUND",
+		69: "VIDEO_NOT_RESTORABLE",
+		70: "VIDEO_SOURCE_CHANGED",
 	}
 	ErrorReason_value = map[string]int32{
-		"ERROR_REASON_UNSPECIFIED":   0,
-		"INVALID_CREDENTIALS":        1,
-		"USERNAME_ALREADY_EXISTS":    2,
-		"TOKEN_EXPIRED":              3,
-		"TOKEN_INVALID":              4,
-		"UNAUTHORIZED":               5,
-		"ACCOUNT_HIDDEN":             6,
-		"ADMIN_REQUIRED":             7,
-		"ADMIN_SELF_DELETE":          8,
-		"USER_NOT_FOUND":             9,
-		"PASSWORD_MISMATCH":          10,
-		"VIDEO_NOT_FOUND":            11,
-		"VIDEO_ACCESS_DENIED":        12,
-		"VIDEO_NOT_OWNER":            13,
-		"VIDEO_UPLOAD_FAILED":        14,
-		"CHANNEL_NOT_FOUND":          15,
-		"CHANNEL_ALREADY_SUBSCRIBED": 16,
-		"CHANNEL_NOT_SUBSCRIBED":     17,
-		"CHANNEL_SELF_SUBSCRIBE":     18,
-		"TAG_NOT_FOUND":              19,
-		"TAG_LIMIT_EXCEEDED":         20,
-		"TAG_ALREADY_EXISTS":         21,
-		"CATEGORY_NOT_FOUND":         22,
-		"MEMBERSHIP_NOT_FOUND":       23,
-		"ALREADY_PREMIUM":            24,
-		"NOT_PREMIUM":                25,
-		"DONATION_FAILED":            26,
-		"DONATION_SELF":              27,
-		"NOTIFICATION_NOT_FOUND":     28,
-		"PADDLE_WEBHOOK_INVALID":     29,
-		"PADDLE_API_ERROR":           30,
+		"ERROR_REASON_UNSPECIFIED":      0,
+		"INVALID_CREDENTIALS":           1,
+		"USERNAME_ALREADY_EXISTS":       2,
+		"TOKEN_EXPIRED":                 3,
+		"TOKEN_INVALID":                 4,
+		"UNAUTHORIZED":                  5,
+		"ACCOUNT_HIDDEN":                6,
+		"ADMIN_REQUIRED":                7,
+		"ADMIN_SELF_DELETE":             8,
+		"USER_NOT_FOUND":                9,
+		"PASSWORD_MISMATCH":             10,
+		"VIDEO_NOT_FOUND":               11,
+		"VIDEO_ACCESS_DENIED":           12,
+		"VIDEO_NOT_OWNER":               13,
+		"VIDEO_UPLOAD_FAILED":           14,
+		"CHANNEL_NOT_FOUND":             15,
+		"CHANNEL_ALREADY_SUBSCRIBED":    16,
+		"CHANNEL_NOT_SUBSCRIBED":        17,
+		"CHANNEL_SELF_SUBSCRIBE":        18,
+		"TAG_NOT_FOUND":                 19,
+		"TAG_LIMIT_EXCEEDED":            20,
+		"TAG_ALREADY_EXISTS":            21,
+		"CATEGORY_NOT_FOUND":            22,
+		"MEMBERSHIP_NOT_FOUND":          23,
+		"ALREADY_PREMIUM":               24,
+		"NOT_PREMIUM":                   25,
+		"DONATION_FAILED":               26,
+		"DONATION_SELF":                 27,
+		"NOTIFICATION_NOT_FOUND":        28,
+		"PADDLE_WEBHOOK_INVALID":        29,
+		"PADDLE_API_ERROR":              30,
+		"SEARCH_ID_INVALID":             31,
+		"SYNONYM_GROUP_NOT_FOUND":       32,
+		"SYNONYM_GROUP_INVALID":         33,
+		"SYNONYM_TERM_EXISTS":           34,
+		"SPELLING_CORRECTION_NOT_FOUND": 35,
+		"SPELLING_CORRECTION_INVALID":   36,
+		"SPELLING_CORRECTION_EXISTS":    37,
+		"CURSOR_INVALID":                38,
+		"UPLOAD_NOT_FOUND":              39,
+		"UPLOAD_INVALID":                40,
+		"UPLOAD_TOO_LARGE":              41,
+		"UPLOAD_TYPE_UNSUPPORTED":       42,
+		"UPLOAD_OFFSET_MISMATCH":        43,
+		"UPLOAD_INCOMPLETE":             44,
+		"UPLOAD_LOCKED":                 45,
+		"VIDEO_UNDECODABLE":             46,
+		"VIDEO_SOURCE_INVALID":          47,
+		"VIDEO_INVALID_TRANSITION":      48,
+		"VIDEO_INVALID_VISIBILITY":      49,
+		"THUMBNAIL_NOT_FOUND":           50,
+		"THUMBNAIL_INVALID":             51,
+		"THUMBNAIL_CONFLICT":            52,
+		"CAPTION_NOT_FOUND":             53,
+		"CAPTION_INVALID":               54,
+		"CAPTION_LANGUAGE_INVALID":      55,
+		"CHAPTER_INVALID":               56,
+		"STORAGE_QUOTA_EXCEEDED":        57,
+		"UPLOAD_RATE_LIMITED":           58,
+		"UPLOAD_CONTENT_MISMATCH":       59,
+		"UPLOAD_REJECTED":               60,
+		"UPLOAD_DUPLICATE":              61,
+		"VIDEO_INVALID_SCHEDULE":        62,
+		"VIDEO_SHARE_LIMIT":             63,
+		"VIDEO_SHARE_NOT_FOUND":         64,
+		"VIDEO_SHARE_INVALID":           65,
+		"VIDEO_INVALID_UPDATE":          66,
+		"VIDEO_VERSION_CONFLICT":        67,
+		"VIDEO_REVISION_NOT_FOUND":      68,
+		"VIDEO_NOT_RESTORABLE":          69,
+		"VIDEO_SOURCE_CHANGED":          70,
 	}
 )
 
@@ -170,7 +305,7 @@ var File_fenzvideo_v1_error_reason_proto protoreflect.FileDescriptor
 
 const file_fenzvideo_v1_error_reason_proto_rawDesc = "" +
 	"\n" +
-	"\x1ffenzvideo/v1/error_reason.proto\x12\ffenzvideo.v1*\xdf\x05\n" +
+	"\x1ffenzvideo/v1/error_reason.proto\x12\ffenzvideo.v1*\xe2\r\n" +
 	"\vErrorReason\x12\x1c\n" +
 	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13INVALID_CREDENTIALS\x10\x01\x12\x1b\n" +
@@ -203,7 +338,47 @@ const file_fenzvideo_v1_error_reason_proto_rawDesc = "" +
 	"\rDONATION_SELF\x10\x1b\x12\x1a\n" +
 	"\x16NOTIFICATION_NOT_FOUND\x10\x1c\x12\x1a\n" +
 	"\x16PADDLE_WEBHOOK_INVALID\x10\x1d\x12\x14\n" +
-	"\x10PADDLE_API_ERROR\x10\x1eB\x1dZ\x1bbackend/api/fenzvideo/v1;v1b\x06proto3"
+	"\x10PADDLE_API_ERROR\x10\x1e\x12\x15\n" +
+	"\x11SEARCH_ID_INVALID\x10\x1f\x12\x1b\n" +
+	"\x17SYNONYM_GROUP_NOT_FOUND\x10 \x12\x19\n" +
+	"\x15SYNONYM_GROUP_INVALID\x10!\x12\x17\n" +
+	"\x13SYNONYM_TERM_EXISTS\x10\"\x12!\n" +
+	"\x1dSPELLING_CORRECTION_NOT_FOUND\x10#\x12\x1f\n" +
+	"\x1bSPELLING_CORRECTION_INVALID\x10$\x12\x1e\n" +
+	"\x1aSPELLING_CORRECTION_EXISTS\x10%\x12\x12\n" +
+	"\x0eCURSOR_INVALID\x10&\x12\x14\n" +
+	"\x10UPLOAD_NOT_FOUND\x10'\x12\x12\n" +
+	"\x0eUPLOAD_INVALID\x10(\x12\x14\n" +
+	"\x10UPLOAD_TOO_LARGE\x10)\x12\x1b\n" +
+	"\x17UPLOAD_TYPE_UNSUPPORTED\x10*\x12\x1a\n" +
+	"\x16UPLOAD_OFFSET_MISMATCH\x10+\x12\x15\n" +
+	"\x11UPLOAD_INCOMPLETE\x10,\x12\x11\n" +
+	"\rUPLOAD_LOCKED\x10-\x12\x15\n" +
+	"\x11VIDEO_UNDECODABLE\x10.\x12\x18\n" +
+	"\x14VIDEO_SOURCE_INVALID\x10/\x12\x1c\n" +
+	"\x18VIDEO_INVALID_TRANSITION\x100\x12\x1c\n" +
+	"\x18VIDEO_INVALID_VISIBILITY\x101\x12\x17\n" +
+	"\x13THUMBNAIL_NOT_FOUND\x102\x12\x15\n" +
+	"\x11THUMBNAIL_INVALID\x103\x12\x16\n" +
+	"\x12THUMBNAIL_CONFLICT\x104\x12\x15\n" +
+	"\x11CAPTION_NOT_FOUND\x105\x12\x13\n" +
+	"\x0fCAPTION_INVALID\x106\x12\x1c\n" +
+	"\x18CAPTION_LANGUAGE_INVALID\x107\x12\x13\n" +
+	"\x0fCHAPTER_INVALID\x108\x12\x1a\n" +
+	"\x16STORAGE_QUOTA_EXCEEDED\x109\x12\x17\n" +
+	"\x13UPLOAD_RATE_LIMITED\x10:\x12\x1b\n" +
+	"\x17UPLOAD_CONTENT_MISMATCH\x10;\x12\x13\n" +
+	"\x0fUPLOAD_REJECTED\x10<\x12\x14\n" +
+	"\x10UPLOAD_DUPLICATE\x10=\x12\x1a\n" +
+	"\x16VIDEO_INVALID_SCHEDULE\x10>\x12\x15\n" +
+	"\x11VIDEO_SHARE_LIMIT\x10?\x12\x19\n" +
+	"\x15VIDEO_SHARE_NOT_FOUND\x10@\x12\x17\n" +
+	"\x13VIDEO_SHARE_INVALID\x10A\x12\x18\n" +
+	"\x14VIDEO_INVALID_UPDATE\x10B\x12\x1a\n" +
+	"\x16VIDEO_VERSION_CONFLICT\x10C\x12\x1c\n" +
+	"\x18VIDEO_REVISION_NOT_FOUND\x10D\x12\x18\n" +
+	"\x14VIDEO_NOT_RESTORABLE\x10E\x12\x18\n" +
+	"\x14VIDEO_SOURCE_CHANGED\x10FB\x1dZ\x1bbackend/api/fenzvideo/v1;v1b\x06proto3"
 
 var (
 	file_fenzvideo_v1_error_reason_proto_rawDescOnce sync.Once
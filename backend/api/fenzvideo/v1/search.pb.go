@@ -23,17 +23,20 @@ const (
 )
 
 type SearchRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Query         string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	CategoryId    *uint64                `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	MinDuration   *uint32                `protobuf:"varint,3,opt,name=min_duration,json=minDuration,proto3,oneof" json:"min_duration,omitempty"`
-	MaxDuration   *uint32                `protobuf:"varint,4,opt,name=max_duration,json=maxDuration,proto3,oneof" json:"max_duration,omitempty"`
-	DateFrom      *string                `protobuf:"bytes,5,opt,name=date_from,json=dateFrom,proto3,oneof" json:"date_from,omitempty"`
-	DateTo        *string                `protobuf:"bytes,6,opt,name=date_to,json=dateTo,proto3,oneof" json:"date_to,omitempty"`
-	SortBy        *string                `protobuf:"bytes,7,opt,name=sort_by,json=sortBy,proto3,oneof" json:"sort_by,omitempty"`
-	AccessType    *string                `protobuf:"bytes,8,opt,name=access_type,json=accessType,proto3,oneof" json:"access_type,omitempty"`
-	Page          int32                  `protobuf:"varint,9,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Query       string                 `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	CategoryId  *uint64                `protobuf:"varint,2,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	MinDuration *uint32                `protobuf:"varint,3,opt,name=min_duration,json=minDuration,proto3,oneof" json:"min_duration,omitempty"`
+	MaxDuration *uint32                `protobuf:"varint,4,opt,name=max_duration,json=maxDuration,proto3,oneof" json:"max_duration,omitempty"`
+	DateFrom    *string                `protobuf:"bytes,5,opt,name=date_from,json=dateFrom,proto3,oneof" json:"date_from,omitempty"`
+	DateTo      *string                `protobuf:"bytes,6,opt,name=date_to,json=dateTo,proto3,oneof" json:"date_to,omitempty"`
+	SortBy      *string                `protobuf:"bytes,7,opt,name=sort_by,json=sortBy,proto3,oneof" json:"sort_by,omitempty"`
+	AccessType  *string                `protobuf:"bytes,8,opt,name=access_type,json=accessType,proto3,oneof" json:"access_type,omitempty"`
+	Page        int32                  `protobuf:"varint,9,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize    int32                  `protobuf:"varint,10,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	SessionId   *string                `protobuf:"bytes,11,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	// Opaque keyset cursor from a previous reply; overrides page when set.
+	Cursor        string `protobuf:"bytes,12,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -138,11 +141,201 @@ func (x *SearchRequest) GetPageSize() int32 {
 	return 0
 }
 
+func (x *SearchRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *SearchRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type SearchReply struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Videos []*VideoReply          `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	// Omitted when paging by cursor.
+	Total *int64 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	// Opaque ID of this search, sent back with RecordSearchClick.
+	SearchId string `protobuf:"bytes,3,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"`
+	// Corrected query, set only when this query returned no results.
+	DidYouMean    string `protobuf:"bytes,4,opt,name=did_you_mean,json=didYouMean,proto3" json:"did_you_mean,omitempty"`
+	NextCursor    string `protobuf:"bytes,5,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchReply) Reset() {
+	*x = SearchReply{}
+	mi := &file_fenzvideo_v1_search_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchReply) ProtoMessage() {}
+
+func (x *SearchReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_search_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchReply.ProtoReflect.Descriptor instead.
+func (*SearchReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_search_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SearchReply) GetVideos() []*VideoReply {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *SearchReply) GetTotal() int64 {
+	if x != nil && x.Total != nil {
+		return *x.Total
+	}
+	return 0
+}
+
+func (x *SearchReply) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
+func (x *SearchReply) GetDidYouMean() string {
+	if x != nil {
+		return x.DidYouMean
+	}
+	return ""
+}
+
+func (x *SearchReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type RecordSearchClickRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	SearchId string                 `protobuf:"bytes,1,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"`
+	VideoId  uint64                 `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	// Zero-based position of the clicked video in the result list.
+	Position      int32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordSearchClickRequest) Reset() {
+	*x = RecordSearchClickRequest{}
+	mi := &file_fenzvideo_v1_search_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordSearchClickRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSearchClickRequest) ProtoMessage() {}
+
+func (x *RecordSearchClickRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_search_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSearchClickRequest.ProtoReflect.Descriptor instead.
+func (*RecordSearchClickRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_search_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *RecordSearchClickRequest) GetSearchId() string {
+	if x != nil {
+		return x.SearchId
+	}
+	return ""
+}
+
+func (x *RecordSearchClickRequest) GetVideoId() uint64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *RecordSearchClickRequest) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type RecordSearchClickReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordSearchClickReply) Reset() {
+	*x = RecordSearchClickReply{}
+	mi := &file_fenzvideo_v1_search_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordSearchClickReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordSearchClickReply) ProtoMessage() {}
+
+func (x *RecordSearchClickReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_search_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordSearchClickReply.ProtoReflect.Descriptor instead.
+func (*RecordSearchClickReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_search_proto_rawDescGZIP(), []int{3}
+}
+
 var File_fenzvideo_v1_search_proto protoreflect.FileDescriptor
 
 const file_fenzvideo_v1_search_proto_rawDesc = "" +
 	"\n" +
-	"\x19fenzvideo/v1/search.proto\x12\ffenzvideo.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x18fenzvideo/v1/video.proto\"\xb8\x03\n" +
+	"\x19fenzvideo/v1/search.proto\x12\ffenzvideo.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x18fenzvideo/v1/video.proto\"\x83\x04\n" +
 	"\rSearchRequest\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\x12$\n" +
 	"\vcategory_id\x18\x02 \x01(\x04H\x00R\n" +
@@ -156,7 +349,10 @@ const file_fenzvideo_v1_search_proto_rawDesc = "" +
 	"accessType\x88\x01\x01\x12\x12\n" +
 	"\x04page\x18\t \x01(\x05R\x04page\x12\x1b\n" +
 	"\tpage_size\x18\n" +
-	" \x01(\x05R\bpageSizeB\x0e\n" +
+	" \x01(\x05R\bpageSize\x12\"\n" +
+	"\n" +
+	"session_id\x18\v \x01(\tH\aR\tsessionId\x88\x01\x01\x12\x16\n" +
+	"\x06cursor\x18\f \x01(\tR\x06cursorB\x0e\n" +
 	"\f_category_idB\x0f\n" +
 	"\r_min_durationB\x0f\n" +
 	"\r_max_durationB\f\n" +
@@ -166,9 +362,25 @@ const file_fenzvideo_v1_search_proto_rawDesc = "" +
 	"\b_date_toB\n" +
 	"\n" +
 	"\b_sort_byB\x0e\n" +
-	"\f_access_type2l\n" +
-	"\rSearchService\x12[\n" +
-	"\x06Search\x12\x1b.fenzvideo.v1.SearchRequest\x1a\x1c.fenzvideo.v1.VideoListReply\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/searchB\x1dZ\x1bbackend/api/fenzvideo/v1;v1b\x06proto3"
+	"\f_access_typeB\r\n" +
+	"\v_session_id\"\xc4\x01\n" +
+	"\vSearchReply\x120\n" +
+	"\x06videos\x18\x01 \x03(\v2\x18.fenzvideo.v1.VideoReplyR\x06videos\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x03H\x00R\x05total\x88\x01\x01\x12\x1b\n" +
+	"\tsearch_id\x18\x03 \x01(\tR\bsearchId\x12 \n" +
+	"\fdid_you_mean\x18\x04 \x01(\tR\n" +
+	"didYouMean\x12\x1f\n" +
+	"\vnext_cursor\x18\x05 \x01(\tR\n" +
+	"nextCursorB\b\n" +
+	"\x06_total\"n\n" +
+	"\x18RecordSearchClickRequest\x12\x1b\n" +
+	"\tsearch_id\x18\x01 \x01(\tR\bsearchId\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\x04R\avideoId\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\x05R\bposition\"\x18\n" +
+	"\x16RecordSearchClickReply2\xef\x01\n" +
+	"\rSearchService\x12X\n" +
+	"\x06Search\x12\x1b.fenzvideo.v1.SearchRequest\x1a\x19.fenzvideo.v1.SearchReply\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/api/v1/search\x12\x83\x01\n" +
+	"\x11RecordSearchClick\x12&.fenzvideo.v1.RecordSearchClickRequest\x1a$.fenzvideo.v1.RecordSearchClickReply\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/v1/search/clicksB\x1dZ\x1bbackend/api/fenzvideo/v1;v1b\x06proto3"
 
 var (
 	file_fenzvideo_v1_search_proto_rawDescOnce sync.Once
@@ -182,19 +394,25 @@ func file_fenzvideo_v1_search_proto_rawDescGZIP() []byte {
 	return file_fenzvideo_v1_search_proto_rawDescData
 }
 
-var file_fenzvideo_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_fenzvideo_v1_search_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_fenzvideo_v1_search_proto_goTypes = []any{
-	(*SearchRequest)(nil),  // 0: fenzvideo.v1.SearchRequest
-	(*VideoListReply)(nil), // 1: fenzvideo.v1.VideoListReply
+	(*SearchRequest)(nil),            // 0: fenzvideo.v1.SearchRequest
+	(*SearchReply)(nil),              // 1: fenzvideo.v1.SearchReply
+	(*RecordSearchClickRequest)(nil), // 2: fenzvideo.v1.RecordSearchClickRequest
+	(*RecordSearchClickReply)(nil),   // 3: fenzvideo.v1.RecordSearchClickReply
+	(*VideoReply)(nil),               // 4: fenzvideo.v1.VideoReply
 }
 var file_fenzvideo_v1_search_proto_depIdxs = []int32{
-	0, // 0: fenzvideo.v1.SearchService.Search:input_type -> fenzvideo.v1.SearchRequest
-	1, // 1: fenzvideo.v1.SearchService.Search:output_type -> fenzvideo.v1.VideoListReply
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	4, // 0: fenzvideo.v1.SearchReply.videos:type_name -> fenzvideo.v1.VideoReply
+	0, // 1: fenzvideo.v1.SearchService.Search:input_type -> fenzvideo.v1.SearchRequest
+	2, // 2: fenzvideo.v1.SearchService.RecordSearchClick:input_type -> fenzvideo.v1.RecordSearchClickRequest
+	1, // 3: fenzvideo.v1.SearchService.Search:output_type -> fenzvideo.v1.SearchReply
+	3, // 4: fenzvideo.v1.SearchService.RecordSearchClick:output_type -> fenzvideo.v1.RecordSearchClickReply
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_fenzvideo_v1_search_proto_init() }
@@ -204,13 +422,14 @@ func file_fenzvideo_v1_search_proto_init() {
 	}
 	file_fenzvideo_v1_video_proto_init()
 	file_fenzvideo_v1_search_proto_msgTypes[0].OneofWrappers = []any{}
+	file_fenzvideo_v1_search_proto_msgTypes[1].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fenzvideo_v1_search_proto_rawDesc), len(file_fenzvideo_v1_search_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
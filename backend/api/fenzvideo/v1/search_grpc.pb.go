@@ -19,14 +19,16 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SearchService_Search_FullMethodName = "/fenzvideo.v1.SearchService/Search"
+	SearchService_Search_FullMethodName            = "/fenzvideo.v1.SearchService/Search"
+	SearchService_RecordSearchClick_FullMethodName = "/fenzvideo.v1.SearchService/RecordSearchClick"
 )
 
 // SearchServiceClient is the client API for SearchService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SearchServiceClient interface {
-	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*VideoListReply, error)
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error)
+	RecordSearchClick(ctx context.Context, in *RecordSearchClickRequest, opts ...grpc.CallOption) (*RecordSearchClickReply, error)
 }
 
 type searchServiceClient struct {
@@ -37,9 +39,9 @@ func NewSearchServiceClient(cc grpc.ClientConnInterface) SearchServiceClient {
 	return &searchServiceClient{cc}
 }
 
-func (c *searchServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*VideoListReply, error) {
+func (c *searchServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	out := new(VideoListReply)
+	out := new(SearchReply)
 	err := c.cc.Invoke(ctx, SearchService_Search_FullMethodName, in, out, cOpts...)
 	if err != nil {
 		return nil, err
@@ -47,11 +49,22 @@ func (c *searchServiceClient) Search(ctx context.Context, in *SearchRequest, opt
 	return out, nil
 }
 
+func (c *searchServiceClient) RecordSearchClick(ctx context.Context, in *RecordSearchClickRequest, opts ...grpc.CallOption) (*RecordSearchClickReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordSearchClickReply)
+	err := c.cc.Invoke(ctx, SearchService_RecordSearchClick_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SearchServiceServer is the server API for SearchService service.
 // All implementations must embed UnimplementedSearchServiceServer
 // for forward compatibility.
 type SearchServiceServer interface {
-	Search(context.Context, *SearchRequest) (*VideoListReply, error)
+	Search(context.Context, *SearchRequest) (*SearchReply, error)
+	RecordSearchClick(context.Context, *RecordSearchClickRequest) (*RecordSearchClickReply, error)
 	mustEmbedUnimplementedSearchServiceServer()
 }
 
@@ -62,9 +75,12 @@ type SearchServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedSearchServiceServer struct{}
 
-func (UnimplementedSearchServiceServer) Search(context.Context, *SearchRequest) (*VideoListReply, error) {
+func (UnimplementedSearchServiceServer) Search(context.Context, *SearchRequest) (*SearchReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method Search not implemented")
 }
+func (UnimplementedSearchServiceServer) RecordSearchClick(context.Context, *RecordSearchClickRequest) (*RecordSearchClickReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordSearchClick not implemented")
+}
 func (UnimplementedSearchServiceServer) mustEmbedUnimplementedSearchServiceServer() {}
 func (UnimplementedSearchServiceServer) testEmbeddedByValue()                       {}
 
@@ -104,6 +120,24 @@ func _SearchService_Search_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SearchService_RecordSearchClick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordSearchClickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceServer).RecordSearchClick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchService_RecordSearchClick_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceServer).RecordSearchClick(ctx, req.(*RecordSearchClickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SearchService_ServiceDesc is the grpc.ServiceDesc for SearchService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -115,6 +149,10 @@ var SearchService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Search",
 			Handler:    _SearchService_Search_Handler,
 		},
+		{
+			MethodName: "RecordSearchClick",
+			Handler:    _SearchService_RecordSearchClick_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fenzvideo/v1/search.proto",
@@ -19,15 +19,18 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationSearchServiceRecordSearchClick = "/fenzvideo.v1.SearchService/RecordSearchClick"
 const OperationSearchServiceSearch = "/fenzvideo.v1.SearchService/Search"
 
 type SearchServiceHTTPServer interface {
-	Search(context.Context, *SearchRequest) (*VideoListReply, error)
+	RecordSearchClick(context.Context, *RecordSearchClickRequest) (*RecordSearchClickReply, error)
+	Search(context.Context, *SearchRequest) (*SearchReply, error)
 }
 
 func RegisterSearchServiceHTTPServer(s *http.Server, srv SearchServiceHTTPServer) {
 	r := s.Route("/")
 	r.GET("/api/v1/search", _SearchService_Search0_HTTP_Handler(srv))
+	r.POST("/api/v1/search/clicks", _SearchService_RecordSearchClick0_HTTP_Handler(srv))
 }
 
 func _SearchService_Search0_HTTP_Handler(srv SearchServiceHTTPServer) func(ctx http.Context) error {
@@ -44,13 +47,36 @@ func _SearchService_Search0_HTTP_Handler(srv SearchServiceHTTPServer) func(ctx h
 		if err != nil {
 			return err
 		}
-		reply := out.(*VideoListReply)
+		reply := out.(*SearchReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SearchService_RecordSearchClick0_HTTP_Handler(srv SearchServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RecordSearchClickRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSearchServiceRecordSearchClick)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RecordSearchClick(ctx, req.(*RecordSearchClickRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RecordSearchClickReply)
 		return ctx.Result(200, reply)
 	}
 }
 
 type SearchServiceHTTPClient interface {
-	Search(ctx context.Context, req *SearchRequest, opts ...http.CallOption) (rsp *VideoListReply, err error)
+	RecordSearchClick(ctx context.Context, req *RecordSearchClickRequest, opts ...http.CallOption) (rsp *RecordSearchClickReply, err error)
+	Search(ctx context.Context, req *SearchRequest, opts ...http.CallOption) (rsp *SearchReply, err error)
 }
 
 type SearchServiceHTTPClientImpl struct {
@@ -61,8 +87,21 @@ func NewSearchServiceHTTPClient(client *http.Client) SearchServiceHTTPClient {
 	return &SearchServiceHTTPClientImpl{client}
 }
 
-func (c *SearchServiceHTTPClientImpl) Search(ctx context.Context, in *SearchRequest, opts ...http.CallOption) (*VideoListReply, error) {
-	var out VideoListReply
+func (c *SearchServiceHTTPClientImpl) RecordSearchClick(ctx context.Context, in *RecordSearchClickRequest, opts ...http.CallOption) (*RecordSearchClickReply, error) {
+	var out RecordSearchClickReply
+	pattern := "/api/v1/search/clicks"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSearchServiceRecordSearchClick))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *SearchServiceHTTPClientImpl) Search(ctx context.Context, in *SearchRequest, opts ...http.CallOption) (*SearchReply, error) {
+	var out SearchReply
 	pattern := "/api/v1/search"
 	path := binding.EncodeURL(pattern, in, true)
 	opts = append(opts, http.Operation(OperationSearchServiceSearch))
@@ -10,6 +10,7 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -23,15 +24,24 @@ const (
 )
 
 type CreateVideoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	CategoryId    uint64                 `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	TagIds        []uint64               `protobuf:"varint,4,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
-	AccessTier    int32                  `protobuf:"varint,5,opt,name=access_tier,json=accessTier,proto3" json:"access_tier,omitempty"`
-	Duration      uint32                 `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
-	VideoUrl      string                 `protobuf:"bytes,7,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
-	ThumbnailUrl  *string                `protobuf:"bytes,8,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	CategoryId  uint64                 `protobuf:"varint,3,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	TagIds      []uint64               `protobuf:"varint,4,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	AccessTier  int32                  `protobuf:"varint,5,opt,name=access_tier,json=accessTier,proto3" json:"access_tier,omitempty"`
+	// Ignored when the server probes uploads; the file's real duration is used.
+	Duration uint32 `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	VideoUrl string `protobuf:"bytes,7,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
+	// An image the caller uploaded as a thumbnail.
+	ThumbnailUrl *string `protobuf:"bytes,8,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
+	// ID of a finished resumable upload; replaces video_url when set.
+	UploadId string `protobuf:"bytes,9,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	// published (default), unlisted or private.
+	Visibility string `protobuf:"bytes,10,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// RFC 3339 time to publish the video at; it stays private until then.
+	// Requires visibility to be unset or published.
+	PublishAt     *string `protobuf:"bytes,11,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -122,22 +132,117 @@ func (x *CreateVideoRequest) GetThumbnailUrl() string {
 	return ""
 }
 
+func (x *CreateVideoRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
+func (x *CreateVideoRequest) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *CreateVideoRequest) GetPublishAt() string {
+	if x != nil && x.PublishAt != nil {
+		return *x.PublishAt
+	}
+	return ""
+}
+
+type ReplaceVideoSourceRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Id       uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VideoUrl string                 `protobuf:"bytes,2,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
+	// ID of a finished resumable upload; replaces video_url when set.
+	UploadId      string `protobuf:"bytes,3,opt,name=upload_id,json=uploadId,proto3" json:"upload_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaceVideoSourceRequest) Reset() {
+	*x = ReplaceVideoSourceRequest{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaceVideoSourceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaceVideoSourceRequest) ProtoMessage() {}
+
+func (x *ReplaceVideoSourceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaceVideoSourceRequest.ProtoReflect.Descriptor instead.
+func (*ReplaceVideoSourceRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ReplaceVideoSourceRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ReplaceVideoSourceRequest) GetVideoUrl() string {
+	if x != nil {
+		return x.VideoUrl
+	}
+	return ""
+}
+
+func (x *ReplaceVideoSourceRequest) GetUploadId() string {
+	if x != nil {
+		return x.UploadId
+	}
+	return ""
+}
+
 type UpdateVideoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Description   *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
-	CategoryId    *uint64                `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
-	TagIds        []uint64               `protobuf:"varint,5,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
-	AccessTier    *int32                 `protobuf:"varint,6,opt,name=access_tier,json=accessTier,proto3,oneof" json:"access_tier,omitempty"`
-	ThumbnailUrl  *string                `protobuf:"bytes,7,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title       *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Description *string                `protobuf:"bytes,3,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	CategoryId  *uint64                `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3,oneof" json:"category_id,omitempty"`
+	TagIds      []uint64               `protobuf:"varint,5,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	AccessTier  *int32                 `protobuf:"varint,6,opt,name=access_tier,json=accessTier,proto3,oneof" json:"access_tier,omitempty"`
+	// An image the caller uploaded as a thumbnail, unless unchanged.
+	ThumbnailUrl *string `protobuf:"bytes,7,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof" json:"thumbnail_url,omitempty"`
+	// published, unlisted or private. Cancels a scheduled publish.
+	Visibility *string `protobuf:"bytes,8,opt,name=visibility,proto3,oneof" json:"visibility,omitempty"`
+	// RFC 3339 time to publish the video at, making it private until then;
+	// empty cancels the schedule. Cannot be combined with visibility.
+	PublishAt *string `protobuf:"bytes,9,opt,name=publish_at,json=publishAt,proto3,oneof" json:"publish_at,omitempty"`
+	// Fields to update, e.g. "description,tag_ids" in JSON; masked fields
+	// left out of the request are cleared. Without a mask, the fields set
+	// in the request and tag_ids if non-empty are updated.
+	UpdateMask *fieldmaskpb.FieldMask `protobuf:"bytes,10,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// Fails with VIDEO_VERSION_CONFLICT unless the video is still at this
+	// version. An If-Match header with the ETag does the same.
+	Version       uint64 `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateVideoRequest) Reset() {
 	*x = UpdateVideoRequest{}
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[1]
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -149,7 +254,7 @@ func (x *UpdateVideoRequest) String() string {
 func (*UpdateVideoRequest) ProtoMessage() {}
 
 func (x *UpdateVideoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[1]
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -162,7 +267,7 @@ func (x *UpdateVideoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateVideoRequest.ProtoReflect.Descriptor instead.
 func (*UpdateVideoRequest) Descriptor() ([]byte, []int) {
-	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{1}
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *UpdateVideoRequest) GetId() uint64 {
@@ -214,16 +319,47 @@ func (x *UpdateVideoRequest) GetThumbnailUrl() string {
 	return ""
 }
 
+func (x *UpdateVideoRequest) GetVisibility() string {
+	if x != nil && x.Visibility != nil {
+		return *x.Visibility
+	}
+	return ""
+}
+
+func (x *UpdateVideoRequest) GetPublishAt() string {
+	if x != nil && x.PublishAt != nil {
+		return *x.PublishAt
+	}
+	return ""
+}
+
+func (x *UpdateVideoRequest) GetUpdateMask() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.UpdateMask
+	}
+	return nil
+}
+
+func (x *UpdateVideoRequest) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
 type GetVideoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Token of a share link granting this video. The stream and chapters
+	// routes take it as the share_token query parameter too.
+	ShareToken    string `protobuf:"bytes,2,opt,name=share_token,json=shareToken,proto3" json:"share_token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetVideoRequest) Reset() {
 	*x = GetVideoRequest{}
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[2]
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -235,7 +371,7 @@ func (x *GetVideoRequest) String() string {
 func (*GetVideoRequest) ProtoMessage() {}
 
 func (x *GetVideoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[2]
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -248,7 +384,7 @@ func (x *GetVideoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVideoRequest.ProtoReflect.Descriptor instead.
 func (*GetVideoRequest) Descriptor() ([]byte, []int) {
-	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{2}
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetVideoRequest) GetId() uint64 {
@@ -258,6 +394,13 @@ func (x *GetVideoRequest) GetId() uint64 {
 	return 0
 }
 
+func (x *GetVideoRequest) GetShareToken() string {
+	if x != nil {
+		return x.ShareToken
+	}
+	return ""
+}
+
 type DeleteVideoRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -267,7 +410,7 @@ type DeleteVideoRequest struct {
 
 func (x *DeleteVideoRequest) Reset() {
 	*x = DeleteVideoRequest{}
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[3]
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -279,7 +422,7 @@ func (x *DeleteVideoRequest) String() string {
 func (*DeleteVideoRequest) ProtoMessage() {}
 
 func (x *DeleteVideoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[3]
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -292,7 +435,7 @@ func (x *DeleteVideoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVideoRequest.ProtoReflect.Descriptor instead.
 func (*DeleteVideoRequest) Descriptor() ([]byte, []int) {
-	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{3}
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *DeleteVideoRequest) GetId() uint64 {
@@ -311,7 +454,7 @@ type DeleteVideoReply struct {
 
 func (x *DeleteVideoReply) Reset() {
 	*x = DeleteVideoReply{}
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[4]
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -323,7 +466,7 @@ func (x *DeleteVideoReply) String() string {
 func (*DeleteVideoReply) ProtoMessage() {}
 
 func (x *DeleteVideoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[4]
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -336,7 +479,7 @@ func (x *DeleteVideoReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteVideoReply.ProtoReflect.Descriptor instead.
 func (*DeleteVideoReply) Descriptor() ([]byte, []int) {
-	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{4}
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *DeleteVideoReply) GetSuccess() bool {
@@ -346,6 +489,7 @@ func (x *DeleteVideoReply) GetSuccess() bool {
 	return false
 }
 
+// Switches visibility between published and private.
 type TogglePublishRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -356,7 +500,7 @@ type TogglePublishRequest struct {
 
 func (x *TogglePublishRequest) Reset() {
 	*x = TogglePublishRequest{}
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[5]
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -368,7 +512,7 @@ func (x *TogglePublishRequest) String() string {
 func (*TogglePublishRequest) ProtoMessage() {}
 
 func (x *TogglePublishRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[5]
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -381,7 +525,7 @@ func (x *TogglePublishRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TogglePublishRequest.ProtoReflect.Descriptor instead.
 func (*TogglePublishRequest) Descriptor() ([]byte, []int) {
-	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{5}
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *TogglePublishRequest) GetId() uint64 {
@@ -398,30 +542,34 @@ func (x *TogglePublishRequest) GetIsPublished() bool {
 	return false
 }
 
-type GetRecommendedRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	SessionId     *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+type VideoShare struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VideoId uint64                 `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	// Pass as share_token to GetVideo.
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// Empty if the link does not expire.
+	ExpiresAt     string `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     string `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRecommendedRequest) Reset() {
-	*x = GetRecommendedRequest{}
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[6]
+func (x *VideoShare) Reset() {
+	*x = VideoShare{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRecommendedRequest) String() string {
+func (x *VideoShare) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRecommendedRequest) ProtoMessage() {}
+func (*VideoShare) ProtoMessage() {}
 
-func (x *GetRecommendedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[6]
+func (x *VideoShare) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -432,68 +580,70 @@ func (x *GetRecommendedRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRecommendedRequest.ProtoReflect.Descriptor instead.
-func (*GetRecommendedRequest) Descriptor() ([]byte, []int) {
-	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use VideoShare.ProtoReflect.Descriptor instead.
+func (*VideoShare) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *GetRecommendedRequest) GetSessionId() string {
-	if x != nil && x.SessionId != nil {
-		return *x.SessionId
+func (x *VideoShare) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *GetRecommendedRequest) GetPage() int32 {
+func (x *VideoShare) GetVideoId() uint64 {
 	if x != nil {
-		return x.Page
+		return x.VideoId
 	}
 	return 0
 }
 
-func (x *GetRecommendedRequest) GetPageSize() int32 {
+func (x *VideoShare) GetToken() string {
 	if x != nil {
-		return x.PageSize
+		return x.Token
 	}
-	return 0
+	return ""
 }
 
-type VideoReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	CategoryId    uint64                 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
-	CategoryName  string                 `protobuf:"bytes,5,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
-	Title         string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
-	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
-	VideoUrl      string                 `protobuf:"bytes,8,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
-	ThumbnailUrl  string                 `protobuf:"bytes,9,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
-	Duration      uint32                 `protobuf:"varint,10,opt,name=duration,proto3" json:"duration,omitempty"`
-	Views         uint64                 `protobuf:"varint,11,opt,name=views,proto3" json:"views,omitempty"`
-	AccessTier    int32                  `protobuf:"varint,12,opt,name=access_tier,json=accessTier,proto3" json:"access_tier,omitempty"`
-	IsPublished   bool                   `protobuf:"varint,13,opt,name=is_published,json=isPublished,proto3" json:"is_published,omitempty"`
-	Tags          []*TagItem             `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+func (x *VideoShare) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+func (x *VideoShare) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type CreateVideoShareRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	VideoId uint64                 `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	// RFC 3339; omit for a link that works until revoked.
+	ExpiresAt     *string `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VideoReply) Reset() {
-	*x = VideoReply{}
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[7]
+func (x *CreateVideoShareRequest) Reset() {
+	*x = CreateVideoShareRequest{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VideoReply) String() string {
+func (x *CreateVideoShareRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VideoReply) ProtoMessage() {}
+func (*CreateVideoShareRequest) ProtoMessage() {}
 
-func (x *VideoReply) ProtoReflect() protoreflect.Message {
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[7]
+func (x *CreateVideoShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -504,100 +654,1360 @@ func (x *VideoReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VideoReply.ProtoReflect.Descriptor instead.
-func (*VideoReply) Descriptor() ([]byte, []int) {
-	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use CreateVideoShareRequest.ProtoReflect.Descriptor instead.
+func (*CreateVideoShareRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *VideoReply) GetId() uint64 {
+func (x *CreateVideoShareRequest) GetVideoId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.VideoId
 	}
 	return 0
 }
 
-func (x *VideoReply) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
+func (x *CreateVideoShareRequest) GetExpiresAt() string {
+	if x != nil && x.ExpiresAt != nil {
+		return *x.ExpiresAt
 	}
-	return 0
+	return ""
 }
 
-func (x *VideoReply) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+type ListVideoSharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       uint64                 `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VideoReply) GetCategoryId() uint64 {
-	if x != nil {
-		return x.CategoryId
-	}
-	return 0
+func (x *ListVideoSharesRequest) Reset() {
+	*x = ListVideoSharesRequest{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *VideoReply) GetCategoryName() string {
-	if x != nil {
-		return x.CategoryName
-	}
-	return ""
+func (x *ListVideoSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *VideoReply) GetTitle() string {
+func (*ListVideoSharesRequest) ProtoMessage() {}
+
+func (x *ListVideoSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[9]
 	if x != nil {
-		return x.Title
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *VideoReply) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
+// Deprecated: Use ListVideoSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListVideoSharesRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *VideoReply) GetVideoUrl() string {
+func (x *ListVideoSharesRequest) GetVideoId() uint64 {
 	if x != nil {
-		return x.VideoUrl
+		return x.VideoId
 	}
-	return ""
+	return 0
 }
 
-func (x *VideoReply) GetThumbnailUrl() string {
-	if x != nil {
-		return x.ThumbnailUrl
-	}
-	return ""
+type ListVideoSharesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        []*VideoShare          `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VideoReply) GetDuration() uint32 {
-	if x != nil {
-		return x.Duration
-	}
-	return 0
+func (x *ListVideoSharesReply) Reset() {
+	*x = ListVideoSharesReply{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *VideoReply) GetViews() uint64 {
-	if x != nil {
-		return x.Views
-	}
-	return 0
+func (x *ListVideoSharesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *VideoReply) GetAccessTier() int32 {
+func (*ListVideoSharesReply) ProtoMessage() {}
+
+func (x *ListVideoSharesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[10]
 	if x != nil {
-		return x.AccessTier
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *VideoReply) GetIsPublished() bool {
-	if x != nil {
-		return x.IsPublished
-	}
-	return false
+// Deprecated: Use ListVideoSharesReply.ProtoReflect.Descriptor instead.
+func (*ListVideoSharesReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListVideoSharesReply) GetShares() []*VideoShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type RevokeVideoShareRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       uint64                 `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Id            uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeVideoShareRequest) Reset() {
+	*x = RevokeVideoShareRequest{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeVideoShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeVideoShareRequest) ProtoMessage() {}
+
+func (x *RevokeVideoShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeVideoShareRequest.ProtoReflect.Descriptor instead.
+func (*RevokeVideoShareRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RevokeVideoShareRequest) GetVideoId() uint64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *RevokeVideoShareRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RevokeVideoShareReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeVideoShareReply) Reset() {
+	*x = RevokeVideoShareReply{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeVideoShareReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeVideoShareReply) ProtoMessage() {}
+
+func (x *RevokeVideoShareReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeVideoShareReply.ProtoReflect.Descriptor instead.
+func (*RevokeVideoShareReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{12}
+}
+
+// The metadata of a video after a change.
+type VideoRevision struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Id      uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	VideoId uint64                 `protobuf:"varint,2,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	// The video's version after the change.
+	Version uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	// 0 for changes made by the system, such as scheduled publishing.
+	ActorId   uint64 `protobuf:"varint,4,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	ActorName string `protobuf:"bytes,5,opt,name=actor_name,json=actorName,proto3" json:"actor_name,omitempty"`
+	// baseline, created, updated, restored, published, thumbnail or scheduled.
+	Action string `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	// The fields the change touched.
+	Fields []string `protobuf:"bytes,7,rep,name=fields,proto3" json:"fields,omitempty"`
+	// The revision a restore went back to.
+	RestoredFrom  uint64   `protobuf:"varint,8,opt,name=restored_from,json=restoredFrom,proto3" json:"restored_from,omitempty"`
+	Title         string   `protobuf:"bytes,9,opt,name=title,proto3" json:"title,omitempty"`
+	Description   string   `protobuf:"bytes,10,opt,name=description,proto3" json:"description,omitempty"`
+	CategoryId    uint64   `protobuf:"varint,11,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	TagIds        []uint64 `protobuf:"varint,12,rep,packed,name=tag_ids,json=tagIds,proto3" json:"tag_ids,omitempty"`
+	AccessTier    int32    `protobuf:"varint,13,opt,name=access_tier,json=accessTier,proto3" json:"access_tier,omitempty"`
+	ThumbnailUrl  string   `protobuf:"bytes,14,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	Visibility    string   `protobuf:"bytes,15,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	CreatedAt     string   `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoRevision) Reset() {
+	*x = VideoRevision{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoRevision) ProtoMessage() {}
+
+func (x *VideoRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoRevision.ProtoReflect.Descriptor instead.
+func (*VideoRevision) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VideoRevision) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VideoRevision) GetVideoId() uint64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *VideoRevision) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *VideoRevision) GetActorId() uint64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *VideoRevision) GetActorName() string {
+	if x != nil {
+		return x.ActorName
+	}
+	return ""
+}
+
+func (x *VideoRevision) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *VideoRevision) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *VideoRevision) GetRestoredFrom() uint64 {
+	if x != nil {
+		return x.RestoredFrom
+	}
+	return 0
+}
+
+func (x *VideoRevision) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *VideoRevision) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *VideoRevision) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *VideoRevision) GetTagIds() []uint64 {
+	if x != nil {
+		return x.TagIds
+	}
+	return nil
+}
+
+func (x *VideoRevision) GetAccessTier() int32 {
+	if x != nil {
+		return x.AccessTier
+	}
+	return 0
+}
+
+func (x *VideoRevision) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *VideoRevision) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *VideoRevision) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListVideoRevisionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VideoId       uint64                 `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVideoRevisionsRequest) Reset() {
+	*x = ListVideoRevisionsRequest{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVideoRevisionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVideoRevisionsRequest) ProtoMessage() {}
+
+func (x *ListVideoRevisionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVideoRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListVideoRevisionsRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListVideoRevisionsRequest) GetVideoId() uint64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *ListVideoRevisionsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListVideoRevisionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListVideoRevisionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*VideoRevision       `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVideoRevisionsReply) Reset() {
+	*x = ListVideoRevisionsReply{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVideoRevisionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVideoRevisionsReply) ProtoMessage() {}
+
+func (x *ListVideoRevisionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVideoRevisionsReply.ProtoReflect.Descriptor instead.
+func (*ListVideoRevisionsReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListVideoRevisionsReply) GetRevisions() []*VideoRevision {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListVideoRevisionsReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RestoreVideoRevisionRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	VideoId uint64                 `protobuf:"varint,1,opt,name=video_id,json=videoId,proto3" json:"video_id,omitempty"`
+	Id      uint64                 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// The version the caller last saw; 0 skips the check.
+	Version       uint64 `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreVideoRevisionRequest) Reset() {
+	*x = RestoreVideoRevisionRequest{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreVideoRevisionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreVideoRevisionRequest) ProtoMessage() {}
+
+func (x *RestoreVideoRevisionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreVideoRevisionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreVideoRevisionRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RestoreVideoRevisionRequest) GetVideoId() uint64 {
+	if x != nil {
+		return x.VideoId
+	}
+	return 0
+}
+
+func (x *RestoreVideoRevisionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RestoreVideoRevisionRequest) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+// A video in the trash.
+type DeletedVideo struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Video     *VideoReply            `protobuf:"bytes,1,opt,name=video,proto3" json:"video,omitempty"`
+	DeletedAt string                 `protobuf:"bytes,2,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+	// When the video and its files are removed for good.
+	PurgeAt       string `protobuf:"bytes,3,opt,name=purge_at,json=purgeAt,proto3" json:"purge_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletedVideo) Reset() {
+	*x = DeletedVideo{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletedVideo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletedVideo) ProtoMessage() {}
+
+func (x *DeletedVideo) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletedVideo.ProtoReflect.Descriptor instead.
+func (*DeletedVideo) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DeletedVideo) GetVideo() *VideoReply {
+	if x != nil {
+		return x.Video
+	}
+	return nil
+}
+
+func (x *DeletedVideo) GetDeletedAt() string {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return ""
+}
+
+func (x *DeletedVideo) GetPurgeAt() string {
+	if x != nil {
+		return x.PurgeAt
+	}
+	return ""
+}
+
+type ListDeletedVideosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedVideosRequest) Reset() {
+	*x = ListDeletedVideosRequest{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedVideosRequest) ProtoMessage() {}
+
+func (x *ListDeletedVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedVideosRequest.ProtoReflect.Descriptor instead.
+func (*ListDeletedVideosRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListDeletedVideosRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDeletedVideosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListDeletedVideosReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Videos        []*DeletedVideo        `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeletedVideosReply) Reset() {
+	*x = ListDeletedVideosReply{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeletedVideosReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeletedVideosReply) ProtoMessage() {}
+
+func (x *ListDeletedVideosReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeletedVideosReply.ProtoReflect.Descriptor instead.
+func (*ListDeletedVideosReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListDeletedVideosReply) GetVideos() []*DeletedVideo {
+	if x != nil {
+		return x.Videos
+	}
+	return nil
+}
+
+func (x *ListDeletedVideosReply) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type RestoreVideoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreVideoRequest) Reset() {
+	*x = RestoreVideoRequest{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreVideoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreVideoRequest) ProtoMessage() {}
+
+func (x *RestoreVideoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreVideoRequest.ProtoReflect.Descriptor instead.
+func (*RestoreVideoRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RestoreVideoRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ListScheduledVideosRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledVideosRequest) Reset() {
+	*x = ListScheduledVideosRequest{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledVideosRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledVideosRequest) ProtoMessage() {}
+
+func (x *ListScheduledVideosRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledVideosRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledVideosRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListScheduledVideosRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListScheduledVideosRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetRecommendedRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	SessionId *string                `protobuf:"bytes,1,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"`
+	Page      int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize  int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Opaque keyset cursor from a previous reply; overrides page when set.
+	Cursor        string `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRecommendedRequest) Reset() {
+	*x = GetRecommendedRequest{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRecommendedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecommendedRequest) ProtoMessage() {}
+
+func (x *GetRecommendedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecommendedRequest.ProtoReflect.Descriptor instead.
+func (*GetRecommendedRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetRecommendedRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *GetRecommendedRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetRecommendedRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetRecommendedRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type ListThumbnailCandidatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListThumbnailCandidatesRequest) Reset() {
+	*x = ListThumbnailCandidatesRequest{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListThumbnailCandidatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListThumbnailCandidatesRequest) ProtoMessage() {}
+
+func (x *ListThumbnailCandidatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListThumbnailCandidatesRequest.ProtoReflect.Descriptor instead.
+func (*ListThumbnailCandidatesRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListThumbnailCandidatesRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ThumbnailCandidate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url   string                 `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Where the frame was taken, in percent of the duration.
+	Position      uint32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThumbnailCandidate) Reset() {
+	*x = ThumbnailCandidate{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThumbnailCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThumbnailCandidate) ProtoMessage() {}
+
+func (x *ThumbnailCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThumbnailCandidate.ProtoReflect.Descriptor instead.
+func (*ThumbnailCandidate) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ThumbnailCandidate) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ThumbnailCandidate) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ThumbnailCandidate) GetPosition() uint32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type ListThumbnailCandidatesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candidates    []*ThumbnailCandidate  `protobuf:"bytes,1,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListThumbnailCandidatesReply) Reset() {
+	*x = ListThumbnailCandidatesReply{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListThumbnailCandidatesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListThumbnailCandidatesReply) ProtoMessage() {}
+
+func (x *ListThumbnailCandidatesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListThumbnailCandidatesReply.ProtoReflect.Descriptor instead.
+func (*ListThumbnailCandidatesReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListThumbnailCandidatesReply) GetCandidates() []*ThumbnailCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+type SetThumbnailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Types that are valid to be assigned to Source:
+	//
+	//	*SetThumbnailRequest_CandidateId
+	//	*SetThumbnailRequest_ThumbnailUrl
+	Source        isSetThumbnailRequest_Source `protobuf_oneof:"source"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetThumbnailRequest) Reset() {
+	*x = SetThumbnailRequest{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetThumbnailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetThumbnailRequest) ProtoMessage() {}
+
+func (x *SetThumbnailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetThumbnailRequest.ProtoReflect.Descriptor instead.
+func (*SetThumbnailRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetThumbnailRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetThumbnailRequest) GetSource() isSetThumbnailRequest_Source {
+	if x != nil {
+		return x.Source
+	}
+	return nil
+}
+
+func (x *SetThumbnailRequest) GetCandidateId() uint64 {
+	if x != nil {
+		if x, ok := x.Source.(*SetThumbnailRequest_CandidateId); ok {
+			return x.CandidateId
+		}
+	}
+	return 0
+}
+
+func (x *SetThumbnailRequest) GetThumbnailUrl() string {
+	if x != nil {
+		if x, ok := x.Source.(*SetThumbnailRequest_ThumbnailUrl); ok {
+			return x.ThumbnailUrl
+		}
+	}
+	return ""
+}
+
+type isSetThumbnailRequest_Source interface {
+	isSetThumbnailRequest_Source()
+}
+
+type SetThumbnailRequest_CandidateId struct {
+	// A frame from ListThumbnailCandidates.
+	CandidateId uint64 `protobuf:"varint,2,opt,name=candidate_id,json=candidateId,proto3,oneof"`
+}
+
+type SetThumbnailRequest_ThumbnailUrl struct {
+	// An image uploaded to /api/v1/upload/thumbnail.
+	ThumbnailUrl string `protobuf:"bytes,3,opt,name=thumbnail_url,json=thumbnailUrl,proto3,oneof"`
+}
+
+func (*SetThumbnailRequest_CandidateId) isSetThumbnailRequest_Source() {}
+
+func (*SetThumbnailRequest_ThumbnailUrl) isSetThumbnailRequest_Source() {}
+
+// Resized WebP copies of thumbnail_url (320, 640 and 1280px wide). Empty
+// until generated; fall back to thumbnail_url.
+type ThumbnailVariants struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Small         string                 `protobuf:"bytes,1,opt,name=small,proto3" json:"small,omitempty"`
+	Medium        string                 `protobuf:"bytes,2,opt,name=medium,proto3" json:"medium,omitempty"`
+	Large         string                 `protobuf:"bytes,3,opt,name=large,proto3" json:"large,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ThumbnailVariants) Reset() {
+	*x = ThumbnailVariants{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ThumbnailVariants) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThumbnailVariants) ProtoMessage() {}
+
+func (x *ThumbnailVariants) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThumbnailVariants.ProtoReflect.Descriptor instead.
+func (*ThumbnailVariants) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ThumbnailVariants) GetSmall() string {
+	if x != nil {
+		return x.Small
+	}
+	return ""
+}
+
+func (x *ThumbnailVariants) GetMedium() string {
+	if x != nil {
+		return x.Medium
+	}
+	return ""
+}
+
+func (x *ThumbnailVariants) GetLarge() string {
+	if x != nil {
+		return x.Large
+	}
+	return ""
+}
+
+type VideoReply struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId       uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username     string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	CategoryId   uint64                 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty"`
+	CategoryName string                 `protobuf:"bytes,5,opt,name=category_name,json=categoryName,proto3" json:"category_name,omitempty"`
+	Title        string                 `protobuf:"bytes,6,opt,name=title,proto3" json:"title,omitempty"`
+	Description  string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	// Short-lived signed playback URL. When issued to a signed-in viewer,
+	// every request for it (and for HLS segments under it) must carry that
+	// viewer's token, as the Authorization header or access_token.
+	VideoUrl     string `protobuf:"bytes,8,opt,name=video_url,json=videoUrl,proto3" json:"video_url,omitempty"`
+	ThumbnailUrl string `protobuf:"bytes,9,opt,name=thumbnail_url,json=thumbnailUrl,proto3" json:"thumbnail_url,omitempty"`
+	Duration     uint32 `protobuf:"varint,10,opt,name=duration,proto3" json:"duration,omitempty"`
+	Views        uint64 `protobuf:"varint,11,opt,name=views,proto3" json:"views,omitempty"`
+	AccessTier   int32  `protobuf:"varint,12,opt,name=access_tier,json=accessTier,proto3" json:"access_tier,omitempty"`
+	// True when visibility is published.
+	IsPublished bool       `protobuf:"varint,13,opt,name=is_published,json=isPublished,proto3" json:"is_published,omitempty"`
+	Tags        []*TagItem `protobuf:"bytes,14,rep,name=tags,proto3" json:"tags,omitempty"`
+	CreatedAt   string     `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Probed from the file on upload.
+	Width      uint32 `protobuf:"varint,16,opt,name=width,proto3" json:"width,omitempty"`
+	Height     uint32 `protobuf:"varint,17,opt,name=height,proto3" json:"height,omitempty"`
+	VideoCodec string `protobuf:"bytes,18,opt,name=video_codec,json=videoCodec,proto3" json:"video_codec,omitempty"`
+	AudioCodec string `protobuf:"bytes,19,opt,name=audio_codec,json=audioCodec,proto3" json:"audio_codec,omitempty"`
+	Bitrate    uint64 `protobuf:"varint,20,opt,name=bitrate,proto3" json:"bitrate,omitempty"`
+	FileSize   uint64 `protobuf:"varint,21,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	// none, pending, running, ready or failed. Once ready, GetVideo returns
+	// the HLS master playlist (.m3u8) as video_url instead of the original.
+	TranscodeStatus string `protobuf:"bytes,22,opt,name=transcode_status,json=transcodeStatus,proto3" json:"transcode_status,omitempty"`
+	// Lifecycle: processing, ready or failed. Only the owner sees
+	// a video before it is ready.
+	Status string `protobuf:"bytes,23,opt,name=status,proto3" json:"status,omitempty"`
+	// published, unlisted (playable by link, not listed) or private.
+	Visibility string `protobuf:"bytes,24,opt,name=visibility,proto3" json:"visibility,omitempty"`
+	// Percent done while processing.
+	ProcessingProgress uint32 `protobuf:"varint,25,opt,name=processing_progress,json=processingProgress,proto3" json:"processing_progress,omitempty"`
+	// Why processing failed. A ready video whose transcoding failed plays
+	// its original file and says why here.
+	ProcessingError   string             `protobuf:"bytes,26,opt,name=processing_error,json=processingError,proto3" json:"processing_error,omitempty"`
+	ThumbnailVariants *ThumbnailVariants `protobuf:"bytes,27,opt,name=thumbnail_variants,json=thumbnailVariants,proto3" json:"thumbnail_variants,omitempty"`
+	// Signed WebVTT thumbnails track (sprite_NNN.jpg#xywh=...) for hover
+	// previews while seeking. Empty until processed.
+	SeekPreviewUrl string `protobuf:"bytes,28,opt,name=seek_preview_url,json=seekPreviewUrl,proto3" json:"seek_preview_url,omitempty"`
+	// Caption tracks; only filled in by GetVideo.
+	Captions []*CaptionTrack `protobuf:"bytes,29,rep,name=captions,proto3" json:"captions,omitempty"`
+	// Chapters in order; only filled in by GetVideo and SetChapters.
+	Chapters []*Chapter `protobuf:"bytes,30,rep,name=chapters,proto3" json:"chapters,omitempty"`
+	// WebVTT chapters track (kind="chapters") when there are chapters. Like
+	// /api/v1/stream, it takes the viewer's token as access_token.
+	ChaptersUrl string `protobuf:"bytes,31,opt,name=chapters_url,json=chaptersUrl,proto3" json:"chapters_url,omitempty"`
+	// When the video is scheduled to be published; empty if it is not.
+	PublishAt string `protobuf:"bytes,32,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	// Incremented by every metadata change; pass it back as version.
+	Version uint64 `protobuf:"varint,33,opt,name=version,proto3" json:"version,omitempty"`
+	// A new source is being processed; until it is swapped in, the video
+	// plays its current one. processing_error says why a replacement failed.
+	Replacing     bool `protobuf:"varint,34,opt,name=replacing,proto3" json:"replacing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VideoReply) Reset() {
+	*x = VideoReply{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VideoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VideoReply) ProtoMessage() {}
+
+func (x *VideoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VideoReply.ProtoReflect.Descriptor instead.
+func (*VideoReply) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *VideoReply) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VideoReply) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VideoReply) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *VideoReply) GetCategoryId() uint64 {
+	if x != nil {
+		return x.CategoryId
+	}
+	return 0
+}
+
+func (x *VideoReply) GetCategoryName() string {
+	if x != nil {
+		return x.CategoryName
+	}
+	return ""
+}
+
+func (x *VideoReply) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *VideoReply) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *VideoReply) GetVideoUrl() string {
+	if x != nil {
+		return x.VideoUrl
+	}
+	return ""
+}
+
+func (x *VideoReply) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *VideoReply) GetDuration() uint32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *VideoReply) GetViews() uint64 {
+	if x != nil {
+		return x.Views
+	}
+	return 0
+}
+
+func (x *VideoReply) GetAccessTier() int32 {
+	if x != nil {
+		return x.AccessTier
+	}
+	return 0
+}
+
+func (x *VideoReply) GetIsPublished() bool {
+	if x != nil {
+		return x.IsPublished
+	}
+	return false
 }
 
 func (x *VideoReply) GetTags() []*TagItem {
@@ -614,17 +2024,257 @@ func (x *VideoReply) GetCreatedAt() string {
 	return ""
 }
 
-type VideoListReply struct {
+func (x *VideoReply) GetWidth() uint32 {
+	if x != nil {
+		return x.Width
+	}
+	return 0
+}
+
+func (x *VideoReply) GetHeight() uint32 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *VideoReply) GetVideoCodec() string {
+	if x != nil {
+		return x.VideoCodec
+	}
+	return ""
+}
+
+func (x *VideoReply) GetAudioCodec() string {
+	if x != nil {
+		return x.AudioCodec
+	}
+	return ""
+}
+
+func (x *VideoReply) GetBitrate() uint64 {
+	if x != nil {
+		return x.Bitrate
+	}
+	return 0
+}
+
+func (x *VideoReply) GetFileSize() uint64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *VideoReply) GetTranscodeStatus() string {
+	if x != nil {
+		return x.TranscodeStatus
+	}
+	return ""
+}
+
+func (x *VideoReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *VideoReply) GetVisibility() string {
+	if x != nil {
+		return x.Visibility
+	}
+	return ""
+}
+
+func (x *VideoReply) GetProcessingProgress() uint32 {
+	if x != nil {
+		return x.ProcessingProgress
+	}
+	return 0
+}
+
+func (x *VideoReply) GetProcessingError() string {
+	if x != nil {
+		return x.ProcessingError
+	}
+	return ""
+}
+
+func (x *VideoReply) GetThumbnailVariants() *ThumbnailVariants {
+	if x != nil {
+		return x.ThumbnailVariants
+	}
+	return nil
+}
+
+func (x *VideoReply) GetSeekPreviewUrl() string {
+	if x != nil {
+		return x.SeekPreviewUrl
+	}
+	return ""
+}
+
+func (x *VideoReply) GetCaptions() []*CaptionTrack {
+	if x != nil {
+		return x.Captions
+	}
+	return nil
+}
+
+func (x *VideoReply) GetChapters() []*Chapter {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+func (x *VideoReply) GetChaptersUrl() string {
+	if x != nil {
+		return x.ChaptersUrl
+	}
+	return ""
+}
+
+func (x *VideoReply) GetPublishAt() string {
+	if x != nil {
+		return x.PublishAt
+	}
+	return ""
+}
+
+func (x *VideoReply) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *VideoReply) GetReplacing() bool {
+	if x != nil {
+		return x.Replacing
+	}
+	return false
+}
+
+type Chapter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Seconds from the start of the video; the first chapter starts at 0.
+	Start         uint32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	Title         string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Chapter) Reset() {
+	*x = Chapter{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Chapter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Chapter) ProtoMessage() {}
+
+func (x *Chapter) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Chapter.ProtoReflect.Descriptor instead.
+func (*Chapter) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Chapter) GetStart() uint32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *Chapter) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type SetChaptersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Videos        []*VideoReply          `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
-	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Chapters      []*Chapter             `protobuf:"bytes,2,rep,name=chapters,proto3" json:"chapters,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetChaptersRequest) Reset() {
+	*x = SetChaptersRequest{}
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetChaptersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetChaptersRequest) ProtoMessage() {}
+
+func (x *SetChaptersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetChaptersRequest.ProtoReflect.Descriptor instead.
+func (*SetChaptersRequest) Descriptor() ([]byte, []int) {
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetChaptersRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SetChaptersRequest) GetChapters() []*Chapter {
+	if x != nil {
+		return x.Chapters
+	}
+	return nil
+}
+
+type VideoListReply struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Videos []*VideoReply          `protobuf:"bytes,1,rep,name=videos,proto3" json:"videos,omitempty"`
+	// Omitted when paging by cursor.
+	Total         *int64 `protobuf:"varint,2,opt,name=total,proto3,oneof" json:"total,omitempty"`
+	NextCursor    string `protobuf:"bytes,3,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *VideoListReply) Reset() {
 	*x = VideoListReply{}
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[8]
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -636,7 +2286,7 @@ func (x *VideoListReply) String() string {
 func (*VideoListReply) ProtoMessage() {}
 
 func (x *VideoListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_fenzvideo_v1_video_proto_msgTypes[8]
+	mi := &file_fenzvideo_v1_video_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -649,7 +2299,7 @@ func (x *VideoListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use VideoListReply.ProtoReflect.Descriptor instead.
 func (*VideoListReply) Descriptor() ([]byte, []int) {
-	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{8}
+	return file_fenzvideo_v1_video_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *VideoListReply) GetVideos() []*VideoReply {
@@ -660,17 +2310,24 @@ func (x *VideoListReply) GetVideos() []*VideoReply {
 }
 
 func (x *VideoListReply) GetTotal() int64 {
-	if x != nil {
-		return x.Total
+	if x != nil && x.Total != nil {
+		return *x.Total
 	}
 	return 0
 }
 
+func (x *VideoListReply) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 var File_fenzvideo_v1_video_proto protoreflect.FileDescriptor
 
 const file_fenzvideo_v1_video_proto_rawDesc = "" +
 	"\n" +
-	"\x18fenzvideo/v1/video.proto\x12\ffenzvideo.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x16fenzvideo/v1/tag.proto\"\xb1\x02\n" +
+	"\x18fenzvideo/v1/video.proto\x12\ffenzvideo.v1\x1a\x1cgoogle/api/annotations.proto\x1a google/protobuf/field_mask.proto\x1a\x16fenzvideo/v1/tag.proto\x1a\x1afenzvideo/v1/caption.proto\"\xa1\x03\n" +
 	"\x12CreateVideoRequest\x12\x14\n" +
 	"\x05title\x18\x01 \x01(\tR\x05title\x12%\n" +
 	"\vdescription\x18\x02 \x01(\tH\x00R\vdescription\x88\x01\x01\x12\x1f\n" +
@@ -681,9 +2338,21 @@ const file_fenzvideo_v1_video_proto_rawDesc = "" +
 	"accessTier\x12\x1a\n" +
 	"\bduration\x18\x06 \x01(\rR\bduration\x12\x1b\n" +
 	"\tvideo_url\x18\a \x01(\tR\bvideoUrl\x12(\n" +
-	"\rthumbnail_url\x18\b \x01(\tH\x01R\fthumbnailUrl\x88\x01\x01B\x0e\n" +
+	"\rthumbnail_url\x18\b \x01(\tH\x01R\fthumbnailUrl\x88\x01\x01\x12\x1b\n" +
+	"\tupload_id\x18\t \x01(\tR\buploadId\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\n" +
+	" \x01(\tR\n" +
+	"visibility\x12\"\n" +
+	"\n" +
+	"publish_at\x18\v \x01(\tH\x02R\tpublishAt\x88\x01\x01B\x0e\n" +
 	"\f_descriptionB\x10\n" +
-	"\x0e_thumbnail_url\"\xc1\x02\n" +
+	"\x0e_thumbnail_urlB\r\n" +
+	"\v_publish_at\"e\n" +
+	"\x19ReplaceVideoSourceRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1b\n" +
+	"\tvideo_url\x18\x02 \x01(\tR\bvideoUrl\x12\x1b\n" +
+	"\tupload_id\x18\x03 \x01(\tR\buploadId\"\xff\x03\n" +
 	"\x12UpdateVideoRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
 	"\x05title\x18\x02 \x01(\tH\x00R\x05title\x88\x01\x01\x12%\n" +
@@ -693,27 +2362,133 @@ const file_fenzvideo_v1_video_proto_rawDesc = "" +
 	"\atag_ids\x18\x05 \x03(\x04R\x06tagIds\x12$\n" +
 	"\vaccess_tier\x18\x06 \x01(\x05H\x03R\n" +
 	"accessTier\x88\x01\x01\x12(\n" +
-	"\rthumbnail_url\x18\a \x01(\tH\x04R\fthumbnailUrl\x88\x01\x01B\b\n" +
+	"\rthumbnail_url\x18\a \x01(\tH\x04R\fthumbnailUrl\x88\x01\x01\x12#\n" +
+	"\n" +
+	"visibility\x18\b \x01(\tH\x05R\n" +
+	"visibility\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"publish_at\x18\t \x01(\tH\x06R\tpublishAt\x88\x01\x01\x12;\n" +
+	"\vupdate_mask\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.FieldMaskR\n" +
+	"updateMask\x12\x18\n" +
+	"\aversion\x18\v \x01(\x04R\aversionB\b\n" +
 	"\x06_titleB\x0e\n" +
 	"\f_descriptionB\x0e\n" +
 	"\f_category_idB\x0e\n" +
 	"\f_access_tierB\x10\n" +
-	"\x0e_thumbnail_url\"!\n" +
+	"\x0e_thumbnail_urlB\r\n" +
+	"\v_visibilityB\r\n" +
+	"\v_publish_at\"B\n" +
 	"\x0fGetVideoRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x04R\x02id\"$\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1f\n" +
+	"\vshare_token\x18\x02 \x01(\tR\n" +
+	"shareToken\"$\n" +
 	"\x12DeleteVideoRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\",\n" +
 	"\x10DeleteVideoReply\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"I\n" +
 	"\x14TogglePublishRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12!\n" +
-	"\fis_published\x18\x02 \x01(\bR\visPublished\"{\n" +
+	"\fis_published\x18\x02 \x01(\bR\visPublished\"\x8b\x01\n" +
+	"\n" +
+	"VideoShare\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\x04R\avideoId\x12\x14\n" +
+	"\x05token\x18\x03 \x01(\tR\x05token\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x04 \x01(\tR\texpiresAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x05 \x01(\tR\tcreatedAt\"g\n" +
+	"\x17CreateVideoShareRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\x04R\avideoId\x12\"\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\tH\x00R\texpiresAt\x88\x01\x01B\r\n" +
+	"\v_expires_at\"3\n" +
+	"\x16ListVideoSharesRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\x04R\avideoId\"H\n" +
+	"\x14ListVideoSharesReply\x120\n" +
+	"\x06shares\x18\x01 \x03(\v2\x18.fenzvideo.v1.VideoShareR\x06shares\"D\n" +
+	"\x17RevokeVideoShareRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\x04R\avideoId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\"\x17\n" +
+	"\x15RevokeVideoShareReply\"\xda\x03\n" +
+	"\rVideoRevision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
+	"\bvideo_id\x18\x02 \x01(\x04R\avideoId\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x04R\aversion\x12\x19\n" +
+	"\bactor_id\x18\x04 \x01(\x04R\aactorId\x12\x1d\n" +
+	"\n" +
+	"actor_name\x18\x05 \x01(\tR\tactorName\x12\x16\n" +
+	"\x06action\x18\x06 \x01(\tR\x06action\x12\x16\n" +
+	"\x06fields\x18\a \x03(\tR\x06fields\x12#\n" +
+	"\rrestored_from\x18\b \x01(\x04R\frestoredFrom\x12\x14\n" +
+	"\x05title\x18\t \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\n" +
+	" \x01(\tR\vdescription\x12\x1f\n" +
+	"\vcategory_id\x18\v \x01(\x04R\n" +
+	"categoryId\x12\x17\n" +
+	"\atag_ids\x18\f \x03(\x04R\x06tagIds\x12\x1f\n" +
+	"\vaccess_tier\x18\r \x01(\x05R\n" +
+	"accessTier\x12#\n" +
+	"\rthumbnail_url\x18\x0e \x01(\tR\fthumbnailUrl\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x0f \x01(\tR\n" +
+	"visibility\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\tR\tcreatedAt\"g\n" +
+	"\x19ListVideoRevisionsRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\x04R\avideoId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"j\n" +
+	"\x17ListVideoRevisionsReply\x129\n" +
+	"\trevisions\x18\x01 \x03(\v2\x1b.fenzvideo.v1.VideoRevisionR\trevisions\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"b\n" +
+	"\x1bRestoreVideoRevisionRequest\x12\x19\n" +
+	"\bvideo_id\x18\x01 \x01(\x04R\avideoId\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x04R\x02id\x12\x18\n" +
+	"\aversion\x18\x03 \x01(\x04R\aversion\"x\n" +
+	"\fDeletedVideo\x12.\n" +
+	"\x05video\x18\x01 \x01(\v2\x18.fenzvideo.v1.VideoReplyR\x05video\x12\x1d\n" +
+	"\n" +
+	"deleted_at\x18\x02 \x01(\tR\tdeletedAt\x12\x19\n" +
+	"\bpurge_at\x18\x03 \x01(\tR\apurgeAt\"K\n" +
+	"\x18ListDeletedVideosRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"b\n" +
+	"\x16ListDeletedVideosReply\x122\n" +
+	"\x06videos\x18\x01 \x03(\v2\x1a.fenzvideo.v1.DeletedVideoR\x06videos\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"%\n" +
+	"\x13RestoreVideoRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"M\n" +
+	"\x1aListScheduledVideosRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\x93\x01\n" +
 	"\x15GetRecommendedRequest\x12\"\n" +
 	"\n" +
 	"session_id\x18\x01 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSizeB\r\n" +
-	"\v_session_id\"\xd1\x03\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursorB\r\n" +
+	"\v_session_id\"0\n" +
+	"\x1eListThumbnailCandidatesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\"R\n" +
+	"\x12ThumbnailCandidate\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
+	"\x03url\x18\x02 \x01(\tR\x03url\x12\x1a\n" +
+	"\bposition\x18\x03 \x01(\rR\bposition\"`\n" +
+	"\x1cListThumbnailCandidatesReply\x12@\n" +
+	"\n" +
+	"candidates\x18\x01 \x03(\v2 .fenzvideo.v1.ThumbnailCandidateR\n" +
+	"candidates\"{\n" +
+	"\x13SetThumbnailRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12#\n" +
+	"\fcandidate_id\x18\x02 \x01(\x04H\x00R\vcandidateId\x12%\n" +
+	"\rthumbnail_url\x18\x03 \x01(\tH\x00R\fthumbnailUrlB\b\n" +
+	"\x06source\"W\n" +
+	"\x11ThumbnailVariants\x12\x14\n" +
+	"\x05small\x18\x01 \x01(\tR\x05small\x12\x16\n" +
+	"\x06medium\x18\x02 \x01(\tR\x06medium\x12\x14\n" +
+	"\x05large\x18\x03 \x01(\tR\x05large\"\x96\t\n" +
 	"\n" +
 	"VideoReply\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
@@ -734,17 +2509,62 @@ const file_fenzvideo_v1_video_proto_rawDesc = "" +
 	"\fis_published\x18\r \x01(\bR\visPublished\x12)\n" +
 	"\x04tags\x18\x0e \x03(\v2\x15.fenzvideo.v1.TagItemR\x04tags\x12\x1d\n" +
 	"\n" +
-	"created_at\x18\x0f \x01(\tR\tcreatedAt\"X\n" +
+	"created_at\x18\x0f \x01(\tR\tcreatedAt\x12\x14\n" +
+	"\x05width\x18\x10 \x01(\rR\x05width\x12\x16\n" +
+	"\x06height\x18\x11 \x01(\rR\x06height\x12\x1f\n" +
+	"\vvideo_codec\x18\x12 \x01(\tR\n" +
+	"videoCodec\x12\x1f\n" +
+	"\vaudio_codec\x18\x13 \x01(\tR\n" +
+	"audioCodec\x12\x18\n" +
+	"\abitrate\x18\x14 \x01(\x04R\abitrate\x12\x1b\n" +
+	"\tfile_size\x18\x15 \x01(\x04R\bfileSize\x12)\n" +
+	"\x10transcode_status\x18\x16 \x01(\tR\x0ftranscodeStatus\x12\x16\n" +
+	"\x06status\x18\x17 \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"visibility\x18\x18 \x01(\tR\n" +
+	"visibility\x12/\n" +
+	"\x13processing_progress\x18\x19 \x01(\rR\x12processingProgress\x12)\n" +
+	"\x10processing_error\x18\x1a \x01(\tR\x0fprocessingError\x12N\n" +
+	"\x12thumbnail_variants\x18\x1b \x01(\v2\x1f.fenzvideo.v1.ThumbnailVariantsR\x11thumbnailVariants\x12(\n" +
+	"\x10seek_preview_url\x18\x1c \x01(\tR\x0eseekPreviewUrl\x126\n" +
+	"\bcaptions\x18\x1d \x03(\v2\x1a.fenzvideo.v1.CaptionTrackR\bcaptions\x121\n" +
+	"\bchapters\x18\x1e \x03(\v2\x15.fenzvideo.v1.ChapterR\bchapters\x12!\n" +
+	"\fchapters_url\x18\x1f \x01(\tR\vchaptersUrl\x12\x1d\n" +
+	"\n" +
+	"publish_at\x18  \x01(\tR\tpublishAt\x12\x18\n" +
+	"\aversion\x18! \x01(\x04R\aversion\x12\x1c\n" +
+	"\treplacing\x18\" \x01(\bR\treplacing\"5\n" +
+	"\aChapter\x12\x14\n" +
+	"\x05start\x18\x01 \x01(\rR\x05start\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\"W\n" +
+	"\x12SetChaptersRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x121\n" +
+	"\bchapters\x18\x02 \x03(\v2\x15.fenzvideo.v1.ChapterR\bchapters\"\x88\x01\n" +
 	"\x0eVideoListReply\x120\n" +
-	"\x06videos\x18\x01 \x03(\v2\x18.fenzvideo.v1.VideoReplyR\x06videos\x12\x14\n" +
-	"\x05total\x18\x02 \x01(\x03R\x05total2\x98\x05\n" +
+	"\x06videos\x18\x01 \x03(\v2\x18.fenzvideo.v1.VideoReplyR\x06videos\x12\x19\n" +
+	"\x05total\x18\x02 \x01(\x03H\x00R\x05total\x88\x01\x01\x12\x1f\n" +
+	"\vnext_cursor\x18\x03 \x01(\tR\n" +
+	"nextCursorB\b\n" +
+	"\x06_total2\xf4\x11\n" +
 	"\fVideoService\x12d\n" +
 	"\vCreateVideo\x12 .fenzvideo.v1.CreateVideoRequest\x1a\x18.fenzvideo.v1.VideoReply\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/api/v1/videos\x12`\n" +
 	"\bGetVideo\x12\x1d.fenzvideo.v1.GetVideoRequest\x1a\x18.fenzvideo.v1.VideoReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/videos/{id}\x12i\n" +
 	"\vUpdateVideo\x12 .fenzvideo.v1.UpdateVideoRequest\x1a\x18.fenzvideo.v1.VideoReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/api/v1/videos/{id}\x12l\n" +
-	"\vDeleteVideo\x12 .fenzvideo.v1.DeleteVideoRequest\x1a\x1e.fenzvideo.v1.DeleteVideoReply\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/videos/{id}\x12u\n" +
-	"\rTogglePublish\x12\".fenzvideo.v1.TogglePublishRequest\x1a\x18.fenzvideo.v1.VideoReply\"&\x82\xd3\xe4\x93\x02 :\x01*2\x1b/api/v1/videos/{id}/publish\x12p\n" +
-	"\x0eGetRecommended\x12#.fenzvideo.v1.GetRecommendedRequest\x1a\x1c.fenzvideo.v1.VideoListReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/recommendedB\x1dZ\x1bbackend/api/fenzvideo/v1;v1b\x06proto3"
+	"\vDeleteVideo\x12 .fenzvideo.v1.DeleteVideoRequest\x1a\x1e.fenzvideo.v1.DeleteVideoReply\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/api/v1/videos/{id}\x12\x82\x01\n" +
+	"\x11ListDeletedVideos\x12&.fenzvideo.v1.ListDeletedVideosRequest\x1a$.fenzvideo.v1.ListDeletedVideosReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/v1/videos/my/trash\x12s\n" +
+	"\fRestoreVideo\x12!.fenzvideo.v1.RestoreVideoRequest\x1a\x18.fenzvideo.v1.VideoReply\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/v1/videos/{id}/restore\x12~\n" +
+	"\x12ReplaceVideoSource\x12'.fenzvideo.v1.ReplaceVideoSourceRequest\x1a\x18.fenzvideo.v1.VideoReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\x1a\x1a/api/v1/videos/{id}/source\x12u\n" +
+	"\rTogglePublish\x12\".fenzvideo.v1.TogglePublishRequest\x1a\x18.fenzvideo.v1.VideoReply\"&\x82\xd3\xe4\x93\x02 :\x01*2\x1b/api/v1/videos/{id}/publish\x12\x80\x01\n" +
+	"\x10CreateVideoShare\x12%.fenzvideo.v1.CreateVideoShareRequest\x1a\x18.fenzvideo.v1.VideoShare\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/v1/videos/{video_id}/shares\x12\x85\x01\n" +
+	"\x0fListVideoShares\x12$.fenzvideo.v1.ListVideoSharesRequest\x1a\".fenzvideo.v1.ListVideoSharesReply\"(\x82\xd3\xe4\x93\x02\"\x12 /api/v1/videos/{video_id}/shares\x12\x8d\x01\n" +
+	"\x10RevokeVideoShare\x12%.fenzvideo.v1.RevokeVideoShareRequest\x1a#.fenzvideo.v1.RevokeVideoShareReply\"-\x82\xd3\xe4\x93\x02'*%/api/v1/videos/{video_id}/shares/{id}\x12\x91\x01\n" +
+	"\x12ListVideoRevisions\x12'.fenzvideo.v1.ListVideoRevisionsRequest\x1a%.fenzvideo.v1.ListVideoRevisionsReply\"+\x82\xd3\xe4\x93\x02%\x12#/api/v1/videos/{video_id}/revisions\x12\x98\x01\n" +
+	"\x14RestoreVideoRevision\x12).fenzvideo.v1.RestoreVideoRevisionRequest\x1a\x18.fenzvideo.v1.VideoReply\";\x82\xd3\xe4\x93\x025:\x01*\"0/api/v1/videos/{video_id}/revisions/{id}/restore\x12\x82\x01\n" +
+	"\x13ListScheduledVideos\x12(.fenzvideo.v1.ListScheduledVideosRequest\x1a\x1c.fenzvideo.v1.VideoListReply\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/api/v1/videos/my/scheduled\x12p\n" +
+	"\x0eGetRecommended\x12#.fenzvideo.v1.GetRecommendedRequest\x1a\x1c.fenzvideo.v1.VideoListReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/v1/recommended\x12\xa5\x01\n" +
+	"\x17ListThumbnailCandidates\x12,.fenzvideo.v1.ListThumbnailCandidatesRequest\x1a*.fenzvideo.v1.ListThumbnailCandidatesReply\"0\x82\xd3\xe4\x93\x02*\x12(/api/v1/videos/{id}/thumbnail-candidates\x12u\n" +
+	"\fSetThumbnail\x12!.fenzvideo.v1.SetThumbnailRequest\x1a\x18.fenzvideo.v1.VideoReply\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/api/v1/videos/{id}/thumbnail\x12r\n" +
+	"\vSetChapters\x12 .fenzvideo.v1.SetChaptersRequest\x1a\x18.fenzvideo.v1.VideoReply\"'\x82\xd3\xe4\x93\x02!:\x01*\x1a\x1c/api/v1/videos/{id}/chaptersB\x1dZ\x1bbackend/api/fenzvideo/v1;v1b\x06proto3"
 
 var (
 	file_fenzvideo_v1_video_proto_rawDescOnce sync.Once
@@ -758,39 +2578,98 @@ func file_fenzvideo_v1_video_proto_rawDescGZIP() []byte {
 	return file_fenzvideo_v1_video_proto_rawDescData
 }
 
-var file_fenzvideo_v1_video_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_fenzvideo_v1_video_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_fenzvideo_v1_video_proto_goTypes = []any{
-	(*CreateVideoRequest)(nil),    // 0: fenzvideo.v1.CreateVideoRequest
-	(*UpdateVideoRequest)(nil),    // 1: fenzvideo.v1.UpdateVideoRequest
-	(*GetVideoRequest)(nil),       // 2: fenzvideo.v1.GetVideoRequest
-	(*DeleteVideoRequest)(nil),    // 3: fenzvideo.v1.DeleteVideoRequest
-	(*DeleteVideoReply)(nil),      // 4: fenzvideo.v1.DeleteVideoReply
-	(*TogglePublishRequest)(nil),  // 5: fenzvideo.v1.TogglePublishRequest
-	(*GetRecommendedRequest)(nil), // 6: fenzvideo.v1.GetRecommendedRequest
-	(*VideoReply)(nil),            // 7: fenzvideo.v1.VideoReply
-	(*VideoListReply)(nil),        // 8: fenzvideo.v1.VideoListReply
-	(*TagItem)(nil),               // 9: fenzvideo.v1.TagItem
+	(*CreateVideoRequest)(nil),             // 0: fenzvideo.v1.CreateVideoRequest
+	(*ReplaceVideoSourceRequest)(nil),      // 1: fenzvideo.v1.ReplaceVideoSourceRequest
+	(*UpdateVideoRequest)(nil),             // 2: fenzvideo.v1.UpdateVideoRequest
+	(*GetVideoRequest)(nil),                // 3: fenzvideo.v1.GetVideoRequest
+	(*DeleteVideoRequest)(nil),             // 4: fenzvideo.v1.DeleteVideoRequest
+	(*DeleteVideoReply)(nil),               // 5: fenzvideo.v1.DeleteVideoReply
+	(*TogglePublishRequest)(nil),           // 6: fenzvideo.v1.TogglePublishRequest
+	(*VideoShare)(nil),                     // 7: fenzvideo.v1.VideoShare
+	(*CreateVideoShareRequest)(nil),        // 8: fenzvideo.v1.CreateVideoShareRequest
+	(*ListVideoSharesRequest)(nil),         // 9: fenzvideo.v1.ListVideoSharesRequest
+	(*ListVideoSharesReply)(nil),           // 10: fenzvideo.v1.ListVideoSharesReply
+	(*RevokeVideoShareRequest)(nil),        // 11: fenzvideo.v1.RevokeVideoShareRequest
+	(*RevokeVideoShareReply)(nil),          // 12: fenzvideo.v1.RevokeVideoShareReply
+	(*VideoRevision)(nil),                  // 13: fenzvideo.v1.VideoRevision
+	(*ListVideoRevisionsRequest)(nil),      // 14: fenzvideo.v1.ListVideoRevisionsRequest
+	(*ListVideoRevisionsReply)(nil),        // 15: fenzvideo.v1.ListVideoRevisionsReply
+	(*RestoreVideoRevisionRequest)(nil),    // 16: fenzvideo.v1.RestoreVideoRevisionRequest
+	(*DeletedVideo)(nil),                   // 17: fenzvideo.v1.DeletedVideo
+	(*ListDeletedVideosRequest)(nil),       // 18: fenzvideo.v1.ListDeletedVideosRequest
+	(*ListDeletedVideosReply)(nil),         // 19: fenzvideo.v1.ListDeletedVideosReply
+	(*RestoreVideoRequest)(nil),            // 20: fenzvideo.v1.RestoreVideoRequest
+	(*ListScheduledVideosRequest)(nil),     // 21: fenzvideo.v1.ListScheduledVideosRequest
+	(*GetRecommendedRequest)(nil),          // 22: fenzvideo.v1.GetRecommendedRequest
+	(*ListThumbnailCandidatesRequest)(nil), // 23: fenzvideo.v1.ListThumbnailCandidatesRequest
+	(*ThumbnailCandidate)(nil),             // 24: fenzvideo.v1.ThumbnailCandidate
+	(*ListThumbnailCandidatesReply)(nil),   // 25: fenzvideo.v1.ListThumbnailCandidatesReply
+	(*SetThumbnailRequest)(nil),            // 26: fenzvideo.v1.SetThumbnailRequest
+	(*ThumbnailVariants)(nil),              // 27: fenzvideo.v1.ThumbnailVariants
+	(*VideoReply)(nil),                     // 28: fenzvideo.v1.VideoReply
+	(*Chapter)(nil),                        // 29: fenzvideo.v1.Chapter
+	(*SetChaptersRequest)(nil),             // 30: fenzvideo.v1.SetChaptersRequest
+	(*VideoListReply)(nil),                 // 31: fenzvideo.v1.VideoListReply
+	(*fieldmaskpb.FieldMask)(nil),          // 32: google.protobuf.FieldMask
+	(*TagItem)(nil),                        // 33: fenzvideo.v1.TagItem
+	(*CaptionTrack)(nil),                   // 34: fenzvideo.v1.CaptionTrack
 }
 var file_fenzvideo_v1_video_proto_depIdxs = []int32{
-	9, // 0: fenzvideo.v1.VideoReply.tags:type_name -> fenzvideo.v1.TagItem
-	7, // 1: fenzvideo.v1.VideoListReply.videos:type_name -> fenzvideo.v1.VideoReply
-	0, // 2: fenzvideo.v1.VideoService.CreateVideo:input_type -> fenzvideo.v1.CreateVideoRequest
-	2, // 3: fenzvideo.v1.VideoService.GetVideo:input_type -> fenzvideo.v1.GetVideoRequest
-	1, // 4: fenzvideo.v1.VideoService.UpdateVideo:input_type -> fenzvideo.v1.UpdateVideoRequest
-	3, // 5: fenzvideo.v1.VideoService.DeleteVideo:input_type -> fenzvideo.v1.DeleteVideoRequest
-	5, // 6: fenzvideo.v1.VideoService.TogglePublish:input_type -> fenzvideo.v1.TogglePublishRequest
-	6, // 7: fenzvideo.v1.VideoService.GetRecommended:input_type -> fenzvideo.v1.GetRecommendedRequest
-	7, // 8: fenzvideo.v1.VideoService.CreateVideo:output_type -> fenzvideo.v1.VideoReply
-	7, // 9: fenzvideo.v1.VideoService.GetVideo:output_type -> fenzvideo.v1.VideoReply
-	7, // 10: fenzvideo.v1.VideoService.UpdateVideo:output_type -> fenzvideo.v1.VideoReply
-	4, // 11: fenzvideo.v1.VideoService.DeleteVideo:output_type -> fenzvideo.v1.DeleteVideoReply
-	7, // 12: fenzvideo.v1.VideoService.TogglePublish:output_type -> fenzvideo.v1.VideoReply
-	8, // 13: fenzvideo.v1.VideoService.GetRecommended:output_type -> fenzvideo.v1.VideoListReply
-	8, // [8:14] is the sub-list for method output_type
-	2, // [2:8] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	32, // 0: fenzvideo.v1.UpdateVideoRequest.update_mask:type_name -> google.protobuf.FieldMask
+	7,  // 1: fenzvideo.v1.ListVideoSharesReply.shares:type_name -> fenzvideo.v1.VideoShare
+	13, // 2: fenzvideo.v1.ListVideoRevisionsReply.revisions:type_name -> fenzvideo.v1.VideoRevision
+	28, // 3: fenzvideo.v1.DeletedVideo.video:type_name -> fenzvideo.v1.VideoReply
+	17, // 4: fenzvideo.v1.ListDeletedVideosReply.videos:type_name -> fenzvideo.v1.DeletedVideo
+	24, // 5: fenzvideo.v1.ListThumbnailCandidatesReply.candidates:type_name -> fenzvideo.v1.ThumbnailCandidate
+	33, // 6: fenzvideo.v1.VideoReply.tags:type_name -> fenzvideo.v1.TagItem
+	27, // 7: fenzvideo.v1.VideoReply.thumbnail_variants:type_name -> fenzvideo.v1.ThumbnailVariants
+	34, // 8: fenzvideo.v1.VideoReply.captions:type_name -> fenzvideo.v1.CaptionTrack
+	29, // 9: fenzvideo.v1.VideoReply.chapters:type_name -> fenzvideo.v1.Chapter
+	29, // 10: fenzvideo.v1.SetChaptersRequest.chapters:type_name -> fenzvideo.v1.Chapter
+	28, // 11: fenzvideo.v1.VideoListReply.videos:type_name -> fenzvideo.v1.VideoReply
+	0,  // 12: fenzvideo.v1.VideoService.CreateVideo:input_type -> fenzvideo.v1.CreateVideoRequest
+	3,  // 13: fenzvideo.v1.VideoService.GetVideo:input_type -> fenzvideo.v1.GetVideoRequest
+	2,  // 14: fenzvideo.v1.VideoService.UpdateVideo:input_type -> fenzvideo.v1.UpdateVideoRequest
+	4,  // 15: fenzvideo.v1.VideoService.DeleteVideo:input_type -> fenzvideo.v1.DeleteVideoRequest
+	18, // 16: fenzvideo.v1.VideoService.ListDeletedVideos:input_type -> fenzvideo.v1.ListDeletedVideosRequest
+	20, // 17: fenzvideo.v1.VideoService.RestoreVideo:input_type -> fenzvideo.v1.RestoreVideoRequest
+	1,  // 18: fenzvideo.v1.VideoService.ReplaceVideoSource:input_type -> fenzvideo.v1.ReplaceVideoSourceRequest
+	6,  // 19: fenzvideo.v1.VideoService.TogglePublish:input_type -> fenzvideo.v1.TogglePublishRequest
+	8,  // 20: fenzvideo.v1.VideoService.CreateVideoShare:input_type -> fenzvideo.v1.CreateVideoShareRequest
+	9,  // 21: fenzvideo.v1.VideoService.ListVideoShares:input_type -> fenzvideo.v1.ListVideoSharesRequest
+	11, // 22: fenzvideo.v1.VideoService.RevokeVideoShare:input_type -> fenzvideo.v1.RevokeVideoShareRequest
+	14, // 23: fenzvideo.v1.VideoService.ListVideoRevisions:input_type -> fenzvideo.v1.ListVideoRevisionsRequest
+	16, // 24: fenzvideo.v1.VideoService.RestoreVideoRevision:input_type -> fenzvideo.v1.RestoreVideoRevisionRequest
+	21, // 25: fenzvideo.v1.VideoService.ListScheduledVideos:input_type -> fenzvideo.v1.ListScheduledVideosRequest
+	22, // 26: fenzvideo.v1.VideoService.GetRecommended:input_type -> fenzvideo.v1.GetRecommendedRequest
+	23, // 27: fenzvideo.v1.VideoService.ListThumbnailCandidates:input_type -> fenzvideo.v1.ListThumbnailCandidatesRequest
+	26, // 28: fenzvideo.v1.VideoService.SetThumbnail:input_type -> fenzvideo.v1.SetThumbnailRequest
+	30, // 29: fenzvideo.v1.VideoService.SetChapters:input_type -> fenzvideo.v1.SetChaptersRequest
+	28, // 30: fenzvideo.v1.VideoService.CreateVideo:output_type -> fenzvideo.v1.VideoReply
+	28, // 31: fenzvideo.v1.VideoService.GetVideo:output_type -> fenzvideo.v1.VideoReply
+	28, // 32: fenzvideo.v1.VideoService.UpdateVideo:output_type -> fenzvideo.v1.VideoReply
+	5,  // 33: fenzvideo.v1.VideoService.DeleteVideo:output_type -> fenzvideo.v1.DeleteVideoReply
+	19, // 34: fenzvideo.v1.VideoService.ListDeletedVideos:output_type -> fenzvideo.v1.ListDeletedVideosReply
+	28, // 35: fenzvideo.v1.VideoService.RestoreVideo:output_type -> fenzvideo.v1.VideoReply
+	28, // 36: fenzvideo.v1.VideoService.ReplaceVideoSource:output_type -> fenzvideo.v1.VideoReply
+	28, // 37: fenzvideo.v1.VideoService.TogglePublish:output_type -> fenzvideo.v1.VideoReply
+	7,  // 38: fenzvideo.v1.VideoService.CreateVideoShare:output_type -> fenzvideo.v1.VideoShare
+	10, // 39: fenzvideo.v1.VideoService.ListVideoShares:output_type -> fenzvideo.v1.ListVideoSharesReply
+	12, // 40: fenzvideo.v1.VideoService.RevokeVideoShare:output_type -> fenzvideo.v1.RevokeVideoShareReply
+	15, // 41: fenzvideo.v1.VideoService.ListVideoRevisions:output_type -> fenzvideo.v1.ListVideoRevisionsReply
+	28, // 42: fenzvideo.v1.VideoService.RestoreVideoRevision:output_type -> fenzvideo.v1.VideoReply
+	31, // 43: fenzvideo.v1.VideoService.ListScheduledVideos:output_type -> fenzvideo.v1.VideoListReply
+	31, // 44: fenzvideo.v1.VideoService.GetRecommended:output_type -> fenzvideo.v1.VideoListReply
+	25, // 45: fenzvideo.v1.VideoService.ListThumbnailCandidates:output_type -> fenzvideo.v1.ListThumbnailCandidatesReply
+	28, // 46: fenzvideo.v1.VideoService.SetThumbnail:output_type -> fenzvideo.v1.VideoReply
+	28, // 47: fenzvideo.v1.VideoService.SetChapters:output_type -> fenzvideo.v1.VideoReply
+	30, // [30:48] is the sub-list for method output_type
+	12, // [12:30] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_fenzvideo_v1_video_proto_init() }
@@ -799,16 +2678,23 @@ func file_fenzvideo_v1_video_proto_init() {
 		return
 	}
 	file_fenzvideo_v1_tag_proto_init()
+	file_fenzvideo_v1_caption_proto_init()
 	file_fenzvideo_v1_video_proto_msgTypes[0].OneofWrappers = []any{}
-	file_fenzvideo_v1_video_proto_msgTypes[1].OneofWrappers = []any{}
-	file_fenzvideo_v1_video_proto_msgTypes[6].OneofWrappers = []any{}
+	file_fenzvideo_v1_video_proto_msgTypes[2].OneofWrappers = []any{}
+	file_fenzvideo_v1_video_proto_msgTypes[8].OneofWrappers = []any{}
+	file_fenzvideo_v1_video_proto_msgTypes[22].OneofWrappers = []any{}
+	file_fenzvideo_v1_video_proto_msgTypes[26].OneofWrappers = []any{
+		(*SetThumbnailRequest_CandidateId)(nil),
+		(*SetThumbnailRequest_ThumbnailUrl)(nil),
+	}
+	file_fenzvideo_v1_video_proto_msgTypes[31].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fenzvideo_v1_video_proto_rawDesc), len(file_fenzvideo_v1_video_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
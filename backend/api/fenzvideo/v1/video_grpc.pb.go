@@ -19,12 +19,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	VideoService_CreateVideo_FullMethodName    = "/fenzvideo.v1.VideoService/CreateVideo"
-	VideoService_GetVideo_FullMethodName       = "/fenzvideo.v1.VideoService/GetVideo"
-	VideoService_UpdateVideo_FullMethodName    = "/fenzvideo.v1.VideoService/UpdateVideo"
-	VideoService_DeleteVideo_FullMethodName    = "/fenzvideo.v1.VideoService/DeleteVideo"
-	VideoService_TogglePublish_FullMethodName  = "/fenzvideo.v1.VideoService/TogglePublish"
-	VideoService_GetRecommended_FullMethodName = "/fenzvideo.v1.VideoService/GetRecommended"
+	VideoService_CreateVideo_FullMethodName             = "/fenzvideo.v1.VideoService/CreateVideo"
+	VideoService_GetVideo_FullMethodName                = "/fenzvideo.v1.VideoService/GetVideo"
+	VideoService_UpdateVideo_FullMethodName             = "/fenzvideo.v1.VideoService/UpdateVideo"
+	VideoService_DeleteVideo_FullMethodName             = "/fenzvideo.v1.VideoService/DeleteVideo"
+	VideoService_ListDeletedVideos_FullMethodName       = "/fenzvideo.v1.VideoService/ListDeletedVideos"
+	VideoService_RestoreVideo_FullMethodName            = "/fenzvideo.v1.VideoService/RestoreVideo"
+	VideoService_ReplaceVideoSource_FullMethodName      = "/fenzvideo.v1.VideoService/ReplaceVideoSource"
+	VideoService_TogglePublish_FullMethodName           = "/fenzvideo.v1.VideoService/TogglePublish"
+	VideoService_CreateVideoShare_FullMethodName        = "/fenzvideo.v1.VideoService/CreateVideoShare"
+	VideoService_ListVideoShares_FullMethodName         = "/fenzvideo.v1.VideoService/ListVideoShares"
+	VideoService_RevokeVideoShare_FullMethodName        = "/fenzvideo.v1.VideoService/RevokeVideoShare"
+	VideoService_ListVideoRevisions_FullMethodName      = "/fenzvideo.v1.VideoService/ListVideoRevisions"
+	VideoService_RestoreVideoRevision_FullMethodName    = "/fenzvideo.v1.VideoService/RestoreVideoRevision"
+	VideoService_ListScheduledVideos_FullMethodName     = "/fenzvideo.v1.VideoService/ListScheduledVideos"
+	VideoService_GetRecommended_FullMethodName          = "/fenzvideo.v1.VideoService/GetRecommended"
+	VideoService_ListThumbnailCandidates_FullMethodName = "/fenzvideo.v1.VideoService/ListThumbnailCandidates"
+	VideoService_SetThumbnail_FullMethodName            = "/fenzvideo.v1.VideoService/SetThumbnail"
+	VideoService_SetChapters_FullMethodName             = "/fenzvideo.v1.VideoService/SetChapters"
 )
 
 // VideoServiceClient is the client API for VideoService service.
@@ -33,10 +45,37 @@ const (
 type VideoServiceClient interface {
 	CreateVideo(ctx context.Context, in *CreateVideoRequest, opts ...grpc.CallOption) (*VideoReply, error)
 	GetVideo(ctx context.Context, in *GetVideoRequest, opts ...grpc.CallOption) (*VideoReply, error)
+	// Updates the fields named in update_mask, so a field in the mask but
+	// not in the request is cleared. Replies carry the version as ETag.
 	UpdateVideo(ctx context.Context, in *UpdateVideoRequest, opts ...grpc.CallOption) (*VideoReply, error)
 	DeleteVideo(ctx context.Context, in *DeleteVideoRequest, opts ...grpc.CallOption) (*DeleteVideoReply, error)
+	// The caller's deleted videos, most recently deleted first. They can be
+	// restored until purge_at.
+	ListDeletedVideos(ctx context.Context, in *ListDeletedVideosRequest, opts ...grpc.CallOption) (*ListDeletedVideosReply, error)
+	RestoreVideo(ctx context.Context, in *RestoreVideoRequest, opts ...grpc.CallOption) (*VideoReply, error)
+	// Swaps in a new file for the caller's video, keeping its ID, stats and
+	// links. The current file keeps playing while the new one is processed
+	// (replacing is set); then the old file is deleted.
+	ReplaceVideoSource(ctx context.Context, in *ReplaceVideoSourceRequest, opts ...grpc.CallOption) (*VideoReply, error)
 	TogglePublish(ctx context.Context, in *TogglePublishRequest, opts ...grpc.CallOption) (*VideoReply, error)
+	// Share links let anyone holding the token watch a video that is
+	// private or members-only, until revoked or expired.
+	CreateVideoShare(ctx context.Context, in *CreateVideoShareRequest, opts ...grpc.CallOption) (*VideoShare, error)
+	ListVideoShares(ctx context.Context, in *ListVideoSharesRequest, opts ...grpc.CallOption) (*ListVideoSharesReply, error)
+	RevokeVideoShare(ctx context.Context, in *RevokeVideoShareRequest, opts ...grpc.CallOption) (*RevokeVideoShareReply, error)
+	// A video's metadata history, newest first; for its owner and admins.
+	ListVideoRevisions(ctx context.Context, in *ListVideoRevisionsRequest, opts ...grpc.CallOption) (*ListVideoRevisionsReply, error)
+	// Sets the video's metadata back to a revision, recording a new one.
+	RestoreVideoRevision(ctx context.Context, in *RestoreVideoRevisionRequest, opts ...grpc.CallOption) (*VideoReply, error)
+	// The caller's videos waiting for their publish_at, soonest first.
+	ListScheduledVideos(ctx context.Context, in *ListScheduledVideosRequest, opts ...grpc.CallOption) (*VideoListReply, error)
 	GetRecommended(ctx context.Context, in *GetRecommendedRequest, opts ...grpc.CallOption) (*VideoListReply, error)
+	// Frames extracted after upload that the owner may pick as thumbnail.
+	ListThumbnailCandidates(ctx context.Context, in *ListThumbnailCandidatesRequest, opts ...grpc.CallOption) (*ListThumbnailCandidatesReply, error)
+	SetThumbnail(ctx context.Context, in *SetThumbnailRequest, opts ...grpc.CallOption) (*VideoReply, error)
+	// Replaces the chapters otherwise parsed from "00:00 Intro" lines of the
+	// description. An empty list removes them.
+	SetChapters(ctx context.Context, in *SetChaptersRequest, opts ...grpc.CallOption) (*VideoReply, error)
 }
 
 type videoServiceClient struct {
@@ -87,6 +126,36 @@ func (c *videoServiceClient) DeleteVideo(ctx context.Context, in *DeleteVideoReq
 	return out, nil
 }
 
+func (c *videoServiceClient) ListDeletedVideos(ctx context.Context, in *ListDeletedVideosRequest, opts ...grpc.CallOption) (*ListDeletedVideosReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeletedVideosReply)
+	err := c.cc.Invoke(ctx, VideoService_ListDeletedVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RestoreVideo(ctx context.Context, in *RestoreVideoRequest, opts ...grpc.CallOption) (*VideoReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VideoReply)
+	err := c.cc.Invoke(ctx, VideoService_RestoreVideo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ReplaceVideoSource(ctx context.Context, in *ReplaceVideoSourceRequest, opts ...grpc.CallOption) (*VideoReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VideoReply)
+	err := c.cc.Invoke(ctx, VideoService_ReplaceVideoSource_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *videoServiceClient) TogglePublish(ctx context.Context, in *TogglePublishRequest, opts ...grpc.CallOption) (*VideoReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(VideoReply)
@@ -97,6 +166,66 @@ func (c *videoServiceClient) TogglePublish(ctx context.Context, in *TogglePublis
 	return out, nil
 }
 
+func (c *videoServiceClient) CreateVideoShare(ctx context.Context, in *CreateVideoShareRequest, opts ...grpc.CallOption) (*VideoShare, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VideoShare)
+	err := c.cc.Invoke(ctx, VideoService_CreateVideoShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListVideoShares(ctx context.Context, in *ListVideoSharesRequest, opts ...grpc.CallOption) (*ListVideoSharesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVideoSharesReply)
+	err := c.cc.Invoke(ctx, VideoService_ListVideoShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RevokeVideoShare(ctx context.Context, in *RevokeVideoShareRequest, opts ...grpc.CallOption) (*RevokeVideoShareReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeVideoShareReply)
+	err := c.cc.Invoke(ctx, VideoService_RevokeVideoShare_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListVideoRevisions(ctx context.Context, in *ListVideoRevisionsRequest, opts ...grpc.CallOption) (*ListVideoRevisionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVideoRevisionsReply)
+	err := c.cc.Invoke(ctx, VideoService_ListVideoRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) RestoreVideoRevision(ctx context.Context, in *RestoreVideoRevisionRequest, opts ...grpc.CallOption) (*VideoReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VideoReply)
+	err := c.cc.Invoke(ctx, VideoService_RestoreVideoRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) ListScheduledVideos(ctx context.Context, in *ListScheduledVideosRequest, opts ...grpc.CallOption) (*VideoListReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VideoListReply)
+	err := c.cc.Invoke(ctx, VideoService_ListScheduledVideos_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *videoServiceClient) GetRecommended(ctx context.Context, in *GetRecommendedRequest, opts ...grpc.CallOption) (*VideoListReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(VideoListReply)
@@ -107,16 +236,73 @@ func (c *videoServiceClient) GetRecommended(ctx context.Context, in *GetRecommen
 	return out, nil
 }
 
+func (c *videoServiceClient) ListThumbnailCandidates(ctx context.Context, in *ListThumbnailCandidatesRequest, opts ...grpc.CallOption) (*ListThumbnailCandidatesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListThumbnailCandidatesReply)
+	err := c.cc.Invoke(ctx, VideoService_ListThumbnailCandidates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) SetThumbnail(ctx context.Context, in *SetThumbnailRequest, opts ...grpc.CallOption) (*VideoReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VideoReply)
+	err := c.cc.Invoke(ctx, VideoService_SetThumbnail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *videoServiceClient) SetChapters(ctx context.Context, in *SetChaptersRequest, opts ...grpc.CallOption) (*VideoReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VideoReply)
+	err := c.cc.Invoke(ctx, VideoService_SetChapters_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // VideoServiceServer is the server API for VideoService service.
 // All implementations must embed UnimplementedVideoServiceServer
 // for forward compatibility.
 type VideoServiceServer interface {
 	CreateVideo(context.Context, *CreateVideoRequest) (*VideoReply, error)
 	GetVideo(context.Context, *GetVideoRequest) (*VideoReply, error)
+	// Updates the fields named in update_mask, so a field in the mask but
+	// not in the request is cleared. Replies carry the version as ETag.
 	UpdateVideo(context.Context, *UpdateVideoRequest) (*VideoReply, error)
 	DeleteVideo(context.Context, *DeleteVideoRequest) (*DeleteVideoReply, error)
+	// The caller's deleted videos, most recently deleted first. They can be
+	// restored until purge_at.
+	ListDeletedVideos(context.Context, *ListDeletedVideosRequest) (*ListDeletedVideosReply, error)
+	RestoreVideo(context.Context, *RestoreVideoRequest) (*VideoReply, error)
+	// Swaps in a new file for the caller's video, keeping its ID, stats and
+	// links. The current file keeps playing while the new one is processed
+	// (replacing is set); then the old file is deleted.
+	ReplaceVideoSource(context.Context, *ReplaceVideoSourceRequest) (*VideoReply, error)
 	TogglePublish(context.Context, *TogglePublishRequest) (*VideoReply, error)
+	// Share links let anyone holding the token watch a video that is
+	// private or members-only, until revoked or expired.
+	CreateVideoShare(context.Context, *CreateVideoShareRequest) (*VideoShare, error)
+	ListVideoShares(context.Context, *ListVideoSharesRequest) (*ListVideoSharesReply, error)
+	RevokeVideoShare(context.Context, *RevokeVideoShareRequest) (*RevokeVideoShareReply, error)
+	// A video's metadata history, newest first; for its owner and admins.
+	ListVideoRevisions(context.Context, *ListVideoRevisionsRequest) (*ListVideoRevisionsReply, error)
+	// Sets the video's metadata back to a revision, recording a new one.
+	RestoreVideoRevision(context.Context, *RestoreVideoRevisionRequest) (*VideoReply, error)
+	// The caller's videos waiting for their publish_at, soonest first.
+	ListScheduledVideos(context.Context, *ListScheduledVideosRequest) (*VideoListReply, error)
 	GetRecommended(context.Context, *GetRecommendedRequest) (*VideoListReply, error)
+	// Frames extracted after upload that the owner may pick as thumbnail.
+	ListThumbnailCandidates(context.Context, *ListThumbnailCandidatesRequest) (*ListThumbnailCandidatesReply, error)
+	SetThumbnail(context.Context, *SetThumbnailRequest) (*VideoReply, error)
+	// Replaces the chapters otherwise parsed from "00:00 Intro" lines of the
+	// description. An empty list removes them.
+	SetChapters(context.Context, *SetChaptersRequest) (*VideoReply, error)
 	mustEmbedUnimplementedVideoServiceServer()
 }
 
@@ -139,12 +325,48 @@ func (UnimplementedVideoServiceServer) UpdateVideo(context.Context, *UpdateVideo
 func (UnimplementedVideoServiceServer) DeleteVideo(context.Context, *DeleteVideoRequest) (*DeleteVideoReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteVideo not implemented")
 }
+func (UnimplementedVideoServiceServer) ListDeletedVideos(context.Context, *ListDeletedVideosRequest) (*ListDeletedVideosReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListDeletedVideos not implemented")
+}
+func (UnimplementedVideoServiceServer) RestoreVideo(context.Context, *RestoreVideoRequest) (*VideoReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreVideo not implemented")
+}
+func (UnimplementedVideoServiceServer) ReplaceVideoSource(context.Context, *ReplaceVideoSourceRequest) (*VideoReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplaceVideoSource not implemented")
+}
 func (UnimplementedVideoServiceServer) TogglePublish(context.Context, *TogglePublishRequest) (*VideoReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method TogglePublish not implemented")
 }
+func (UnimplementedVideoServiceServer) CreateVideoShare(context.Context, *CreateVideoShareRequest) (*VideoShare, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateVideoShare not implemented")
+}
+func (UnimplementedVideoServiceServer) ListVideoShares(context.Context, *ListVideoSharesRequest) (*ListVideoSharesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVideoShares not implemented")
+}
+func (UnimplementedVideoServiceServer) RevokeVideoShare(context.Context, *RevokeVideoShareRequest) (*RevokeVideoShareReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeVideoShare not implemented")
+}
+func (UnimplementedVideoServiceServer) ListVideoRevisions(context.Context, *ListVideoRevisionsRequest) (*ListVideoRevisionsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVideoRevisions not implemented")
+}
+func (UnimplementedVideoServiceServer) RestoreVideoRevision(context.Context, *RestoreVideoRevisionRequest) (*VideoReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RestoreVideoRevision not implemented")
+}
+func (UnimplementedVideoServiceServer) ListScheduledVideos(context.Context, *ListScheduledVideosRequest) (*VideoListReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduledVideos not implemented")
+}
 func (UnimplementedVideoServiceServer) GetRecommended(context.Context, *GetRecommendedRequest) (*VideoListReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetRecommended not implemented")
 }
+func (UnimplementedVideoServiceServer) ListThumbnailCandidates(context.Context, *ListThumbnailCandidatesRequest) (*ListThumbnailCandidatesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListThumbnailCandidates not implemented")
+}
+func (UnimplementedVideoServiceServer) SetThumbnail(context.Context, *SetThumbnailRequest) (*VideoReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetThumbnail not implemented")
+}
+func (UnimplementedVideoServiceServer) SetChapters(context.Context, *SetChaptersRequest) (*VideoReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetChapters not implemented")
+}
 func (UnimplementedVideoServiceServer) mustEmbedUnimplementedVideoServiceServer() {}
 func (UnimplementedVideoServiceServer) testEmbeddedByValue()                      {}
 
@@ -238,6 +460,60 @@ func _VideoService_DeleteVideo_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoService_ListDeletedVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListDeletedVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListDeletedVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListDeletedVideos(ctx, req.(*ListDeletedVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_RestoreVideo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVideoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RestoreVideo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_RestoreVideo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RestoreVideo(ctx, req.(*RestoreVideoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ReplaceVideoSource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaceVideoSourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ReplaceVideoSource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ReplaceVideoSource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ReplaceVideoSource(ctx, req.(*ReplaceVideoSourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VideoService_TogglePublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TogglePublishRequest)
 	if err := dec(in); err != nil {
@@ -256,6 +532,114 @@ func _VideoService_TogglePublish_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoService_CreateVideoShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVideoShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).CreateVideoShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_CreateVideoShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).CreateVideoShare(ctx, req.(*CreateVideoShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListVideoShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVideoSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListVideoShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListVideoShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListVideoShares(ctx, req.(*ListVideoSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_RevokeVideoShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeVideoShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RevokeVideoShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_RevokeVideoShare_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RevokeVideoShare(ctx, req.(*RevokeVideoShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListVideoRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVideoRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListVideoRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListVideoRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListVideoRevisions(ctx, req.(*ListVideoRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_RestoreVideoRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVideoRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).RestoreVideoRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_RestoreVideoRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).RestoreVideoRevision(ctx, req.(*RestoreVideoRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_ListScheduledVideos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledVideosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListScheduledVideos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListScheduledVideos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListScheduledVideos(ctx, req.(*ListScheduledVideosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _VideoService_GetRecommended_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRecommendedRequest)
 	if err := dec(in); err != nil {
@@ -274,6 +658,60 @@ func _VideoService_GetRecommended_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _VideoService_ListThumbnailCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListThumbnailCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).ListThumbnailCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_ListThumbnailCandidates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).ListThumbnailCandidates(ctx, req.(*ListThumbnailCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_SetThumbnail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetThumbnailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).SetThumbnail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_SetThumbnail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).SetThumbnail(ctx, req.(*SetThumbnailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VideoService_SetChapters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetChaptersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VideoServiceServer).SetChapters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VideoService_SetChapters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VideoServiceServer).SetChapters(ctx, req.(*SetChaptersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // VideoService_ServiceDesc is the grpc.ServiceDesc for VideoService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -297,14 +735,62 @@ var VideoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteVideo",
 			Handler:    _VideoService_DeleteVideo_Handler,
 		},
+		{
+			MethodName: "ListDeletedVideos",
+			Handler:    _VideoService_ListDeletedVideos_Handler,
+		},
+		{
+			MethodName: "RestoreVideo",
+			Handler:    _VideoService_RestoreVideo_Handler,
+		},
+		{
+			MethodName: "ReplaceVideoSource",
+			Handler:    _VideoService_ReplaceVideoSource_Handler,
+		},
 		{
 			MethodName: "TogglePublish",
 			Handler:    _VideoService_TogglePublish_Handler,
 		},
+		{
+			MethodName: "CreateVideoShare",
+			Handler:    _VideoService_CreateVideoShare_Handler,
+		},
+		{
+			MethodName: "ListVideoShares",
+			Handler:    _VideoService_ListVideoShares_Handler,
+		},
+		{
+			MethodName: "RevokeVideoShare",
+			Handler:    _VideoService_RevokeVideoShare_Handler,
+		},
+		{
+			MethodName: "ListVideoRevisions",
+			Handler:    _VideoService_ListVideoRevisions_Handler,
+		},
+		{
+			MethodName: "RestoreVideoRevision",
+			Handler:    _VideoService_RestoreVideoRevision_Handler,
+		},
+		{
+			MethodName: "ListScheduledVideos",
+			Handler:    _VideoService_ListScheduledVideos_Handler,
+		},
 		{
 			MethodName: "GetRecommended",
 			Handler:    _VideoService_GetRecommended_Handler,
 		},
+		{
+			MethodName: "ListThumbnailCandidates",
+			Handler:    _VideoService_ListThumbnailCandidates_Handler,
+		},
+		{
+			MethodName: "SetThumbnail",
+			Handler:    _VideoService_SetThumbnail_Handler,
+		},
+		{
+			MethodName: "SetChapters",
+			Handler:    _VideoService_SetChapters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "fenzvideo/v1/video.proto",
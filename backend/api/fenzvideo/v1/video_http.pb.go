@@ -20,17 +20,41 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationVideoServiceCreateVideo = "/fenzvideo.v1.VideoService/CreateVideo"
+const OperationVideoServiceCreateVideoShare = "/fenzvideo.v1.VideoService/CreateVideoShare"
 const OperationVideoServiceDeleteVideo = "/fenzvideo.v1.VideoService/DeleteVideo"
 const OperationVideoServiceGetRecommended = "/fenzvideo.v1.VideoService/GetRecommended"
 const OperationVideoServiceGetVideo = "/fenzvideo.v1.VideoService/GetVideo"
+const OperationVideoServiceListDeletedVideos = "/fenzvideo.v1.VideoService/ListDeletedVideos"
+const OperationVideoServiceListScheduledVideos = "/fenzvideo.v1.VideoService/ListScheduledVideos"
+const OperationVideoServiceListThumbnailCandidates = "/fenzvideo.v1.VideoService/ListThumbnailCandidates"
+const OperationVideoServiceListVideoRevisions = "/fenzvideo.v1.VideoService/ListVideoRevisions"
+const OperationVideoServiceListVideoShares = "/fenzvideo.v1.VideoService/ListVideoShares"
+const OperationVideoServiceReplaceVideoSource = "/fenzvideo.v1.VideoService/ReplaceVideoSource"
+const OperationVideoServiceRestoreVideo = "/fenzvideo.v1.VideoService/RestoreVideo"
+const OperationVideoServiceRestoreVideoRevision = "/fenzvideo.v1.VideoService/RestoreVideoRevision"
+const OperationVideoServiceRevokeVideoShare = "/fenzvideo.v1.VideoService/RevokeVideoShare"
+const OperationVideoServiceSetChapters = "/fenzvideo.v1.VideoService/SetChapters"
+const OperationVideoServiceSetThumbnail = "/fenzvideo.v1.VideoService/SetThumbnail"
 const OperationVideoServiceTogglePublish = "/fenzvideo.v1.VideoService/TogglePublish"
 const OperationVideoServiceUpdateVideo = "/fenzvideo.v1.VideoService/UpdateVideo"
 
 type VideoServiceHTTPServer interface {
 	CreateVideo(context.Context, *CreateVideoRequest) (*VideoReply, error)
+	CreateVideoShare(context.Context, *CreateVideoShareRequest) (*VideoShare, error)
 	DeleteVideo(context.Context, *DeleteVideoRequest) (*DeleteVideoReply, error)
 	GetRecommended(context.Context, *GetRecommendedRequest) (*VideoListReply, error)
 	GetVideo(context.Context, *GetVideoRequest) (*VideoReply, error)
+	ListDeletedVideos(context.Context, *ListDeletedVideosRequest) (*ListDeletedVideosReply, error)
+	ListScheduledVideos(context.Context, *ListScheduledVideosRequest) (*VideoListReply, error)
+	ListThumbnailCandidates(context.Context, *ListThumbnailCandidatesRequest) (*ListThumbnailCandidatesReply, error)
+	ListVideoRevisions(context.Context, *ListVideoRevisionsRequest) (*ListVideoRevisionsReply, error)
+	ListVideoShares(context.Context, *ListVideoSharesRequest) (*ListVideoSharesReply, error)
+	ReplaceVideoSource(context.Context, *ReplaceVideoSourceRequest) (*VideoReply, error)
+	RestoreVideo(context.Context, *RestoreVideoRequest) (*VideoReply, error)
+	RestoreVideoRevision(context.Context, *RestoreVideoRevisionRequest) (*VideoReply, error)
+	RevokeVideoShare(context.Context, *RevokeVideoShareRequest) (*RevokeVideoShareReply, error)
+	SetChapters(context.Context, *SetChaptersRequest) (*VideoReply, error)
+	SetThumbnail(context.Context, *SetThumbnailRequest) (*VideoReply, error)
 	TogglePublish(context.Context, *TogglePublishRequest) (*VideoReply, error)
 	UpdateVideo(context.Context, *UpdateVideoRequest) (*VideoReply, error)
 }
@@ -41,8 +65,20 @@ func RegisterVideoServiceHTTPServer(s *http.Server, srv VideoServiceHTTPServer)
 	r.GET("/api/v1/videos/{id}", _VideoService_GetVideo0_HTTP_Handler(srv))
 	r.PUT("/api/v1/videos/{id}", _VideoService_UpdateVideo0_HTTP_Handler(srv))
 	r.DELETE("/api/v1/videos/{id}", _VideoService_DeleteVideo0_HTTP_Handler(srv))
+	r.GET("/api/v1/videos/my/trash", _VideoService_ListDeletedVideos0_HTTP_Handler(srv))
+	r.POST("/api/v1/videos/{id}/restore", _VideoService_RestoreVideo0_HTTP_Handler(srv))
+	r.PUT("/api/v1/videos/{id}/source", _VideoService_ReplaceVideoSource0_HTTP_Handler(srv))
 	r.PATCH("/api/v1/videos/{id}/publish", _VideoService_TogglePublish0_HTTP_Handler(srv))
+	r.POST("/api/v1/videos/{video_id}/shares", _VideoService_CreateVideoShare0_HTTP_Handler(srv))
+	r.GET("/api/v1/videos/{video_id}/shares", _VideoService_ListVideoShares0_HTTP_Handler(srv))
+	r.DELETE("/api/v1/videos/{video_id}/shares/{id}", _VideoService_RevokeVideoShare0_HTTP_Handler(srv))
+	r.GET("/api/v1/videos/{video_id}/revisions", _VideoService_ListVideoRevisions0_HTTP_Handler(srv))
+	r.POST("/api/v1/videos/{video_id}/revisions/{id}/restore", _VideoService_RestoreVideoRevision0_HTTP_Handler(srv))
+	r.GET("/api/v1/videos/my/scheduled", _VideoService_ListScheduledVideos0_HTTP_Handler(srv))
 	r.GET("/api/v1/recommended", _VideoService_GetRecommended0_HTTP_Handler(srv))
+	r.GET("/api/v1/videos/{id}/thumbnail-candidates", _VideoService_ListThumbnailCandidates0_HTTP_Handler(srv))
+	r.PUT("/api/v1/videos/{id}/thumbnail", _VideoService_SetThumbnail0_HTTP_Handler(srv))
+	r.PUT("/api/v1/videos/{id}/chapters", _VideoService_SetChapters0_HTTP_Handler(srv))
 }
 
 func _VideoService_CreateVideo0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
@@ -136,6 +172,75 @@ func _VideoService_DeleteVideo0_HTTP_Handler(srv VideoServiceHTTPServer) func(ct
 	}
 }
 
+func _VideoService_ListDeletedVideos0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDeletedVideosRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceListDeletedVideos)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDeletedVideos(ctx, req.(*ListDeletedVideosRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDeletedVideosReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_RestoreVideo0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreVideoRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceRestoreVideo)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreVideo(ctx, req.(*RestoreVideoRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VideoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ReplaceVideoSource0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReplaceVideoSourceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceReplaceVideoSource)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReplaceVideoSource(ctx, req.(*ReplaceVideoSourceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VideoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _VideoService_TogglePublish0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in TogglePublishRequest
@@ -161,6 +266,141 @@ func _VideoService_TogglePublish0_HTTP_Handler(srv VideoServiceHTTPServer) func(
 	}
 }
 
+func _VideoService_CreateVideoShare0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateVideoShareRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceCreateVideoShare)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateVideoShare(ctx, req.(*CreateVideoShareRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VideoShare)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ListVideoShares0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListVideoSharesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceListVideoShares)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListVideoShares(ctx, req.(*ListVideoSharesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListVideoSharesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_RevokeVideoShare0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeVideoShareRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceRevokeVideoShare)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeVideoShare(ctx, req.(*RevokeVideoShareRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeVideoShareReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ListVideoRevisions0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListVideoRevisionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceListVideoRevisions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListVideoRevisions(ctx, req.(*ListVideoRevisionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListVideoRevisionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_RestoreVideoRevision0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RestoreVideoRevisionRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceRestoreVideoRevision)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RestoreVideoRevision(ctx, req.(*RestoreVideoRevisionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VideoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_ListScheduledVideos0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListScheduledVideosRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceListScheduledVideos)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListScheduledVideos(ctx, req.(*ListScheduledVideosRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VideoListReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _VideoService_GetRecommended0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in GetRecommendedRequest
@@ -180,11 +420,95 @@ func _VideoService_GetRecommended0_HTTP_Handler(srv VideoServiceHTTPServer) func
 	}
 }
 
+func _VideoService_ListThumbnailCandidates0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListThumbnailCandidatesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceListThumbnailCandidates)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListThumbnailCandidates(ctx, req.(*ListThumbnailCandidatesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListThumbnailCandidatesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_SetThumbnail0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetThumbnailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceSetThumbnail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetThumbnail(ctx, req.(*SetThumbnailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VideoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _VideoService_SetChapters0_HTTP_Handler(srv VideoServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetChaptersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationVideoServiceSetChapters)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetChapters(ctx, req.(*SetChaptersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VideoReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type VideoServiceHTTPClient interface {
 	CreateVideo(ctx context.Context, req *CreateVideoRequest, opts ...http.CallOption) (rsp *VideoReply, err error)
+	CreateVideoShare(ctx context.Context, req *CreateVideoShareRequest, opts ...http.CallOption) (rsp *VideoShare, err error)
 	DeleteVideo(ctx context.Context, req *DeleteVideoRequest, opts ...http.CallOption) (rsp *DeleteVideoReply, err error)
 	GetRecommended(ctx context.Context, req *GetRecommendedRequest, opts ...http.CallOption) (rsp *VideoListReply, err error)
 	GetVideo(ctx context.Context, req *GetVideoRequest, opts ...http.CallOption) (rsp *VideoReply, err error)
+	ListDeletedVideos(ctx context.Context, req *ListDeletedVideosRequest, opts ...http.CallOption) (rsp *ListDeletedVideosReply, err error)
+	ListScheduledVideos(ctx context.Context, req *ListScheduledVideosRequest, opts ...http.CallOption) (rsp *VideoListReply, err error)
+	ListThumbnailCandidates(ctx context.Context, req *ListThumbnailCandidatesRequest, opts ...http.CallOption) (rsp *ListThumbnailCandidatesReply, err error)
+	ListVideoRevisions(ctx context.Context, req *ListVideoRevisionsRequest, opts ...http.CallOption) (rsp *ListVideoRevisionsReply, err error)
+	ListVideoShares(ctx context.Context, req *ListVideoSharesRequest, opts ...http.CallOption) (rsp *ListVideoSharesReply, err error)
+	ReplaceVideoSource(ctx context.Context, req *ReplaceVideoSourceRequest, opts ...http.CallOption) (rsp *VideoReply, err error)
+	RestoreVideo(ctx context.Context, req *RestoreVideoRequest, opts ...http.CallOption) (rsp *VideoReply, err error)
+	RestoreVideoRevision(ctx context.Context, req *RestoreVideoRevisionRequest, opts ...http.CallOption) (rsp *VideoReply, err error)
+	RevokeVideoShare(ctx context.Context, req *RevokeVideoShareRequest, opts ...http.CallOption) (rsp *RevokeVideoShareReply, err error)
+	SetChapters(ctx context.Context, req *SetChaptersRequest, opts ...http.CallOption) (rsp *VideoReply, err error)
+	SetThumbnail(ctx context.Context, req *SetThumbnailRequest, opts ...http.CallOption) (rsp *VideoReply, err error)
 	TogglePublish(ctx context.Context, req *TogglePublishRequest, opts ...http.CallOption) (rsp *VideoReply, err error)
 	UpdateVideo(ctx context.Context, req *UpdateVideoRequest, opts ...http.CallOption) (rsp *VideoReply, err error)
 }
@@ -210,6 +534,19 @@ func (c *VideoServiceHTTPClientImpl) CreateVideo(ctx context.Context, in *Create
 	return &out, nil
 }
 
+func (c *VideoServiceHTTPClientImpl) CreateVideoShare(ctx context.Context, in *CreateVideoShareRequest, opts ...http.CallOption) (*VideoShare, error) {
+	var out VideoShare
+	pattern := "/api/v1/videos/{video_id}/shares"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceCreateVideoShare))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *VideoServiceHTTPClientImpl) DeleteVideo(ctx context.Context, in *DeleteVideoRequest, opts ...http.CallOption) (*DeleteVideoReply, error) {
 	var out DeleteVideoReply
 	pattern := "/api/v1/videos/{id}"
@@ -249,6 +586,149 @@ func (c *VideoServiceHTTPClientImpl) GetVideo(ctx context.Context, in *GetVideoR
 	return &out, nil
 }
 
+func (c *VideoServiceHTTPClientImpl) ListDeletedVideos(ctx context.Context, in *ListDeletedVideosRequest, opts ...http.CallOption) (*ListDeletedVideosReply, error) {
+	var out ListDeletedVideosReply
+	pattern := "/api/v1/videos/my/trash"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceListDeletedVideos))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) ListScheduledVideos(ctx context.Context, in *ListScheduledVideosRequest, opts ...http.CallOption) (*VideoListReply, error) {
+	var out VideoListReply
+	pattern := "/api/v1/videos/my/scheduled"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceListScheduledVideos))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) ListThumbnailCandidates(ctx context.Context, in *ListThumbnailCandidatesRequest, opts ...http.CallOption) (*ListThumbnailCandidatesReply, error) {
+	var out ListThumbnailCandidatesReply
+	pattern := "/api/v1/videos/{id}/thumbnail-candidates"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceListThumbnailCandidates))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) ListVideoRevisions(ctx context.Context, in *ListVideoRevisionsRequest, opts ...http.CallOption) (*ListVideoRevisionsReply, error) {
+	var out ListVideoRevisionsReply
+	pattern := "/api/v1/videos/{video_id}/revisions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceListVideoRevisions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) ListVideoShares(ctx context.Context, in *ListVideoSharesRequest, opts ...http.CallOption) (*ListVideoSharesReply, error) {
+	var out ListVideoSharesReply
+	pattern := "/api/v1/videos/{video_id}/shares"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceListVideoShares))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) ReplaceVideoSource(ctx context.Context, in *ReplaceVideoSourceRequest, opts ...http.CallOption) (*VideoReply, error) {
+	var out VideoReply
+	pattern := "/api/v1/videos/{id}/source"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceReplaceVideoSource))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) RestoreVideo(ctx context.Context, in *RestoreVideoRequest, opts ...http.CallOption) (*VideoReply, error) {
+	var out VideoReply
+	pattern := "/api/v1/videos/{id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceRestoreVideo))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) RestoreVideoRevision(ctx context.Context, in *RestoreVideoRevisionRequest, opts ...http.CallOption) (*VideoReply, error) {
+	var out VideoReply
+	pattern := "/api/v1/videos/{video_id}/revisions/{id}/restore"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceRestoreVideoRevision))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) RevokeVideoShare(ctx context.Context, in *RevokeVideoShareRequest, opts ...http.CallOption) (*RevokeVideoShareReply, error) {
+	var out RevokeVideoShareReply
+	pattern := "/api/v1/videos/{video_id}/shares/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationVideoServiceRevokeVideoShare))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) SetChapters(ctx context.Context, in *SetChaptersRequest, opts ...http.CallOption) (*VideoReply, error) {
+	var out VideoReply
+	pattern := "/api/v1/videos/{id}/chapters"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceSetChapters))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *VideoServiceHTTPClientImpl) SetThumbnail(ctx context.Context, in *SetThumbnailRequest, opts ...http.CallOption) (*VideoReply, error) {
+	var out VideoReply
+	pattern := "/api/v1/videos/{id}/thumbnail"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationVideoServiceSetThumbnail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *VideoServiceHTTPClientImpl) TogglePublish(ctx context.Context, in *TogglePublishRequest, opts ...http.CallOption) (*VideoReply, error) {
 	var out VideoReply
 	pattern := "/api/v1/videos/{id}/publish"
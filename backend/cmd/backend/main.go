@@ -5,6 +5,7 @@ import (
 	"os"
 
 	"backend/internal/conf"
+	"backend/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -33,7 +34,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server, tw *server.TranscodeWorker, sr *server.StorageReconciler, tp *server.TrashPurger, us *server.UploadSweeper) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -43,6 +44,10 @@ func newApp(logger log.Logger, gs *grpc.Server, hs *http.Server) *kratos.App {
 		kratos.Server(
 			gs,
 			hs,
+			tw,
+			sr,
+			tp,
+			us,
 		),
 	)
 }
@@ -74,7 +79,7 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Auth, bc.Storage, bc.Nats, bc.Admin, logger)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Auth, bc.Storage, bc.Nats, bc.Admin, bc.Media, logger)
 	if err != nil {
 		panic(err)
 	}
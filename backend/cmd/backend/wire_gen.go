@@ -23,7 +23,7 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, storage *conf.Storage, nats *conf.NATS, admin *conf.Admin, logger log.Logger) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, storage *conf.Storage, nats *conf.NATS, admin *conf.Admin, media *conf.Media, logger log.Logger) (*kratos.App, func(), error) {
 	db := data.NewDB(confData, logger)
 	client := data.NewRedisClient(confData, logger)
 	minioClient := data.NewMinIOClient(storage, logger)
@@ -42,23 +42,47 @@ func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, stor
 	tagUsecase := biz.NewTagUsecase(tagRepo, logger)
 	tagService := service.NewTagService(tagUsecase)
 	videoCache := data.NewVideoCache(dataData, logger)
-	videoRepo := data.NewVideoRepo(dataData, videoCache, logger)
+	minIOUploader := data.NewUploader(minioClient, storage)
+	videoRepo := data.NewVideoRepo(dataData, videoCache, minIOUploader, logger)
 	channelRepo := data.NewChannelRepo(dataData, logger)
 	membershipChecker := data.NewMembershipChecker(channelRepo)
-	videoUsecase := biz.NewVideoUsecase(videoRepo, tagUsecase, membershipChecker, logger)
-	videoService := service.NewVideoService(videoUsecase)
-	searchRepo := data.NewSearchRepo(dataData, logger)
-	searchUsecase := biz.NewSearchUsecase(searchRepo, logger)
+	cursorCodec := biz.NewCursorCodec(auth)
+	signer := data.NewPlaybackSigner(storage, auth)
+	mediaProber := data.NewMediaProber(media, minIOUploader, logger)
+	transcodeQueue := data.NewTranscodeQueue(dataData, logger)
+	captionRepo := data.NewCaptionRepo(dataData, minIOUploader, media, logger)
+	quotaRepo := data.NewQuotaRepo(dataData, minIOUploader, logger)
+	quotaUsecase := biz.NewQuotaUsecase(quotaRepo, storage, logger)
+	uploadRepo := data.NewUploadRepo(dataData, videoCache, minIOUploader, logger)
+	scanner := data.NewScanner(media, logger)
+	uploadUsecase := biz.NewUploadUsecase(uploadRepo, minIOUploader, quotaUsecase, scanner, logger)
+	videoUsecase := biz.NewVideoUsecase(videoRepo, tagUsecase, membershipChecker, cursorCodec, signer, mediaProber, transcodeQueue, captionRepo, quotaUsecase, uploadUsecase, logger)
+	trashRepo := data.NewTrashRepo(dataData, videoCache, minIOUploader, logger)
+	trashUsecase := biz.NewTrashUsecase(trashRepo, videoRepo, storage, logger)
+	videoService := service.NewVideoService(videoUsecase, uploadUsecase, trashUsecase)
+	searchRepo := data.NewSearchRepo(dataData, media, logger)
+	searchUsecase := biz.NewSearchUsecase(searchRepo, cursorCodec, logger)
 	searchService := service.NewSearchService(searchUsecase)
 	channelUsecase := biz.NewChannelUsecase(channelRepo, logger)
 	channelService := service.NewChannelService(channelUsecase)
-	adminRepo := data.NewAdminRepo(dataData, logger)
-	adminUsecase := biz.NewAdminUsecase(adminRepo, logger)
-	adminService := service.NewAdminService(adminUsecase)
-	grpcServer := server.NewGRPCServer(confServer, auth, logger, authService, categoryService, tagService, videoService, searchService, channelService, adminService)
-	minIOUploader := data.NewUploader(minioClient, storage)
-	httpServer := server.NewHTTPServer(confServer, auth, logger, authService, categoryService, tagService, videoService, searchService, channelService, adminService, minIOUploader)
-	app := newApp(logger, grpcServer, httpServer)
+	adminRepo := data.NewAdminRepo(dataData, minIOUploader, logger)
+	adminUsecase := biz.NewAdminUsecase(adminRepo, cursorCodec, logger)
+	storageRepo := data.NewStorageRepo(dataData, minIOUploader, logger)
+	storageUsecase := biz.NewStorageUsecase(storageRepo, storage, logger)
+	adminService := service.NewAdminService(adminUsecase, storageUsecase, trashUsecase)
+	uploadService := service.NewUploadService(uploadUsecase, quotaUsecase)
+	captionUsecase := biz.NewCaptionUsecase(captionRepo, videoUsecase, logger)
+	captionService := service.NewCaptionService(captionUsecase)
+	grpcServer := server.NewGRPCServer(confServer, auth, logger, authService, categoryService, tagService, videoService, searchService, channelService, adminService, uploadService, captionService)
+	httpServer := server.NewHTTPServer(confServer, auth, logger, authService, categoryService, tagService, videoService, searchService, channelService, adminService, uploadService, captionService, minIOUploader, signer)
+	transcoder := data.NewTranscoder(media, minIOUploader, logger)
+	thumbnailer := data.NewThumbnailer(media, minIOUploader, logger)
+	transcodeUsecase := biz.NewTranscodeUsecase(videoRepo, videoUsecase, transcodeQueue, transcoder, thumbnailer, logger)
+	transcodeWorker := server.NewTranscodeWorker(transcodeUsecase, logger)
+	storageReconciler := server.NewStorageReconciler(storageUsecase, logger)
+	trashPurger := server.NewTrashPurger(trashUsecase, logger)
+	uploadSweeper := server.NewUploadSweeper(uploadUsecase, logger)
+	app := newApp(logger, grpcServer, httpServer, transcodeWorker, storageReconciler, trashPurger, uploadSweeper)
 	return app, func() {
 		cleanup()
 	}, nil
@@ -293,7 +293,8 @@ func seedVideos(db *gorm.DB, geminiKey string, users []model.User, cats []model.
 			ViewsMember:    viewsMember,
 			ViewsNonMember: viewsNonMember,
 			AccessTier:     0, // public
-			IsPublished:    true,
+			Status:         "ready",
+			Visibility:     "published",
 			IsHidden:       false,
 		}
 
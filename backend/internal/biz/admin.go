@@ -2,7 +2,9 @@ package biz
 
 import (
 	"context"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"backend/internal/pkg/pagination"
 
@@ -26,7 +28,8 @@ type AdminVideo struct {
 	UserID         uint64
 	CategoryName   string
 	AccessTier     int8
-	IsPublished    bool
+	Status         string
+	Visibility     string
 	IsHidden       bool
 	ViewsMember    uint64
 	ViewsNonMember uint64
@@ -39,34 +42,114 @@ type AdminTag struct {
 	Slug string
 }
 
+// AdminSearchQueryStat aggregates search_query_dailies over a date window.
+type AdminSearchQueryStat struct {
+	Query           string
+	Searches        int64
+	ZeroResults     int64
+	ClickedSearches int64
+	TotalLatencyMs  int64
+}
+
+type AdminSynonymGroup struct {
+	ID    uint64
+	Terms []string
+}
+
+type AdminSpellingCorrection struct {
+	ID          uint64
+	Misspelling string
+	Correction  string
+}
+
+// AdminDuplicateGroup is content uploaded by more than one account.
+type AdminDuplicateGroup struct {
+	SHA256  string
+	Size    int64
+	Uploads []*AdminDuplicateUpload
+}
+
+type AdminDuplicateUpload struct {
+	UploadID   uint64
+	UserID     uint64
+	Username   string
+	ObjectName string
+	Status     string
+	// DuplicateOf is the upload this one was rejected or replaced in favour of.
+	DuplicateOf string
+	VideoIDs    []uint64
+	CreatedAt   time.Time
+}
+
+const (
+	maxSearchTermRunes = 100
+
+	defaultSearchStatsDays  = 7
+	maxSearchStatsDays      = 90
+	defaultSearchStatsLimit = 20
+	maxSearchStatsLimit     = 100
+)
+
 type AdminRepo interface {
-	ListUsers(ctx context.Context, offset, limit int) ([]*AdminUser, int64, error)
+	ListUsers(ctx context.Context, page pagination.Request) ([]*AdminUser, int64, error)
 	FindUserByID(ctx context.Context, id uint64) (*AdminUser, error)
 	DeleteUser(ctx context.Context, id uint64) error
-	ListAllVideos(ctx context.Context, offset, limit int) ([]*AdminVideo, int64, error)
+	ListAllVideos(ctx context.Context, page pagination.Request) ([]*AdminVideo, int64, error)
 	DeleteVideo(ctx context.Context, id uint64) error
 	CreateTag(ctx context.Context, tag *AdminTag) (*AdminTag, error)
 	UpdateTag(ctx context.Context, tag *AdminTag) (*AdminTag, error)
 	DeleteTag(ctx context.Context, id uint64) error
 	FindTagByID(ctx context.Context, id uint64) (*AdminTag, error)
 	FindTagByName(ctx context.Context, name string) (*AdminTag, error)
+	TopSearchQueries(ctx context.Context, since time.Time, limit int) ([]*AdminSearchQueryStat, error)
+	TopZeroResultQueries(ctx context.Context, since time.Time, limit int) ([]*AdminSearchQueryStat, error)
+	SearchQueryCTR(ctx context.Context, since time.Time, query string, limit int) ([]*AdminSearchQueryStat, error)
+	ListSynonymGroups(ctx context.Context, offset, limit int) ([]*AdminSynonymGroup, int64, error)
+	FindSynonymGroupByID(ctx context.Context, id uint64) (*AdminSynonymGroup, error)
+	// FindSynonymGroupIDsByTerms maps each already-registered term to its group ID.
+	FindSynonymGroupIDsByTerms(ctx context.Context, terms []string) (map[string]uint64, error)
+	// CreateSynonymGroup and UpdateSynonymGroup fail with
+	// ErrSynonymTermExists if another group has one of the terms.
+	CreateSynonymGroup(ctx context.Context, group *AdminSynonymGroup) (*AdminSynonymGroup, error)
+	UpdateSynonymGroup(ctx context.Context, group *AdminSynonymGroup) (*AdminSynonymGroup, error)
+	DeleteSynonymGroup(ctx context.Context, id uint64) error
+	ListSpellingCorrections(ctx context.Context, offset, limit int) ([]*AdminSpellingCorrection, int64, error)
+	FindSpellingCorrectionByID(ctx context.Context, id uint64) (*AdminSpellingCorrection, error)
+	FindSpellingCorrectionByMisspelling(ctx context.Context, misspelling string) (*AdminSpellingCorrection, error)
+	CreateSpellingCorrection(ctx context.Context, c *AdminSpellingCorrection) (*AdminSpellingCorrection, error)
+	UpdateSpellingCorrection(ctx context.Context, c *AdminSpellingCorrection) (*AdminSpellingCorrection, error)
+	DeleteSpellingCorrection(ctx context.Context, id uint64) error
+	ListDuplicateContent(ctx context.Context, offset, limit int) ([]*AdminDuplicateGroup, int64, error)
 }
 
 type AdminUsecase struct {
-	repo AdminRepo
-	log  *log.Helper
+	repo    AdminRepo
+	cursors *pagination.CursorCodec
+	log     *log.Helper
 }
 
-func NewAdminUsecase(repo AdminRepo, logger log.Logger) *AdminUsecase {
+func NewAdminUsecase(repo AdminRepo, cursors *pagination.CursorCodec, logger log.Logger) *AdminUsecase {
 	return &AdminUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:    repo,
+		cursors: cursors,
+		log:     log.NewHelper(logger),
 	}
 }
 
-func (uc *AdminUsecase) ListUsers(ctx context.Context, page, pageSize int32) ([]*AdminUser, int64, error) {
-	offset, limit := pagination.Normalize(page, pageSize)
-	return uc.repo.ListUsers(ctx, offset, limit)
+// ListUsers pages users newest first. With a cursor the total is not counted.
+func (uc *AdminUsecase) ListUsers(ctx context.Context, page, pageSize int32, cursor string) ([]*AdminUser, int64, string, error) {
+	req, limit, err := newPageRequest(uc.cursors, page, pageSize, cursor, sortIDDesc)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	users, total, err := uc.repo.ListUsers(ctx, req)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	users, next := nextPage(uc.cursors, users, limit, func(u *AdminUser) *pagination.Cursor {
+		return &pagination.Cursor{Sort: sortIDDesc, ID: u.ID}
+	})
+	return users, total, next, nil
 }
 
 func (uc *AdminUsecase) DeleteUser(ctx context.Context, callerID, targetID uint64) error {
@@ -86,9 +169,20 @@ func (uc *AdminUsecase) DeleteUser(ctx context.Context, callerID, targetID uint6
 	return nil
 }
 
-func (uc *AdminUsecase) ListAllVideos(ctx context.Context, page, pageSize int32) ([]*AdminVideo, int64, error) {
-	offset, limit := pagination.Normalize(page, pageSize)
-	return uc.repo.ListAllVideos(ctx, offset, limit)
+// ListAllVideos pages videos newest first. With a cursor the total is not counted.
+func (uc *AdminUsecase) ListAllVideos(ctx context.Context, page, pageSize int32, cursor string) ([]*AdminVideo, int64, string, error) {
+	req, limit, err := newPageRequest(uc.cursors, page, pageSize, cursor, sortIDDesc)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	videos, total, err := uc.repo.ListAllVideos(ctx, req)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	videos, next := nextPage(uc.cursors, videos, limit, func(v *AdminVideo) *pagination.Cursor {
+		return &pagination.Cursor{Sort: sortIDDesc, ID: v.ID}
+	})
+	return videos, total, next, nil
 }
 
 func (uc *AdminUsecase) DeleteVideo(ctx context.Context, videoID uint64) error {
@@ -141,3 +235,233 @@ func (uc *AdminUsecase) DeleteTag(ctx context.Context, tagID uint64) error {
 	}
 	return nil
 }
+
+func (uc *AdminUsecase) TopSearchQueries(ctx context.Context, days, limit int32) ([]*AdminSearchQueryStat, error) {
+	since, n := normalizeSearchStatsWindow(days, limit)
+	stats, err := uc.repo.TopSearchQueries(ctx, since, n)
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to load search stats")
+	}
+	return stats, nil
+}
+
+func (uc *AdminUsecase) TopZeroResultQueries(ctx context.Context, days, limit int32) ([]*AdminSearchQueryStat, error) {
+	since, n := normalizeSearchStatsWindow(days, limit)
+	stats, err := uc.repo.TopZeroResultQueries(ctx, since, n)
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to load search stats")
+	}
+	return stats, nil
+}
+
+// SearchQueryCTR returns click-through stats per query. An empty query lists
+// the most searched queries; otherwise only that (normalized) query.
+func (uc *AdminUsecase) SearchQueryCTR(ctx context.Context, days, limit int32, query string) ([]*AdminSearchQueryStat, error) {
+	since, n := normalizeSearchStatsWindow(days, limit)
+	stats, err := uc.repo.SearchQueryCTR(ctx, since, NormalizeSearchQuery(query), n)
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to load search stats")
+	}
+	return stats, nil
+}
+
+// normalizeSearchStatsWindow turns a day count into the first day (local
+// midnight) of the window and clamps the row limit.
+func normalizeSearchStatsWindow(days, limit int32) (time.Time, int) {
+	if days < 1 {
+		days = defaultSearchStatsDays
+	}
+	if days > maxSearchStatsDays {
+		days = maxSearchStatsDays
+	}
+	if limit < 1 {
+		limit = defaultSearchStatsLimit
+	}
+	if limit > maxSearchStatsLimit {
+		limit = maxSearchStatsLimit
+	}
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return today.AddDate(0, 0, -int(days-1)), int(limit)
+}
+
+func (uc *AdminUsecase) ListSynonymGroups(ctx context.Context, page, pageSize int32) ([]*AdminSynonymGroup, int64, error) {
+	offset, limit := pagination.Normalize(page, pageSize)
+	return uc.repo.ListSynonymGroups(ctx, offset, limit)
+}
+
+// ListDuplicateContent lists content hashes shared by uploads of different
+// accounts, most recently uploaded first.
+func (uc *AdminUsecase) ListDuplicateContent(ctx context.Context, page, pageSize int32) ([]*AdminDuplicateGroup, int64, error) {
+	offset, limit := pagination.Normalize(page, pageSize)
+	return uc.repo.ListDuplicateContent(ctx, offset, limit)
+}
+
+func (uc *AdminUsecase) CreateSynonymGroup(ctx context.Context, terms []string) (*AdminSynonymGroup, error) {
+	normalized, err := normalizeSynonymTerms(terms)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkSynonymTerms(ctx, 0, normalized); err != nil {
+		return nil, err
+	}
+
+	created, err := uc.repo.CreateSynonymGroup(ctx, &AdminSynonymGroup{Terms: normalized})
+	if errors.Is(err, ErrSynonymTermExists) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to create synonym group")
+	}
+	return created, nil
+}
+
+func (uc *AdminUsecase) UpdateSynonymGroup(ctx context.Context, id uint64, terms []string) (*AdminSynonymGroup, error) {
+	if _, err := uc.repo.FindSynonymGroupByID(ctx, id); err != nil {
+		return nil, errors.NotFound("SYNONYM_GROUP_NOT_FOUND", "synonym group not found")
+	}
+	normalized, err := normalizeSynonymTerms(terms)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.checkSynonymTerms(ctx, id, normalized); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.repo.UpdateSynonymGroup(ctx, &AdminSynonymGroup{ID: id, Terms: normalized})
+	if errors.Is(err, ErrSynonymTermExists) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to update synonym group")
+	}
+	return updated, nil
+}
+
+func (uc *AdminUsecase) DeleteSynonymGroup(ctx context.Context, id uint64) error {
+	if _, err := uc.repo.FindSynonymGroupByID(ctx, id); err != nil {
+		return errors.NotFound("SYNONYM_GROUP_NOT_FOUND", "synonym group not found")
+	}
+	if err := uc.repo.DeleteSynonymGroup(ctx, id); err != nil {
+		return errors.InternalServer("INTERNAL", "failed to delete synonym group")
+	}
+	return nil
+}
+
+// ErrSynonymTermExists is returned when a term is claimed by another group
+// between checkSynonymTerms and the write.
+var ErrSynonymTermExists = errors.Conflict("SYNONYM_TERM_EXISTS", "term already belongs to another synonym group")
+
+// checkSynonymTerms rejects terms that already belong to another group:
+// a term in two groups would make query expansion ambiguous.
+func (uc *AdminUsecase) checkSynonymTerms(ctx context.Context, groupID uint64, terms []string) error {
+	owners, err := uc.repo.FindSynonymGroupIDsByTerms(ctx, terms)
+	if err != nil {
+		return errors.InternalServer("INTERNAL", "failed to check synonym terms")
+	}
+	for _, t := range terms {
+		if owner, ok := owners[t]; ok && owner != groupID {
+			return errors.Conflict("SYNONYM_TERM_EXISTS", "term already belongs to another synonym group: "+t)
+		}
+	}
+	return nil
+}
+
+func (uc *AdminUsecase) ListSpellingCorrections(ctx context.Context, page, pageSize int32) ([]*AdminSpellingCorrection, int64, error) {
+	offset, limit := pagination.Normalize(page, pageSize)
+	return uc.repo.ListSpellingCorrections(ctx, offset, limit)
+}
+
+func (uc *AdminUsecase) CreateSpellingCorrection(ctx context.Context, c *AdminSpellingCorrection) (*AdminSpellingCorrection, error) {
+	if err := normalizeSpellingCorrection(c); err != nil {
+		return nil, err
+	}
+	existing, _ := uc.repo.FindSpellingCorrectionByMisspelling(ctx, c.Misspelling)
+	if existing != nil {
+		return nil, errors.Conflict("SPELLING_CORRECTION_EXISTS", "misspelling already has a correction")
+	}
+
+	created, err := uc.repo.CreateSpellingCorrection(ctx, c)
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to create spelling correction")
+	}
+	return created, nil
+}
+
+func (uc *AdminUsecase) UpdateSpellingCorrection(ctx context.Context, c *AdminSpellingCorrection) (*AdminSpellingCorrection, error) {
+	if _, err := uc.repo.FindSpellingCorrectionByID(ctx, c.ID); err != nil {
+		return nil, errors.NotFound("SPELLING_CORRECTION_NOT_FOUND", "spelling correction not found")
+	}
+	if err := normalizeSpellingCorrection(c); err != nil {
+		return nil, err
+	}
+	existing, _ := uc.repo.FindSpellingCorrectionByMisspelling(ctx, c.Misspelling)
+	if existing != nil && existing.ID != c.ID {
+		return nil, errors.Conflict("SPELLING_CORRECTION_EXISTS", "misspelling already has a correction")
+	}
+
+	updated, err := uc.repo.UpdateSpellingCorrection(ctx, c)
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to update spelling correction")
+	}
+	return updated, nil
+}
+
+func (uc *AdminUsecase) DeleteSpellingCorrection(ctx context.Context, id uint64) error {
+	if _, err := uc.repo.FindSpellingCorrectionByID(ctx, id); err != nil {
+		return errors.NotFound("SPELLING_CORRECTION_NOT_FOUND", "spelling correction not found")
+	}
+	if err := uc.repo.DeleteSpellingCorrection(ctx, id); err != nil {
+		return errors.InternalServer("INTERNAL", "failed to delete spelling correction")
+	}
+	return nil
+}
+
+// normalizeSynonymTerms normalizes and de-duplicates terms the same way
+// search queries are normalized. A group needs at least two distinct terms.
+func normalizeSynonymTerms(terms []string) ([]string, error) {
+	seen := make(map[string]bool, len(terms))
+	result := make([]string, 0, len(terms))
+	for _, t := range terms {
+		t = NormalizeSearchQuery(t)
+		if t == "" || seen[t] {
+			continue
+		}
+		if err := validateSearchTerm(t, "SYNONYM_GROUP_INVALID"); err != nil {
+			return nil, err
+		}
+		seen[t] = true
+		result = append(result, t)
+	}
+	if len(result) < 2 {
+		return nil, errors.BadRequest("SYNONYM_GROUP_INVALID", "a synonym group needs at least two distinct terms")
+	}
+	return result, nil
+}
+
+func normalizeSpellingCorrection(c *AdminSpellingCorrection) error {
+	c.Misspelling = NormalizeSearchQuery(c.Misspelling)
+	c.Correction = NormalizeSearchQuery(c.Correction)
+	if c.Misspelling == "" || c.Correction == "" {
+		return errors.BadRequest("SPELLING_CORRECTION_INVALID", "misspelling and correction are required")
+	}
+	if c.Misspelling == c.Correction {
+		return errors.BadRequest("SPELLING_CORRECTION_INVALID", "correction must differ from the misspelling")
+	}
+	if err := validateSearchTerm(c.Misspelling, "SPELLING_CORRECTION_INVALID"); err != nil {
+		return err
+	}
+	return validateSearchTerm(c.Correction, "SPELLING_CORRECTION_INVALID")
+}
+
+// validateSearchTerm rejects terms that cannot be spliced safely into a
+// FULLTEXT BOOLEAN MODE expression.
+func validateSearchTerm(term, reason string) error {
+	if utf8.RuneCountInString(term) > maxSearchTermRunes {
+		return errors.BadRequest(reason, "term is too long: "+term)
+	}
+	if strings.ContainsAny(term, `"()<>~*@+`) {
+		return errors.BadRequest(reason, "term contains search operators: "+term)
+	}
+	return nil
+}
@@ -11,4 +11,11 @@ var ProviderSet = wire.NewSet(
 	NewSearchUsecase,
 	NewChannelUsecase,
 	NewAdminUsecase,
+	NewUploadUsecase,
+	NewTranscodeUsecase,
+	NewCaptionUsecase,
+	NewStorageUsecase,
+	NewTrashUsecase,
+	NewQuotaUsecase,
+	NewCursorCodec,
 )
@@ -0,0 +1,174 @@
+package biz
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"backend/internal/pkg/caption"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// MaxCaptionSize is the largest caption file accepted, in bytes.
+const MaxCaptionSize = 1 << 20
+
+// Caption is a WebVTT caption track of a video in one language.
+type Caption struct {
+	ID        uint64
+	VideoID   uint64
+	Language  string // BCP 47, e.g. "en" or "zh-TW"
+	Label     string
+	URL       string
+	Text      string // cue text without timings, for search
+	UpdatedAt time.Time
+}
+
+type CaptionRepo interface {
+	// Save stores vtt and creates or replaces the track of caption.Language.
+	Save(ctx context.Context, caption *Caption, vtt []byte) (*Caption, error)
+	List(ctx context.Context, videoID uint64) ([]*Caption, error)
+	// Delete returns ErrCaptionNotFound if the video has no such track.
+	Delete(ctx context.Context, videoID uint64, language string) error
+}
+
+var (
+	ErrCaptionNotFound        = errors.NotFound("CAPTION_NOT_FOUND", "caption track not found")
+	ErrCaptionLanguageInvalid = errors.BadRequest("CAPTION_LANGUAGE_INVALID", "language must be a BCP 47 tag such as en or zh-TW")
+)
+
+type CaptionUsecase struct {
+	repo   CaptionRepo
+	videos *VideoUsecase
+	log    *log.Helper
+}
+
+func NewCaptionUsecase(repo CaptionRepo, videos *VideoUsecase, logger log.Logger) *CaptionUsecase {
+	return &CaptionUsecase{
+		repo:   repo,
+		videos: videos,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// ListCaptions returns a video's tracks with signed URLs to anyone who may
+// watch it.
+func (uc *CaptionUsecase) ListCaptions(ctx context.Context, videoID uint64, viewerID *uint64, viewerRole string) ([]*Caption, error) {
+	if _, err := uc.videos.CheckAccess(ctx, videoID, viewerID, viewerRole); err != nil {
+		return nil, err
+	}
+	captions, err := uc.repo.List(ctx, videoID)
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to list captions")
+	}
+	return uc.videos.signCaptions(captions, viewerID), nil
+}
+
+// UploadCaption validates a WebVTT or SRT file and stores it as WebVTT,
+// replacing the video's track in the same language.
+func (uc *CaptionUsecase) UploadCaption(ctx context.Context, userID uint64, c *Caption, format string, content []byte) (*Caption, error) {
+	video, err := uc.videos.repo.FindByID(ctx, c.VideoID)
+	if err != nil {
+		return nil, errors.NotFound("VIDEO_NOT_FOUND", "video not found")
+	}
+	if video.UserID != userID {
+		return nil, errors.Forbidden("VIDEO_NOT_OWNER", "not the owner of this video")
+	}
+	lang, ok := normalizeLanguage(c.Language)
+	if !ok {
+		return nil, ErrCaptionLanguageInvalid
+	}
+	c.Language = lang
+	c.Label = strings.TrimSpace(c.Label)
+	if c.Label == "" {
+		c.Label = lang
+	}
+	if len(c.Label) > 100 {
+		return nil, errors.BadRequest("CAPTION_INVALID", "label must be at most 100 bytes")
+	}
+
+	if len(content) > MaxCaptionSize {
+		return nil, errors.BadRequest("CAPTION_INVALID", "caption file must be at most 1MB")
+	}
+	track, err := caption.Parse(content, strings.ToLower(format))
+	if err != nil {
+		if errors.Is(err, caption.ErrInvalid) {
+			return nil, errors.BadRequest("CAPTION_INVALID", err.Error())
+		}
+		return nil, errors.InternalServer("INTERNAL", "failed to read caption file")
+	}
+	if len(track.Cues) == 0 {
+		return nil, errors.BadRequest("CAPTION_INVALID", "caption file has no cues")
+	}
+	if last := track.Cues[len(track.Cues)-1]; video.Duration > 0 && last.Start >= time.Duration(video.Duration)*time.Second {
+		return nil, errors.BadRequest("CAPTION_INVALID", "captions start after the video ends")
+	}
+	c.Text = track.Text()
+
+	saved, err := uc.repo.Save(ctx, c, track.WebVTT())
+	if err != nil {
+		uc.log.Errorf("save %s captions of video %d: %v", lang, c.VideoID, err)
+		return nil, errors.InternalServer("INTERNAL", "failed to store captions")
+	}
+	uid := userID
+	return uc.videos.signCaptions([]*Caption{saved}, &uid)[0], nil
+}
+
+func (uc *CaptionUsecase) DeleteCaption(ctx context.Context, userID, videoID uint64, language string) error {
+	video, err := uc.videos.repo.FindByID(ctx, videoID)
+	if err != nil {
+		return errors.NotFound("VIDEO_NOT_FOUND", "video not found")
+	}
+	if video.UserID != userID {
+		return errors.Forbidden("VIDEO_NOT_OWNER", "not the owner of this video")
+	}
+	lang, ok := normalizeLanguage(language)
+	if !ok {
+		return ErrCaptionNotFound
+	}
+	if err := uc.repo.Delete(ctx, videoID, lang); err != nil {
+		if errors.Is(err, ErrCaptionNotFound) {
+			return err
+		}
+		return errors.InternalServer("INTERNAL", "failed to delete captions")
+	}
+	return nil
+}
+
+// signCaptions replaces the stored URLs of captions with URLs signed for
+// the viewer, as for the video itself.
+func (uc *VideoUsecase) signCaptions(captions []*Caption, viewerID *uint64) []*Caption {
+	var uid uint64
+	if viewerID != nil {
+		uid = *viewerID
+	}
+	for _, c := range captions {
+		c.URL = uc.playback.SignURL(c.URL, uid)
+	}
+	return captions
+}
+
+var languageTag = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$`)
+
+// normalizeLanguage checks the shape of a BCP 47 tag and returns it in
+// canonical case: "ZH-tw" becomes "zh-TW", "sr-latn" becomes "sr-Latn".
+func normalizeLanguage(tag string) (string, bool) {
+	if len(tag) > 35 || !languageTag.MatchString(tag) {
+		return "", false
+	}
+	parts := strings.Split(tag, "-")
+	parts[0] = strings.ToLower(parts[0])
+	for i := 1; i < len(parts); i++ {
+		switch p := parts[i]; {
+		case len(p) == 2:
+			parts[i] = strings.ToUpper(p) // region
+		case len(p) == 4:
+			parts[i] = strings.ToUpper(p[:1]) + strings.ToLower(p[1:]) // script
+		default:
+			parts[i] = strings.ToLower(p)
+		}
+	}
+	return strings.Join(parts, "-"), true
+}
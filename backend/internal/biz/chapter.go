@@ -0,0 +1,133 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+const (
+	maxChapters        = 100
+	maxChapterTitleLen = 100 // characters
+)
+
+// Chapter is a titled section of a video, running until the next chapter
+// starts or the video ends.
+type Chapter struct {
+	Start uint32 // seconds
+	Title string
+}
+
+func errChapterInvalid(format string, args ...interface{}) error {
+	return errors.BadRequest("CHAPTER_INVALID", fmt.Sprintf(format, args...))
+}
+
+// validateChapters requires a first chapter at 0:00, strictly increasing
+// starts inside the video (when its duration is known) and titles.
+func validateChapters(chapters []*Chapter, duration uint32) error {
+	if len(chapters) > maxChapters {
+		return errChapterInvalid("at most %d chapters allowed", maxChapters)
+	}
+	for i, c := range chapters {
+		c.Title = strings.TrimSpace(c.Title)
+		if c.Title == "" {
+			return errChapterInvalid("chapter %d has no title", i+1)
+		}
+		if utf8.RuneCountInString(c.Title) > maxChapterTitleLen {
+			return errChapterInvalid("chapter %d title exceeds %d characters", i+1, maxChapterTitleLen)
+		}
+		if i == 0 && c.Start != 0 {
+			return errChapterInvalid("the first chapter must start at 0:00")
+		}
+		if i > 0 && c.Start <= chapters[i-1].Start {
+			return errChapterInvalid("chapter %d must start after chapter %d", i+1, i)
+		}
+		if duration > 0 && c.Start >= duration {
+			return errChapterInvalid("chapter %d starts after the video ends", i+1)
+		}
+	}
+	return nil
+}
+
+// chapterLine matches description lines such as "00:00 Intro",
+// "1:02:03 - Q&A" or "(12:30) Outro".
+var chapterLine = regexp.MustCompile(`^\(?(?:(\d{1,2}):)?(\d{1,2}):(\d{2})\)?\s*[-–—:|]?\s+(\S.*)$`)
+
+// parseChapters collects the timestamp lines of a description. It returns
+// nil unless they form a valid chapter list of at least two chapters, so
+// descriptions that merely mention a time are left alone.
+func parseChapters(description string, duration uint32) []*Chapter {
+	var chapters []*Chapter
+	for _, line := range strings.Split(description, "\n") {
+		m := chapterLine.FindStringSubmatch(strings.TrimSpace(line))
+		if m == nil {
+			continue
+		}
+		h, _ := strconv.Atoi(m[1])
+		min, _ := strconv.Atoi(m[2])
+		sec, _ := strconv.Atoi(m[3])
+		if sec > 59 || (m[1] != "" && min > 59) {
+			continue
+		}
+		chapters = append(chapters, &Chapter{
+			Start: uint32(h*3600 + min*60 + sec),
+			Title: m[4],
+		})
+	}
+	if len(chapters) < 2 || validateChapters(chapters, duration) != nil {
+		return nil
+	}
+	return chapters
+}
+
+// chaptersFromDescription replaces a video's chapters with those listed in
+// its description, if it lists any. Chapters set explicitly survive
+// descriptions without a chapter list.
+func (uc *VideoUsecase) chaptersFromDescription(ctx context.Context, videoID uint64, description string, duration uint32) {
+	chapters := parseChapters(description, duration)
+	if chapters == nil {
+		return
+	}
+	if err := uc.repo.ReplaceChapters(ctx, videoID, chapters); err != nil {
+		uc.log.Warnf("store chapters of video %d: %v", videoID, err)
+	}
+}
+
+// SetChapters replaces a video's chapters; an empty list removes them.
+func (uc *VideoUsecase) SetChapters(ctx context.Context, userID, videoID uint64, chapters []*Chapter) (*Video, error) {
+	video, err := uc.repo.FindByID(ctx, videoID)
+	if err != nil {
+		return nil, errors.NotFound("VIDEO_NOT_FOUND", "video not found")
+	}
+	if video.UserID != userID {
+		return nil, errors.Forbidden("VIDEO_NOT_OWNER", "not the owner of this video")
+	}
+	if err := validateChapters(chapters, video.Duration); err != nil {
+		return nil, err
+	}
+	if err := uc.repo.ReplaceChapters(ctx, videoID, chapters); err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to set chapters")
+	}
+	video.Chapters = chapters
+	return video, nil
+}
+
+// GetChapters returns a video's chapters to anyone who may watch it, along
+// with the video (for its duration).
+func (uc *VideoUsecase) GetChapters(ctx context.Context, videoID uint64, viewerID *uint64, viewerRole string) (*Video, error) {
+	video, err := uc.CheckAccess(ctx, videoID, viewerID, viewerRole)
+	if err != nil {
+		return nil, err
+	}
+	chapters, err := uc.repo.ListChapters(ctx, videoID)
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to list chapters")
+	}
+	video.Chapters = chapters
+	return video, nil
+}
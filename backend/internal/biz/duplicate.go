@@ -0,0 +1,113 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var ErrUploadDuplicate = errors.Conflict("UPLOAD_DUPLICATE", "this video is already published by another creator")
+
+// duplicateOf looks for stored objects with the content of u, whose
+// SHA256 is known. A copy of another creator's published video comes back
+// as ErrUploadDuplicate along with the original; an identical upload of
+// the same user comes back to be reused instead of u. Lookup failures are
+// logged and treated as no duplicate.
+func (uc *UploadUsecase) duplicateOf(ctx context.Context, u *Upload) (*Upload, error) {
+	uploads, err := uc.repo.FindUploadsByHash(ctx, u.SHA256)
+	if err != nil {
+		uc.log.Warnf("find duplicates of %s: %v", u.ObjectName, err)
+		return nil, nil
+	}
+	var (
+		names []string
+		own   *Upload
+	)
+	byName := make(map[string]*Upload, len(uploads))
+	for _, d := range uploads {
+		if d.ObjectName == u.ObjectName {
+			continue
+		}
+		names = append(names, d.ObjectName)
+		byName[d.ObjectName] = d
+		if own == nil && d.UserID == u.UserID && d.Kind == u.Kind {
+			own = d
+		}
+	}
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	if u.Kind == "video" {
+		source, err := uc.repo.FindPublishedSource(ctx, names, u.UserID)
+		if err != nil {
+			uc.log.Warnf("find published duplicates of %s: %v", u.ObjectName, err)
+		} else if source != "" {
+			uc.log.Warnf("user %d uploaded a copy of published %s", u.UserID, source)
+			return byName[source], ErrUploadDuplicate
+		}
+	}
+	return own, nil
+}
+
+// discardDuplicate deletes the object of a new upload that turned out to
+// duplicate an existing one. If recordAs is set the attempt is recorded
+// with that status, so it shows in the duplicate content report.
+func (uc *UploadUsecase) discardDuplicate(ctx context.Context, u *Upload, original *Upload, recordAs string) {
+	if err := uc.uploader.Delete(ctx, u.ObjectName); err != nil {
+		uc.log.Warnf("delete duplicate upload %s: %v", u.ObjectName, err)
+	}
+	if recordAs == "" {
+		return
+	}
+	u.Status = recordAs
+	u.DuplicateOf = original.ObjectName
+	if _, err := uc.repo.CreateUpload(ctx, u); err != nil {
+		uc.log.Warnf("record duplicate upload %s: %v", u.ObjectName, err)
+	}
+}
+
+// ResolveSource checks the source a new video is created from: an upload
+// of the user's, or an object stored before uploads were recorded that
+// one of the user's videos already uses. An upload discarded as a
+// duplicate resolves to the copy kept instead; anything else is refused.
+func (uc *UploadUsecase) ResolveSource(ctx context.Context, userID uint64, videoURL string) (string, error) {
+	objectName, ok := uc.uploader.ObjectName(videoURL)
+	if !ok {
+		return "", ErrVideoSourceInvalid
+	}
+	u, err := uc.repo.GetUpload(ctx, objectName)
+	if err != nil {
+		uc.log.Errorf("load upload %s: %v", objectName, err)
+		return "", errors.InternalServer("INTERNAL", "failed to load upload")
+	}
+	switch {
+	case u == nil:
+		return uc.resolveLegacySource(ctx, userID, videoURL)
+	case u.UserID != userID:
+		return "", errors.NotFound("UPLOAD_NOT_FOUND", "upload not found")
+	case u.Status == UploadRejected && u.DuplicateOf != "":
+		return "", ErrUploadDuplicate
+	case u.Status == UploadRejected:
+		return "", ErrUploadRejected
+	case u.DuplicateOf != "":
+		return uc.uploader.GetURL(u.DuplicateOf), nil
+	case u.Status == UploadDeleted:
+		return "", errors.NotFound("UPLOAD_NOT_FOUND", "upload not found")
+	}
+	return videoURL, nil
+}
+
+// resolveLegacySource accepts an unrecorded object only if one of the
+// user's own videos uses it, so nobody can claim another user's file.
+func (uc *UploadUsecase) resolveLegacySource(ctx context.Context, userID uint64, videoURL string) (string, error) {
+	ok, err := uc.repo.HasSource(ctx, userID, videoURL)
+	if err != nil {
+		uc.log.Errorf("look up source %s of user %d: %v", videoURL, userID, err)
+		return "", errors.InternalServer("INTERNAL", "failed to load upload")
+	}
+	if !ok {
+		return "", errors.NotFound("UPLOAD_NOT_FOUND", "upload not found")
+	}
+	return videoURL, nil
+}
@@ -0,0 +1,185 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"time"
+
+	"backend/internal/pkg/filetype"
+	"backend/internal/pkg/imagemeta"
+	"backend/internal/pkg/upload"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// verifyTimeout bounds reading a stored video back to hash and scan it.
+const verifyTimeout = 2 * time.Hour
+
+// ScanResult is a malware scanner's verdict on a file.
+type ScanResult struct {
+	Infected  bool
+	Signature string // what was found, e.g. "Eicar-Signature"
+}
+
+// Scanner checks file contents for malware. Scan may stop reading early,
+// e.g. past the largest file it inspects, and then reports what it saw.
+type Scanner interface {
+	Scan(ctx context.Context, r io.Reader) (*ScanResult, error)
+}
+
+var (
+	ErrUploadContentMismatch = errors.BadRequest("UPLOAD_CONTENT_MISMATCH", "file content does not match its content type")
+	ErrUploadRejected        = errors.BadRequest("UPLOAD_REJECTED", "file was rejected by the malware scanner")
+)
+
+// checkHead rejects a file whose leading bytes are not of contentType, such
+// as an HTML page labelled video/mp4.
+func checkHead(contentType string, head []byte) error {
+	if !filetype.Matches(contentType, head) {
+		return ErrUploadContentMismatch
+	}
+	return nil
+}
+
+// inspectImage checks an image's signature, strips its metadata and scans
+// it, returning the cleaned image and its SHA-256. Images are small enough
+// to do this before they are stored.
+func (uc *UploadUsecase) inspectImage(ctx context.Context, contentType string, data []byte) ([]byte, string, error) {
+	if err := checkHead(contentType, data); err != nil {
+		return nil, "", err
+	}
+	data, err := imagemeta.Strip(data, contentType)
+	if err != nil {
+		return nil, "", ErrUploadContentMismatch
+	}
+	if uc.scanner != nil {
+		result, err := uc.scanner.Scan(ctx, bytes.NewReader(data))
+		switch {
+		case err != nil:
+			// A scanner outage must not stop uploads.
+			uc.log.Warnf("scan %s upload: %v", contentType, err)
+		case result.Infected:
+			uc.log.Warnf("rejected %s upload: %s", contentType, result.Signature)
+			return nil, "", ErrUploadRejected
+		}
+	}
+	sum := sha256.Sum256(data)
+	return data, hex.EncodeToString(sum[:]), nil
+}
+
+// inspectStored checks an object uploaded straight to MinIO. Images are
+// cleaned in place, keeping the object's metadata; videos only have their
+// signature checked here and are verified later. It returns the object's
+// size and, for images, SHA-256.
+func (uc *UploadUsecase) inspectStored(ctx context.Context, kind uploadKind, objectName string, info *upload.ObjectInfo) (int64, string, error) {
+	obj, _, err := uc.uploader.Open(ctx, objectName)
+	if err != nil {
+		return 0, "", err
+	}
+	defer obj.Close()
+
+	if !kind.image {
+		head := make([]byte, filetype.HeadSize)
+		n, err := io.ReadFull(obj, head)
+		if err != nil && err != io.ErrUnexpectedEOF {
+			return 0, "", err
+		}
+		return info.Size, "", checkHead(info.ContentType, head[:n])
+	}
+
+	data, err := io.ReadAll(obj)
+	if err != nil {
+		return 0, "", err
+	}
+	cleaned, sum, err := uc.inspectImage(ctx, info.ContentType, data)
+	if err != nil {
+		return 0, "", err
+	}
+	if len(cleaned) != len(data) {
+		err := uc.uploader.PutWithMetadata(ctx, objectName, bytes.NewReader(cleaned), int64(len(cleaned)), info.ContentType, info.UserMetadata)
+		if err != nil {
+			return 0, "", err
+		}
+	}
+	return int64(len(cleaned)), sum, nil
+}
+
+// verifyLater hashes (unless u.SHA256 is known) and scans a stored video
+// in the background, since videos are too large to wait for. Infected
+// uploads are deleted and rejected. Images were inspected before storing.
+func (uc *UploadUsecase) verifyLater(u *Upload) {
+	if uploadKinds[u.Kind].image || u.SHA256 != "" && uc.scanner == nil {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), verifyTimeout)
+		defer cancel()
+		if err := uc.verify(ctx, u); err != nil {
+			uc.log.Warnf("verify upload %s: %v", u.ObjectName, err)
+		}
+	}()
+}
+
+func (uc *UploadUsecase) verify(ctx context.Context, u *Upload) error {
+	obj, _, err := uc.uploader.Open(ctx, u.ObjectName)
+	if err != nil {
+		return err
+	}
+	defer obj.Close()
+
+	h := sha256.New()
+	var r io.Reader = obj
+	if u.SHA256 == "" {
+		r = io.TeeReader(obj, h)
+	}
+	if uc.scanner != nil {
+		result, err := uc.scanner.Scan(ctx, r)
+		switch {
+		case err != nil:
+			uc.log.Warnf("scan upload %s: %v", u.ObjectName, err)
+		case result.Infected:
+			uc.log.Warnf("rejected upload %s of user %d: %s", u.ObjectName, u.UserID, result.Signature)
+			if err := uc.uploader.Delete(ctx, u.ObjectName); err != nil {
+				uc.log.Warnf("delete rejected upload %s: %v", u.ObjectName, err)
+			}
+			return uc.repo.RejectUpload(ctx, u.ObjectName, "")
+		}
+	}
+	if u.SHA256 != "" {
+		return nil
+	}
+	// The scanner may have stopped early; hash the rest.
+	if _, err := io.Copy(io.Discard, r); err != nil {
+		return err
+	}
+	u.SHA256 = hex.EncodeToString(h.Sum(nil))
+	if err := uc.repo.SetUploadHash(ctx, u.ObjectName, u.SHA256); err != nil {
+		return err
+	}
+	return uc.dedupeStored(ctx, u)
+}
+
+// dedupeStored handles duplicates found once a stored upload is hashed. A
+// copy of another creator's published video is rejected. A copy of the
+// user's own upload is deleted unless a video already uses it;
+// ResolveSource then points CreateVideo at the original.
+func (uc *UploadUsecase) dedupeStored(ctx context.Context, u *Upload) error {
+	original, err := uc.duplicateOf(ctx, u)
+	if errors.Is(err, ErrUploadDuplicate) {
+		if err := uc.uploader.Delete(ctx, u.ObjectName); err != nil {
+			uc.log.Warnf("delete duplicate upload %s: %v", u.ObjectName, err)
+		}
+		return uc.repo.RejectUpload(ctx, u.ObjectName, original.ObjectName)
+	}
+	if original == nil {
+		return nil
+	}
+	marked, err := uc.repo.MarkDuplicate(ctx, u.ObjectName, original.ObjectName)
+	if err != nil || !marked {
+		return err
+	}
+	return uc.uploader.Delete(ctx, u.ObjectName)
+}
@@ -0,0 +1,53 @@
+package biz
+
+import (
+	"backend/internal/conf"
+	"backend/internal/pkg/hash"
+	"backend/internal/pkg/pagination"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Sort modes recorded in cursors, so a cursor from one ordering cannot be
+// replayed against another.
+const (
+	sortIDDesc    = "id_desc"
+	sortDateDesc  = "date_desc"
+	sortDateAsc   = "date_asc"
+	sortViewsDesc = "views_desc"
+	sortViewsAsc  = "views_asc"
+	sortShuffle   = "shuffle"
+)
+
+// NewCursorCodec signs list cursors with a key derived from the JWT secret.
+func NewCursorCodec(c *conf.Auth) *pagination.CursorCodec {
+	return pagination.NewCursorCodec(hash.DeriveKey(c.JwtSecret, "cursor"))
+}
+
+// newPageRequest builds a pagination.Request from page/page_size or, when
+// cursor is set, from the decoded keyset position. One extra row is
+// requested so nextPage can tell whether another page exists.
+func newPageRequest(codec *pagination.CursorCodec, page, pageSize int32, cursor, sort string) (pagination.Request, int, error) {
+	offset, limit := pagination.Normalize(page, pageSize)
+	req := pagination.Request{Offset: offset, Limit: limit + 1}
+	if cursor != "" {
+		after, err := codec.Decode(cursor, sort)
+		if err != nil {
+			return req, limit, errors.BadRequest("CURSOR_INVALID", "invalid cursor")
+		}
+		req.Offset = 0
+		req.After = after
+		req.Seed = after.Seed
+	}
+	return req, limit, nil
+}
+
+// nextPage trims the extra row fetched by newPageRequest and returns the
+// cursor pointing after the last kept row, or "" on the last page.
+func nextPage[T any](codec *pagination.CursorCodec, items []T, limit int, cursorOf func(T) *pagination.Cursor) ([]T, string) {
+	if len(items) <= limit {
+		return items, ""
+	}
+	items = items[:limit]
+	return items, codec.Encode(cursorOf(items[limit-1]))
+}
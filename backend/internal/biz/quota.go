@@ -0,0 +1,141 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// uploadWindow is the period daily upload limits are counted over.
+const uploadWindow = 24 * time.Hour
+
+// DefaultQuota applies to roles without a configured quota.
+var DefaultQuota = Quota{StorageBytes: 10 << 30, DailyUploads: 20}
+
+// Quota caps what a user may upload; zero values are unlimited.
+type Quota struct {
+	StorageBytes int64
+	DailyUploads int32
+}
+
+// StorageUsage is what a user has stored and uploaded recently.
+type StorageUsage struct {
+	Role string
+	// UsedBytes counts uploads not yet used by a video plus everything
+	// stored for the user's live videos, as last tallied by
+	// StorageUsecase.Reconcile.
+	UsedBytes int64
+	// Uploads and Videos count video uploads and created videos since the
+	// start of the upload window, deleted ones included.
+	Uploads int64
+	Videos  int64
+	Quota   Quota
+}
+
+type QuotaRepo interface {
+	// GetStorageUsage returns ErrUserNotFound for unknown users.
+	GetStorageUsage(ctx context.Context, userID uint64, since time.Time) (*StorageUsage, error)
+}
+
+var ErrUserNotFound = errors.NotFound("USER_NOT_FOUND", "user not found")
+
+// QuotaUsecase enforces per-user storage quotas and daily upload limits,
+// which depend on the user's role.
+type QuotaUsecase struct {
+	repo   QuotaRepo
+	quotas map[string]Quota
+	log    *log.Helper
+}
+
+func NewQuotaUsecase(repo QuotaRepo, c *conf.Storage, logger log.Logger) *QuotaUsecase {
+	quotas := make(map[string]Quota, len(c.GetQuotas()))
+	for role, q := range c.GetQuotas() {
+		quotas[role] = Quota{StorageBytes: q.StorageBytes, DailyUploads: q.DailyUploads}
+	}
+	return &QuotaUsecase{
+		repo:   repo,
+		quotas: quotas,
+		log:    log.NewHelper(logger),
+	}
+}
+
+// quotaFor is the quota of a role.
+func (uc *QuotaUsecase) quotaFor(role string) Quota {
+	if q, ok := uc.quotas[role]; ok {
+		return q
+	}
+	return DefaultQuota
+}
+
+// Usage returns a user's usage together with their quota.
+func (uc *QuotaUsecase) Usage(ctx context.Context, userID uint64) (*StorageUsage, error) {
+	usage, err := uc.repo.GetStorageUsage(ctx, userID, time.Now().Add(-uploadWindow))
+	if err != nil {
+		if errors.Is(err, ErrUserNotFound) {
+			return nil, err
+		}
+		uc.log.Errorf("storage usage of user %d: %v", userID, err)
+		return nil, errors.InternalServer("INTERNAL", "failed to load storage usage")
+	}
+	usage.Quota = uc.quotaFor(usage.Role)
+	return usage, nil
+}
+
+// CheckUpload rejects an upload of size bytes that would exceed the user's
+// storage quota, or a video upload beyond their daily limit.
+func (uc *QuotaUsecase) CheckUpload(ctx context.Context, userID uint64, kind string, size int64) error {
+	usage, err := uc.Usage(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if kind == "video" {
+		if err := checkDailyLimit(usage.Quota, usage.Uploads); err != nil {
+			return err
+		}
+	}
+	return checkStorage(usage.Quota, usage.UsedBytes+size)
+}
+
+// CheckVideo rejects creating a video once the user is over their storage
+// quota (the source already counts) or has created their daily limit.
+func (uc *QuotaUsecase) CheckVideo(ctx context.Context, userID uint64) error {
+	usage, err := uc.Usage(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := checkDailyLimit(usage.Quota, usage.Videos); err != nil {
+		return err
+	}
+	return checkStorage(usage.Quota, usage.UsedBytes)
+}
+
+// CheckStorage rejects adding to a user's files once they are over their
+// storage quota, such as replacing a video's source.
+func (uc *QuotaUsecase) CheckStorage(ctx context.Context, userID uint64) error {
+	usage, err := uc.Usage(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return checkStorage(usage.Quota, usage.UsedBytes)
+}
+
+func checkDailyLimit(q Quota, count int64) error {
+	if q.DailyUploads > 0 && count >= int64(q.DailyUploads) {
+		return errors.New(429, "UPLOAD_RATE_LIMITED",
+			fmt.Sprintf("at most %d videos may be uploaded per day", q.DailyUploads))
+	}
+	return nil
+}
+
+func checkStorage(q Quota, bytes int64) error {
+	if q.StorageBytes > 0 && bytes > q.StorageBytes {
+		return errors.Forbidden("STORAGE_QUOTA_EXCEEDED",
+			fmt.Sprintf("storage quota of %d bytes exceeded", q.StorageBytes))
+	}
+	return nil
+}
@@ -0,0 +1,164 @@
+package biz
+
+import (
+	"context"
+
+	"backend/internal/pkg/media"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+var ErrVideoSourceChanged = errors.Conflict("VIDEO_SOURCE_CHANGED", "the video's source changed meanwhile; reload it and try again")
+
+// ReplaceSource swaps the file of the owner's video, keeping its ID,
+// stats, comments and tags. A ready video keeps playing its current file
+// while the new one is processed; a failed one is processed again from
+// the new file straight away.
+func (uc *VideoUsecase) ReplaceSource(ctx context.Context, userID, videoID uint64, videoURL string) (*Video, error) {
+	video, err := uc.repo.FindByID(ctx, videoID)
+	if err != nil {
+		return nil, errors.NotFound("VIDEO_NOT_FOUND", "video not found")
+	}
+	if video.UserID != userID {
+		return nil, errors.Forbidden("VIDEO_NOT_OWNER", "not the owner of this video")
+	}
+	if video.Status != VideoReady && video.Status != VideoFailed {
+		return nil, errors.Conflict("VIDEO_INVALID_TRANSITION", "video is still being processed")
+	}
+	if videoURL == video.VideoURL {
+		return nil, errors.BadRequest("VIDEO_SOURCE_INVALID", "the video already uses that file")
+	}
+	if videoURL == video.PendingVideoURL {
+		return video, nil
+	}
+	if err := uc.quotas.CheckStorage(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	source := *video
+	source.VideoURL = videoURL
+	if err := uc.probe(ctx, &source); err != nil {
+		return nil, err
+	}
+
+	if video.Status == VideoReady && uc.transcodes != nil {
+		if err := uc.replaceLater(ctx, videoID, videoURL); err != nil {
+			return nil, err
+		}
+		return uc.repo.FindByID(ctx, videoID)
+	}
+
+	// Nothing to keep playing: swap the file in now.
+	source.HLSURL = ""
+	source.SeekPreviewURL = ""
+	source.TranscodeStatus = TranscodeNone
+	if video.Status == VideoFailed && uc.transcodes != nil {
+		source.TranscodeStatus = TranscodePending
+	}
+	ok, err := uc.repo.ReplaceSource(ctx, video, &source)
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to replace video source")
+	}
+	if !ok {
+		return nil, ErrVideoSourceChanged
+	}
+	if video.Status == VideoFailed {
+		uc.reprocess(ctx, &source)
+	}
+	return uc.repo.FindByID(ctx, videoID)
+}
+
+// replaceLater queues the new source of a ready video for processing.
+func (uc *VideoUsecase) replaceLater(ctx context.Context, videoID uint64, videoURL string) error {
+	ok, err := uc.repo.SetPendingSource(ctx, videoID, videoURL)
+	if err != nil {
+		return errors.InternalServer("INTERNAL", "failed to replace video source")
+	}
+	if !ok {
+		return ErrVideoSourceChanged
+	}
+	err = uc.transcodes.Enqueue(ctx, &TranscodeJob{Kind: JobReplace, VideoID: videoID, VideoURL: videoURL})
+	if err == nil {
+		return nil
+	}
+	uc.log.Errorf("enqueue source replacement for video %d: %v", videoID, err)
+	if err := uc.repo.CancelPendingSource(ctx, videoID, videoURL, ""); err != nil {
+		uc.log.Warnf("cancel source replacement of video %d: %v", videoID, err)
+	}
+	return errors.InternalServer("INTERNAL", "failed to queue the new source")
+}
+
+// reprocess takes a failed video whose source was replaced through
+// processing again. The probe has vetted the file, so without transcoding
+// it plays as is.
+func (uc *VideoUsecase) reprocess(ctx context.Context, video *Video) {
+	if uc.transcodes == nil {
+		// failed -> ready skips processing, which Transition does not allow.
+		if _, err := uc.repo.UpdateStatus(ctx, video.ID, VideoFailed, VideoReady, ""); err != nil {
+			uc.log.Warnf("mark video %d ready: %v", video.ID, err)
+		}
+		return
+	}
+	if err := uc.Transition(ctx, video.ID, VideoProcessing, ""); err != nil {
+		uc.log.Warnf("reprocess video %d: %v", video.ID, err)
+		return
+	}
+	uc.enqueueTranscode(ctx, video)
+}
+
+// replace processes the pending source of a ready video and swaps it in,
+// leaving the current file playing until then.
+func (uc *TranscodeUsecase) replace(ctx context.Context, video *Video) {
+	source := *video
+	source.VideoURL = video.PendingVideoURL
+	fail := func(msg string) {
+		if err := uc.repo.CancelPendingSource(ctx, video.ID, source.VideoURL, msg); err != nil {
+			uc.log.Errorf("replace source of video %d: %v", video.ID, err)
+		}
+	}
+
+	if err := uc.videos.probe(ctx, &source); err != nil {
+		if ctx.Err() != nil {
+			return
+		}
+		uc.log.Errorf("replace source of video %d: %v", video.ID, err)
+		fail("new source file could not be inspected")
+		return
+	}
+	manifestURL, err := uc.transcoder.Transcode(ctx, &source, uc.progress(ctx, video.ID))
+	if err != nil {
+		if ctx.Err() != nil {
+			return // shutting down; the job will be redelivered
+		}
+		uc.log.Errorf("replace source of video %d: %v", video.ID, err)
+		msg := "transcoding the new source failed"
+		if errors.Is(err, media.ErrNotVideo) {
+			msg = "new source file could not be decoded"
+		}
+		fail(msg)
+		return
+	}
+	source.HLSURL = manifestURL
+	source.TranscodeStatus = TranscodeReady
+	source.SeekPreviewURL = ""
+	if uc.thumbnailer != nil {
+		if url, err := uc.thumbnailer.SeekPreview(ctx, &source); err != nil {
+			uc.log.Warnf("seek preview of video %d: %v", video.ID, err)
+		} else {
+			source.SeekPreviewURL = url
+		}
+	}
+
+	ok, err := uc.repo.ReplaceSource(ctx, video, &source)
+	if err != nil {
+		uc.log.Errorf("replace source of video %d: %v", video.ID, err)
+		fail("failed to swap in the new source")
+		return
+	}
+	if !ok {
+		return // superseded by another replacement
+	}
+	// Offer frames of the new file; the chosen thumbnail stays.
+	uc.makeThumbnails(ctx, &source)
+	uc.log.Infof("replaced the source of video %d", video.ID)
+}
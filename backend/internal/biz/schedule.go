@@ -0,0 +1,42 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/pkg/pagination"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// A scheduled video (PublishAt set) stays private until the publish
+// scheduler in the data layer makes it published at PublishAt. Setting the
+// visibility by hand cancels the schedule.
+
+var (
+	ErrVideoInvalidSchedule = errors.BadRequest("VIDEO_INVALID_SCHEDULE", "a scheduled video is published at publish_at; leave visibility unset")
+	ErrVideoPublishAtPast   = errors.BadRequest("VIDEO_INVALID_SCHEDULE", "publish_at must be in the future")
+)
+
+// checkSchedule validates publishing a video with the given requested
+// visibility at publishAt.
+func checkSchedule(publishAt *time.Time, visibility string) error {
+	if visibility != VisibilityPublished {
+		return ErrVideoInvalidSchedule
+	}
+	if !publishAt.After(time.Now()) {
+		return ErrVideoPublishAtPast
+	}
+	return nil
+}
+
+// ListScheduledVideos lists the caller's videos that are waiting to be
+// published, soonest first.
+func (uc *VideoUsecase) ListScheduledVideos(ctx context.Context, userID uint64, page, pageSize int32) ([]*Video, int64, error) {
+	offset, limit := pagination.Normalize(page, pageSize)
+	videos, total, err := uc.repo.ListScheduled(ctx, userID, offset, limit)
+	if err != nil {
+		return nil, 0, errors.InternalServer("INTERNAL", "failed to list scheduled videos")
+	}
+	return videos, total, nil
+}
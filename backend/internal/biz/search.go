@@ -2,9 +2,14 @@ package biz
 
 import (
 	"context"
+	"strings"
 	"time"
 
+	"backend/internal/pkg/pagination"
+
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
 )
 
 type SearchParams struct {
@@ -18,24 +23,150 @@ type SearchParams struct {
 	AccessType  string
 	Page        int32
 	PageSize    int32
+	Cursor      string
+
+	// Viewer identity, recorded in the search log only.
+	ViewerID  *uint64
+	SessionID *string
+}
+
+type SearchResult struct {
+	Videos     []*Video
+	Total      int64 // not counted when paging by cursor
+	SearchID   string
+	DidYouMean string
+	NextCursor string
+}
+
+// SearchLogEntry is one executed search, buffered for the analytics worker.
+type SearchLogEntry struct {
+	SearchID    string
+	Query       string // normalized
+	Params      *SearchParams
+	ResultCount int64
+	Latency     time.Duration
+	CreatedAt   time.Time
+}
+
+// SearchClick records which result of a logged search the viewer opened.
+type SearchClick struct {
+	SearchID  string
+	VideoID   uint64
+	Position  int32
+	ClickedAt time.Time
 }
 
 type SearchRepo interface {
-	Search(ctx context.Context, params *SearchParams) ([]*Video, int64, error)
+	// Search orders by params.SortBy (one of the sort* modes) with ID as
+	// tie-breaker, so page.After can continue from any row.
+	Search(ctx context.Context, params *SearchParams, page pagination.Request) ([]*Video, int64, error)
+	LogSearch(ctx context.Context, entry *SearchLogEntry) error
+	LogClick(ctx context.Context, click *SearchClick) error
+	// SuggestCorrection applies admin-defined spelling corrections to query.
+	// Returns "" when nothing was corrected.
+	SuggestCorrection(ctx context.Context, query string) (string, error)
 }
 
 type SearchUsecase struct {
-	repo SearchRepo
-	log  *log.Helper
+	repo    SearchRepo
+	cursors *pagination.CursorCodec
+	log     *log.Helper
 }
 
-func NewSearchUsecase(repo SearchRepo, logger log.Logger) *SearchUsecase {
+func NewSearchUsecase(repo SearchRepo, cursors *pagination.CursorCodec, logger log.Logger) *SearchUsecase {
 	return &SearchUsecase{
-		repo: repo,
-		log:  log.NewHelper(logger),
+		repo:    repo,
+		cursors: cursors,
+		log:     log.NewHelper(logger),
+	}
+}
+
+// Search runs a search. Each new search is logged for analytics; pages
+// reached through a cursor belong to the search that issued the cursor and
+// keep its search ID.
+func (uc *SearchUsecase) Search(ctx context.Context, params *SearchParams) (*SearchResult, error) {
+	params.SortBy = normalizeSearchSort(params.SortBy)
+	req, limit, err := newPageRequest(uc.cursors, params.Page, params.PageSize, params.Cursor, params.SortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	videos, total, err := uc.repo.Search(ctx, params, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var searchID string
+	if req.Keyset() {
+		searchID = req.After.Ref
+	} else {
+		searchID = uuid.New().String()
+		// Analytics must never fail the search itself.
+		if err := uc.repo.LogSearch(ctx, &SearchLogEntry{
+			SearchID:    searchID,
+			Query:       NormalizeSearchQuery(params.Query),
+			Params:      params,
+			ResultCount: total,
+			Latency:     time.Since(start),
+			CreatedAt:   start,
+		}); err != nil {
+			uc.log.Warnf("failed to log search: %v", err)
+		}
+	}
+
+	sort := params.SortBy
+	videos, next := nextPage(uc.cursors, videos, limit, func(v *Video) *pagination.Cursor {
+		return &pagination.Cursor{Sort: sort, Key: searchSortKey(sort, v), ID: v.ID, Ref: searchID}
+	})
+
+	result := &SearchResult{Videos: videos, Total: total, SearchID: searchID, NextCursor: next}
+	if !req.Keyset() && total == 0 && strings.TrimSpace(params.Query) != "" {
+		suggestion, err := uc.repo.SuggestCorrection(ctx, params.Query)
+		if err != nil {
+			uc.log.Warnf("failed to suggest correction: %v", err)
+		}
+		result.DidYouMean = suggestion
+	}
+	return result, nil
+}
+
+func (uc *SearchUsecase) RecordClick(ctx context.Context, click *SearchClick) error {
+	if _, err := uuid.Parse(click.SearchID); err != nil {
+		return errors.BadRequest("SEARCH_ID_INVALID", "invalid search_id")
+	}
+	if click.VideoID == 0 {
+		return errors.BadRequest("VIDEO_NOT_FOUND", "video_id is required")
+	}
+	click.ClickedAt = time.Now()
+	if err := uc.repo.LogClick(ctx, click); err != nil {
+		uc.log.Warnf("failed to log search click: %v", err)
+	}
+	return nil
+}
+
+func normalizeSearchSort(sortBy string) string {
+	switch sortBy {
+	case sortViewsDesc, sortViewsAsc, sortDateAsc:
+		return sortBy
+	default:
+		return sortDateDesc
+	}
+}
+
+// searchSortKey is the keyset value of v under sort: creation time in
+// microseconds or total views.
+func searchSortKey(sort string, v *Video) int64 {
+	switch sort {
+	case sortViewsDesc, sortViewsAsc:
+		return int64(v.ViewsMember + v.ViewsNonMember)
+	default:
+		return v.CreatedAt.UnixMicro()
 	}
 }
 
-func (uc *SearchUsecase) Search(ctx context.Context, params *SearchParams) ([]*Video, int64, error) {
-	return uc.repo.Search(ctx, params)
+// NormalizeSearchQuery lowercases a query and collapses whitespace so that
+// "Go  Tutorial" and "go tutorial" aggregate into the same analytics row.
+func NormalizeSearchQuery(q string) string {
+	return strings.Join(strings.Fields(strings.ToLower(q)), " ")
 }
@@ -0,0 +1,127 @@
+package biz
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	"backend/internal/pkg/authctx"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// maxVideoShares bounds the share links of one video.
+const maxVideoShares = 50
+
+// VideoShare is a revocable, optionally expiring link token that grants
+// one video to whoever holds it, regardless of visibility and access tier.
+// Hidden videos and videos that are not ready stay unavailable.
+type VideoShare struct {
+	ID        uint64
+	VideoID   uint64
+	Token     string
+	ExpiresAt *time.Time
+	CreatedAt time.Time
+}
+
+var (
+	ErrVideoShareLimit    = errors.BadRequest("VIDEO_SHARE_LIMIT", "too many share links for this video")
+	ErrVideoShareNotFound = errors.NotFound("VIDEO_SHARE_NOT_FOUND", "share link not found")
+	ErrVideoShareExpiry   = errors.BadRequest("VIDEO_SHARE_INVALID", "expires_at must be in the future")
+)
+
+// grants reports whether s lets its holder watch videoID now.
+func (s *VideoShare) grants(videoID uint64, now time.Time) bool {
+	return s.VideoID == videoID && (s.ExpiresAt == nil || now.Before(*s.ExpiresAt))
+}
+
+// sharedWith reports whether the share token in ctx, if any, grants the
+// video. Lookup failures deny access.
+func (uc *VideoUsecase) sharedWith(ctx context.Context, videoID uint64) bool {
+	token, ok := authctx.ShareTokenFromContext(ctx)
+	if !ok {
+		return false
+	}
+	share, err := uc.repo.FindShareByToken(ctx, token)
+	if err != nil {
+		uc.log.Warnf("find share token for video %d: %v", videoID, err)
+		return false
+	}
+	return share != nil && share.grants(videoID, time.Now())
+}
+
+// CreateShare creates a share link for the owner's video, expiring at
+// expiresAt unless it is nil.
+func (uc *VideoUsecase) CreateShare(ctx context.Context, userID, videoID uint64, expiresAt *time.Time) (*VideoShare, error) {
+	if err := uc.checkOwner(ctx, userID, videoID); err != nil {
+		return nil, err
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return nil, ErrVideoShareExpiry
+	}
+	shares, err := uc.repo.ListShares(ctx, videoID)
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to list share links")
+	}
+	if len(shares) >= maxVideoShares {
+		return nil, ErrVideoShareLimit
+	}
+
+	token, err := newShareToken()
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to create share link")
+	}
+	share, err := uc.repo.CreateShare(ctx, &VideoShare{VideoID: videoID, Token: token, ExpiresAt: expiresAt})
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to create share link")
+	}
+	return share, nil
+}
+
+// ListShares lists the share links of the owner's video, newest first.
+func (uc *VideoUsecase) ListShares(ctx context.Context, userID, videoID uint64) ([]*VideoShare, error) {
+	if err := uc.checkOwner(ctx, userID, videoID); err != nil {
+		return nil, err
+	}
+	shares, err := uc.repo.ListShares(ctx, videoID)
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to list share links")
+	}
+	return shares, nil
+}
+
+// RevokeShare deletes a share link; its token stops working at once.
+func (uc *VideoUsecase) RevokeShare(ctx context.Context, userID, videoID, shareID uint64) error {
+	if err := uc.checkOwner(ctx, userID, videoID); err != nil {
+		return err
+	}
+	ok, err := uc.repo.DeleteShare(ctx, videoID, shareID)
+	if err != nil {
+		return errors.InternalServer("INTERNAL", "failed to revoke share link")
+	}
+	if !ok {
+		return ErrVideoShareNotFound
+	}
+	return nil
+}
+
+func (uc *VideoUsecase) checkOwner(ctx context.Context, userID, videoID uint64) error {
+	video, err := uc.repo.FindByID(ctx, videoID)
+	if err != nil {
+		return errors.NotFound("VIDEO_NOT_FOUND", "video not found")
+	}
+	if video.UserID != userID {
+		return errors.Forbidden("VIDEO_NOT_OWNER", "not the owner of this video")
+	}
+	return nil
+}
+
+// newShareToken returns 192 random bits, URL-safe.
+func newShareToken() (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
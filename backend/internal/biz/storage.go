@@ -0,0 +1,193 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"backend/internal/conf"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// DefaultOrphanGracePeriod is how long an unreferenced object is kept
+	// before it is collected, so uploads survive until CreateVideo.
+	DefaultOrphanGracePeriod = 48 * time.Hour
+
+	maxReportedOrphans = 1000
+	reconcileBatchSize = 500
+)
+
+// StoredObject is an object in the media bucket.
+type StoredObject struct {
+	Name         string
+	Size         int64
+	LastModified time.Time
+	// Referenced means something still uses the object: the source,
+	// renditions, thumbnails or captions of a video, live or in the trash,
+	// or a user's avatar.
+	Referenced bool
+	// VideoID is the video the object is stored for, if any.
+	VideoID uint64
+}
+
+// StorageReport is the outcome of one reconciliation of the bucket.
+type StorageReport struct {
+	DryRun       bool
+	Scanned      int64
+	ScannedBytes int64
+	// Orphans are unreferenced objects older than the grace period,
+	// deleted unless DryRun. Only the first maxReportedOrphans are listed.
+	Orphans     []*StoredObject
+	OrphanCount int64
+	OrphanBytes int64
+	Deleted     int64
+	// Recent counts unreferenced objects still inside the grace period.
+	Recent     int64
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+type StorageRepo interface {
+	// ScanObjects calls fn for every object in the bucket.
+	ScanObjects(ctx context.Context, fn func(*StoredObject) error) error
+	// DeleteObjects removes objects and marks their uploads deleted.
+	DeleteObjects(ctx context.Context, names []string) error
+	// AttachUploads marks the pending uploads of objects as attached.
+	AttachUploads(ctx context.Context, names []string) error
+	// SetStorageSizes records the bytes stored for each video, which count
+	// towards their owners' quotas.
+	SetStorageSizes(ctx context.Context, sizes map[uint64]int64) error
+}
+
+// StorageUsecase garbage-collects the bucket: objects nothing references,
+// such as uploads never passed to CreateVideo, are deleted once they are
+// older than the grace period.
+type StorageUsecase struct {
+	repo     StorageRepo
+	grace    time.Duration
+	interval time.Duration
+	log      *log.Helper
+}
+
+func NewStorageUsecase(repo StorageRepo, c *conf.Storage, logger log.Logger) *StorageUsecase {
+	grace := c.OrphanGracePeriod.AsDuration()
+	if grace <= 0 {
+		grace = DefaultOrphanGracePeriod
+	}
+	// A finished resumable upload is unreferenced until it is claimed.
+	if grace < ResumableUploadTTL {
+		grace = ResumableUploadTTL
+	}
+	return &StorageUsecase{
+		repo:     repo,
+		grace:    grace,
+		interval: c.ReconcileInterval.AsDuration(),
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Enabled reports whether periodic reconciliation is configured.
+func (uc *StorageUsecase) Enabled() bool {
+	return uc.interval > 0
+}
+
+// Run reconciles the bucket every interval until ctx is done. Runs are
+// idempotent, so several instances reconciling at once only repeat work.
+func (uc *StorageUsecase) Run(ctx context.Context) {
+	ticker := time.NewTicker(uc.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			report, err := uc.Reconcile(ctx, false)
+			if err != nil {
+				uc.log.Errorf("reconcile storage: %v", err)
+				continue
+			}
+			uc.log.Infof("reconciled storage: %d objects scanned, %d orphans (%d bytes) deleted, %d recent",
+				report.Scanned, report.Deleted, report.OrphanBytes, report.Recent)
+		}
+	}
+}
+
+// Reconcile scans the bucket, deleting orphans past the grace period,
+// marking referenced uploads attached and tallying the bytes stored for
+// each video. A dry run changes nothing and only reports what would be
+// deleted.
+func (uc *StorageUsecase) Reconcile(ctx context.Context, dryRun bool) (*StorageReport, error) {
+	report := &StorageReport{DryRun: dryRun, StartedAt: time.Now()}
+	cutoff := report.StartedAt.Add(-uc.grace)
+
+	var attached, orphans []string
+	sizes := make(map[uint64]int64)
+	flush := func(force bool) error {
+		if len(attached) >= reconcileBatchSize || force && len(attached) > 0 {
+			if err := uc.repo.AttachUploads(ctx, attached); err != nil {
+				return err
+			}
+			attached = attached[:0]
+		}
+		if len(orphans) >= reconcileBatchSize || force && len(orphans) > 0 {
+			if err := uc.repo.DeleteObjects(ctx, orphans); err != nil {
+				return err
+			}
+			report.Deleted += int64(len(orphans))
+			orphans = orphans[:0]
+		}
+		return nil
+	}
+
+	err := uc.repo.ScanObjects(ctx, func(obj *StoredObject) error {
+		report.Scanned++
+		report.ScannedBytes += obj.Size
+		switch {
+		case obj.Referenced:
+			if obj.VideoID != 0 {
+				sizes[obj.VideoID] += obj.Size
+			}
+			if !dryRun && isUploadObject(obj.Name) {
+				attached = append(attached, obj.Name)
+			}
+		case obj.LastModified.After(cutoff):
+			report.Recent++
+		default:
+			report.OrphanCount++
+			report.OrphanBytes += obj.Size
+			if len(report.Orphans) < maxReportedOrphans {
+				report.Orphans = append(report.Orphans, obj)
+			}
+			if !dryRun {
+				orphans = append(orphans, obj.Name)
+			}
+		}
+		return flush(false)
+	})
+	if err == nil {
+		err = flush(true)
+	}
+	// A partial scan would undercount.
+	if err == nil && !dryRun {
+		err = uc.repo.SetStorageSizes(ctx, sizes)
+	}
+	report.FinishedAt = time.Now()
+	return report, err
+}
+
+// isUploadObject reports whether name is a user upload, e.g.
+// "videos/20240131-<uuid>.mp4", rather than a file derived from one.
+func isUploadObject(name string) bool {
+	dir, rest, ok := strings.Cut(name, "/")
+	if !ok || strings.Contains(rest, "/") {
+		return false
+	}
+	for _, k := range uploadKinds {
+		if k.dir == dir {
+			return true
+		}
+	}
+	return false
+}
@@ -77,6 +77,19 @@ func (uc *TagUsecase) SetMyTags(ctx context.Context, userID *uint64, sessionID *
 	return tags, nil
 }
 
+// GetPreferredTagIDs returns the IDs of all of the user's tag preferences.
+func (uc *TagUsecase) GetPreferredTagIDs(ctx context.Context, userID *uint64, sessionID *string) ([]uint64, error) {
+	tags, err := uc.GetMyTags(ctx, userID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]uint64, len(tags))
+	for i, t := range tags {
+		ids[i] = t.ID
+	}
+	return ids, nil
+}
+
 // GetRecommendedTagIDs returns a random subset of the user's tag preferences
 // for recommendation variety. Each request gets a different combination.
 func (uc *TagUsecase) GetRecommendedTagIDs(ctx context.Context, userID *uint64, sessionID *string) ([]uint64, error) {
@@ -0,0 +1,198 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// ThumbnailPositions are where candidate frames are taken, in percent of
+// the duration. The middle one becomes the thumbnail if none was uploaded.
+var ThumbnailPositions = []uint32{10, 25, 50, 75, 90}
+
+const defaultThumbnailPosition = 50
+
+// ThumbnailCandidate is a frame extracted from a video that its owner may
+// pick as the thumbnail.
+type ThumbnailCandidate struct {
+	ID       uint64
+	VideoID  uint64
+	URL      string
+	Position uint32 // percent of the duration
+}
+
+// ThumbnailVariants are resized WebP copies of a video's thumbnail. They are
+// empty until a worker has generated them; clients fall back to ThumbnailURL.
+type ThumbnailVariants struct {
+	Small  string
+	Medium string
+	Large  string
+}
+
+// Thumbnailer extracts and resizes thumbnails of stored videos.
+// Implemented with ffmpeg in the data layer.
+type Thumbnailer interface {
+	// ExtractCandidates stores a frame at each position (percent of the
+	// duration) and returns their stored URLs in the same order.
+	ExtractCandidates(ctx context.Context, video *Video, positions []uint32) ([]string, error)
+	// MakeVariants stores resized WebP copies of a stored thumbnail. It
+	// returns ErrThumbnailInvalid for thumbnails outside storage.
+	MakeVariants(ctx context.Context, thumbnailURL string) (*ThumbnailVariants, error)
+	// SeekPreview stores seek-preview sprite sheets of a video and a WebVTT
+	// thumbnails track for them, returning the track's stored URL.
+	SeekPreview(ctx context.Context, video *Video) (string, error)
+}
+
+var (
+	ErrThumbnailNotFound = errors.NotFound("THUMBNAIL_NOT_FOUND", "thumbnail candidate not found")
+	ErrThumbnailInvalid  = errors.BadRequest("THUMBNAIL_INVALID", "thumbnail must be uploaded to storage first")
+	ErrThumbnailConflict = errors.Conflict("THUMBNAIL_CONFLICT", "thumbnail was changed concurrently; retry")
+)
+
+// ListThumbnailCandidates returns the extracted frames of a video to its owner.
+func (uc *VideoUsecase) ListThumbnailCandidates(ctx context.Context, userID, videoID uint64) ([]*ThumbnailCandidate, error) {
+	video, err := uc.repo.FindByID(ctx, videoID)
+	if err != nil {
+		return nil, errors.NotFound("VIDEO_NOT_FOUND", "video not found")
+	}
+	if video.UserID != userID {
+		return nil, errors.Forbidden("VIDEO_NOT_OWNER", "not the owner of this video")
+	}
+	candidates, err := uc.repo.ListThumbnailCandidates(ctx, videoID)
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to list thumbnail candidates")
+	}
+	return candidates, nil
+}
+
+// SetThumbnail makes a candidate frame (candidateID) or an image the owner
+// uploaded (thumbnailURL, see UploadUsecase.CheckThumbnail) the video's
+// thumbnail. Its variants are regenerated in the background.
+func (uc *VideoUsecase) SetThumbnail(ctx context.Context, userID, videoID, candidateID uint64, thumbnailURL string) (*Video, error) {
+	video, err := uc.repo.FindByID(ctx, videoID)
+	if err != nil {
+		return nil, errors.NotFound("VIDEO_NOT_FOUND", "video not found")
+	}
+	if video.UserID != userID {
+		return nil, errors.Forbidden("VIDEO_NOT_OWNER", "not the owner of this video")
+	}
+
+	if candidateID != 0 {
+		candidates, err := uc.repo.ListThumbnailCandidates(ctx, videoID)
+		if err != nil {
+			return nil, errors.InternalServer("INTERNAL", "failed to list thumbnail candidates")
+		}
+		thumbnailURL = ""
+		for _, c := range candidates {
+			if c.ID == candidateID {
+				thumbnailURL = c.URL
+			}
+		}
+		if thumbnailURL == "" {
+			return nil, ErrThumbnailNotFound
+		}
+	} else if thumbnailURL == "" {
+		return nil, ErrThumbnailInvalid
+	} else if err := uc.uploads.CheckThumbnail(ctx, userID, thumbnailURL); err != nil {
+		return nil, err
+	}
+
+	ok, err := uc.repo.SetThumbnail(ctx, videoID, video.ThumbnailURL, thumbnailURL, nil, &VideoRevision{
+		VideoID: videoID,
+		ActorID: userID,
+		Action:  RevisionThumbnail,
+		Fields:  []string{VideoFieldThumbnailURL},
+	})
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to set thumbnail")
+	}
+	if !ok {
+		return nil, ErrThumbnailConflict
+	}
+	uc.enqueueThumbnail(ctx, videoID, thumbnailURL)
+
+	return uc.repo.FindByID(ctx, videoID)
+}
+
+// enqueueThumbnail schedules variants for a newly chosen thumbnail.
+func (uc *VideoUsecase) enqueueThumbnail(ctx context.Context, videoID uint64, thumbnailURL string) {
+	if uc.transcodes == nil {
+		return
+	}
+	job := &TranscodeJob{Kind: JobThumbnail, VideoID: videoID, ThumbnailURL: thumbnailURL}
+	if err := uc.transcodes.Enqueue(ctx, job); err != nil {
+		uc.log.Errorf("enqueue thumbnail variants for video %d: %v", videoID, err)
+	}
+}
+
+// makeThumbnails extracts candidate frames of a freshly processed video,
+// picks the default one if the owner uploaded none, and builds the
+// thumbnail's variants. Thumbnails are cosmetic, so failures are only
+// logged.
+func (uc *TranscodeUsecase) makeThumbnails(ctx context.Context, video *Video) {
+	if uc.thumbnailer == nil {
+		return
+	}
+	urls, err := uc.thumbnailer.ExtractCandidates(ctx, video, ThumbnailPositions)
+	if err != nil {
+		uc.log.Warnf("extract thumbnails of video %d: %v", video.ID, err)
+	} else {
+		candidates := make([]*ThumbnailCandidate, len(urls))
+		for i, url := range urls {
+			candidates[i] = &ThumbnailCandidate{VideoID: video.ID, URL: url, Position: ThumbnailPositions[i]}
+		}
+		if err := uc.repo.ReplaceThumbnailCandidates(ctx, video.ID, candidates); err != nil {
+			uc.log.Warnf("store thumbnails of video %d: %v", video.ID, err)
+		}
+		if video.ThumbnailURL == "" {
+			for _, c := range candidates {
+				if c.Position == defaultThumbnailPosition {
+					ok, err := uc.repo.SetThumbnail(ctx, video.ID, "", c.URL, nil, &VideoRevision{
+						VideoID: video.ID,
+						Action:  RevisionThumbnail,
+						Fields:  []string{VideoFieldThumbnailURL},
+					})
+					if err != nil {
+						uc.log.Warnf("set default thumbnail of video %d: %v", video.ID, err)
+					}
+					if ok {
+						video.ThumbnailURL = c.URL
+					}
+				}
+			}
+		}
+	}
+	uc.makeVariants(ctx, video.ID, video.ThumbnailURL)
+}
+
+// makeVariants stores variants of thumbnailURL, unless the video's
+// thumbnail has changed meanwhile (its own job will handle that one).
+func (uc *TranscodeUsecase) makeVariants(ctx context.Context, videoID uint64, thumbnailURL string) {
+	if uc.thumbnailer == nil || thumbnailURL == "" {
+		return
+	}
+	variants, err := uc.thumbnailer.MakeVariants(ctx, thumbnailURL)
+	if err != nil {
+		uc.log.Warnf("thumbnail variants of video %d: %v", videoID, err)
+		return
+	}
+	if _, err := uc.repo.SetThumbnail(ctx, videoID, thumbnailURL, thumbnailURL, variants, nil); err != nil {
+		uc.log.Warnf("store thumbnail variants of video %d: %v", videoID, err)
+	}
+}
+
+// makeSeekPreview builds the hover-scrub sprite sheets of a freshly
+// processed video. Like thumbnails, failures are only logged.
+func (uc *TranscodeUsecase) makeSeekPreview(ctx context.Context, video *Video) {
+	if uc.thumbnailer == nil {
+		return
+	}
+	url, err := uc.thumbnailer.SeekPreview(ctx, video)
+	if err != nil {
+		uc.log.Warnf("seek preview of video %d: %v", video.ID, err)
+		return
+	}
+	if err := uc.repo.SetSeekPreview(ctx, video.ID, url); err != nil {
+		uc.log.Warnf("store seek preview of video %d: %v", video.ID, err)
+	}
+}
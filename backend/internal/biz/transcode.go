@@ -0,0 +1,158 @@
+package biz
+
+import (
+	"context"
+
+	"backend/internal/pkg/media"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// Transcode statuses stored on a video. Videos created while transcoding is
+// unavailable stay "none" and play the original file.
+const (
+	TranscodeNone    = "none"
+	TranscodePending = "pending"
+	TranscodeRunning = "running"
+	TranscodeReady   = "ready"
+	TranscodeFailed  = "failed"
+)
+
+// Job kinds. The zero value processes a new source: HLS renditions, then
+// thumbnails.
+const (
+	JobProcess   = ""
+	JobThumbnail = "thumbnail" // only rebuild the variants of ThumbnailURL
+	JobReplace   = "replace"   // process VideoURL, then swap it in as the source
+)
+
+// TranscodeJob asks a worker to process a video.
+type TranscodeJob struct {
+	Kind         string `json:"kind,omitempty"`
+	VideoID      uint64 `json:"video_id"`
+	VideoURL     string `json:"video_url,omitempty"`
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+}
+
+// TranscodeQueue carries jobs from the API to transcode workers.
+// Implemented with NATS JetStream in the data layer.
+type TranscodeQueue interface {
+	Enqueue(ctx context.Context, job *TranscodeJob) error
+	// Consume hands jobs to handle one at a time until ctx is done. A job
+	// is acknowledged once handle returns, so a worker that dies mid-job
+	// leaves it to be redelivered.
+	Consume(ctx context.Context, handle func(context.Context, *TranscodeJob)) error
+}
+
+// Transcoder turns a video's stored source into HLS renditions stored next
+// to it and returns the stored URL of the master playlist. progress is
+// called with the percentage done as encoding advances.
+// Implemented with ffmpeg in the data layer.
+type Transcoder interface {
+	Transcode(ctx context.Context, video *Video, progress func(percent uint32)) (string, error)
+}
+
+// progressStep limits how often encoding progress is written to the video.
+const progressStep = 5
+
+type TranscodeUsecase struct {
+	repo        VideoRepo
+	videos      *VideoUsecase
+	queue       TranscodeQueue
+	transcoder  Transcoder
+	thumbnailer Thumbnailer
+	log         *log.Helper
+}
+
+func NewTranscodeUsecase(repo VideoRepo, videos *VideoUsecase, queue TranscodeQueue, transcoder Transcoder, thumbnailer Thumbnailer, logger log.Logger) *TranscodeUsecase {
+	return &TranscodeUsecase{
+		repo:        repo,
+		videos:      videos,
+		queue:       queue,
+		transcoder:  transcoder,
+		thumbnailer: thumbnailer,
+		log:         log.NewHelper(logger),
+	}
+}
+
+// Enabled reports whether this process can run transcode jobs.
+func (uc *TranscodeUsecase) Enabled() bool {
+	return uc.queue != nil && uc.transcoder != nil
+}
+
+// Run consumes transcode jobs until ctx is done.
+func (uc *TranscodeUsecase) Run(ctx context.Context) error {
+	return uc.queue.Consume(ctx, uc.process)
+}
+
+func (uc *TranscodeUsecase) process(ctx context.Context, job *TranscodeJob) {
+	video, err := uc.repo.FindByID(ctx, job.VideoID)
+	if err != nil {
+		uc.log.Warnf("transcode video %d: %v (skipped)", job.VideoID, err)
+		return
+	}
+	if job.Kind == JobThumbnail {
+		if video.ThumbnailURL == job.ThumbnailURL {
+			uc.makeVariants(ctx, video.ID, job.ThumbnailURL)
+		}
+		return
+	}
+	if job.Kind == JobReplace {
+		if video.PendingVideoURL == job.VideoURL {
+			uc.replace(ctx, video)
+		}
+		return
+	}
+	if video.Status != VideoProcessing || video.VideoURL != job.VideoURL {
+		return // duplicate delivery, or the source has since changed
+	}
+
+	if err := uc.repo.UpdateTranscode(ctx, video.ID, TranscodeRunning, ""); err != nil {
+		uc.log.Errorf("transcode video %d: %v", video.ID, err)
+		return
+	}
+	manifestURL, err := uc.transcoder.Transcode(ctx, video, uc.progress(ctx, video.ID))
+	if err != nil {
+		if ctx.Err() != nil {
+			return // shutting down; the job will be redelivered
+		}
+		uc.log.Errorf("transcode video %d: %v", video.ID, err)
+		msg := "transcoding failed; the original file is played instead"
+		if errors.Is(err, media.ErrNotVideo) {
+			msg = "source file could not be transcoded; the original file is played instead"
+		}
+		// The probe has vetted the file, so it still plays as uploaded.
+		uc.makeThumbnails(ctx, video)
+		uc.finish(ctx, video.ID, TranscodeFailed, "", VideoReady, msg)
+		return
+	}
+	uc.makeThumbnails(ctx, video)
+	uc.makeSeekPreview(ctx, video)
+	uc.finish(ctx, video.ID, TranscodeReady, manifestURL, VideoReady, "")
+	uc.log.Infof("transcoded video %d", video.ID)
+}
+
+// progress reports encoding progress in steps of progressStep percent.
+func (uc *TranscodeUsecase) progress(ctx context.Context, videoID uint64) func(uint32) {
+	var reported uint32
+	return func(percent uint32) {
+		if percent < reported+progressStep {
+			return
+		}
+		reported = percent
+		if err := uc.videos.ReportProgress(ctx, videoID, percent); err != nil {
+			uc.log.Warnf("transcode video %d: report progress: %v", videoID, err)
+		}
+	}
+}
+
+func (uc *TranscodeUsecase) finish(ctx context.Context, videoID uint64, transcodeStatus, manifestURL, status, errMsg string) {
+	if err := uc.repo.UpdateTranscode(ctx, videoID, transcodeStatus, manifestURL); err != nil {
+		uc.log.Errorf("transcode video %d: %v", videoID, err)
+		return
+	}
+	if err := uc.videos.Transition(ctx, videoID, status, errMsg); err != nil {
+		uc.log.Errorf("transcode video %d: mark %s: %v", videoID, status, err)
+	}
+}
@@ -0,0 +1,153 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"backend/internal/conf"
+	"backend/internal/pkg/pagination"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	// DefaultTrashRetention is how long a deleted video can be restored
+	// before it is purged.
+	DefaultTrashRetention = 30 * 24 * time.Hour
+
+	trashPurgeInterval  = time.Hour
+	trashPurgeBatchSize = 100
+)
+
+var ErrVideoNotRestorable = errors.Conflict("VIDEO_NOT_RESTORABLE", "the video's owner no longer exists")
+
+// DeletedVideo is a video in the trash.
+type DeletedVideo struct {
+	*Video
+	DeletedAt time.Time
+	// PurgeAt is when the video and its files are removed for good.
+	PurgeAt time.Time
+	// OwnerDeleted means the video went with its owner's account and
+	// cannot come back.
+	OwnerDeleted bool
+}
+
+type TrashRepo interface {
+	// ListDeleted lists deleted videos, most recently deleted first; all
+	// users' when userID is 0.
+	ListDeleted(ctx context.Context, userID uint64, offset, limit int) ([]*DeletedVideo, int64, error)
+	// FindDeleted returns nil if the video is not in the trash.
+	FindDeleted(ctx context.Context, id uint64) (*DeletedVideo, error)
+	Restore(ctx context.Context, id uint64) error
+	// ListExpired returns the IDs of videos deleted before cutoff.
+	ListExpired(ctx context.Context, cutoff time.Time, limit int) ([]uint64, error)
+	// Purge removes a deleted video with everything recorded for it, then
+	// its stored files; files it fails to delete are left to the storage
+	// reconciler.
+	Purge(ctx context.Context, id uint64) error
+}
+
+// TrashUsecase keeps deleted videos restorable for the retention period
+// and purges them afterwards.
+type TrashUsecase struct {
+	repo      TrashRepo
+	videos    VideoRepo
+	retention time.Duration
+	log       *log.Helper
+}
+
+func NewTrashUsecase(repo TrashRepo, videos VideoRepo, c *conf.Storage, logger log.Logger) *TrashUsecase {
+	retention := c.TrashRetention.AsDuration()
+	if retention <= 0 {
+		retention = DefaultTrashRetention
+	}
+	return &TrashUsecase{
+		repo:      repo,
+		videos:    videos,
+		retention: retention,
+		log:       log.NewHelper(logger),
+	}
+}
+
+// ListDeletedVideos lists a user's deleted videos, or everyone's for an
+// admin passing userID 0.
+func (uc *TrashUsecase) ListDeletedVideos(ctx context.Context, userID uint64, page, pageSize int32) ([]*DeletedVideo, int64, error) {
+	offset, limit := pagination.Normalize(page, pageSize)
+	videos, total, err := uc.repo.ListDeleted(ctx, userID, offset, limit)
+	if err != nil {
+		return nil, 0, errors.InternalServer("INTERNAL", "failed to list deleted videos")
+	}
+	for _, v := range videos {
+		v.PurgeAt = v.DeletedAt.Add(uc.retention)
+	}
+	return videos, total, nil
+}
+
+// RestoreVideo takes a video out of the trash, unchanged. userID 0 lets
+// an admin restore anyone's video.
+func (uc *TrashUsecase) RestoreVideo(ctx context.Context, userID, videoID uint64) (*Video, error) {
+	deleted, err := uc.repo.FindDeleted(ctx, videoID)
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to load video")
+	}
+	if deleted == nil {
+		return nil, errors.NotFound("VIDEO_NOT_FOUND", "video not found in trash")
+	}
+	if userID != 0 && deleted.UserID != userID {
+		return nil, errors.Forbidden("VIDEO_NOT_OWNER", "not the owner of this video")
+	}
+	if deleted.OwnerDeleted {
+		return nil, ErrVideoNotRestorable
+	}
+	if err := uc.repo.Restore(ctx, videoID); err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to restore video")
+	}
+	return uc.videos.FindByID(ctx, videoID)
+}
+
+// Run purges expired videos every hour until ctx is done.
+func (uc *TrashUsecase) Run(ctx context.Context) {
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := uc.PurgeExpired(ctx)
+			if err != nil {
+				uc.log.Errorf("purge trash: %v", err)
+			}
+			if n > 0 {
+				uc.log.Infof("purged %d deleted videos", n)
+			}
+		}
+	}
+}
+
+// PurgeExpired purges the videos deleted longer ago than the retention
+// period. A video that fails is retried on the next run.
+func (uc *TrashUsecase) PurgeExpired(ctx context.Context) (int, error) {
+	cutoff := time.Now().Add(-uc.retention)
+	purged := 0
+	for {
+		ids, err := uc.repo.ListExpired(ctx, cutoff, trashPurgeBatchSize)
+		if err != nil {
+			return purged, err
+		}
+		failed := 0
+		for _, id := range ids {
+			if err := uc.repo.Purge(ctx, id); err != nil {
+				uc.log.Warnf("purge video %d: %v", id, err)
+				failed++
+				continue
+			}
+			purged++
+		}
+		// Stop rather than spin on a batch that keeps failing.
+		if len(ids) < trashPurgeBatchSize || failed > 0 {
+			return purged, nil
+		}
+	}
+}
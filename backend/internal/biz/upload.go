@@ -0,0 +1,614 @@
+package biz
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"backend/internal/pkg/filetype"
+	"backend/internal/pkg/upload"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxResumableUploadSize caps a single resumable upload.
+	MaxResumableUploadSize = 10 << 30 // 10GB
+	// ResumableUploadTTL is how long an unfinished upload can be resumed.
+	ResumableUploadTTL = 24 * time.Hour
+	// MaxThumbnailSize caps thumbnail uploads.
+	MaxThumbnailSize = 10 << 20 // 10MB
+	// PresignedUploadTTL is how long a presigned upload policy is valid.
+	PresignedUploadTTL = 15 * time.Minute
+
+	uploadSweepInterval  = time.Hour
+	uploadSweepBatchSize = 100
+
+	// uploaderMetaKey is the object metadata recording who presigned it.
+	uploaderMetaKey = "Uploader"
+)
+
+var (
+	// VideoContentTypes are the accepted video upload types.
+	VideoContentTypes = []string{"video/mp4", "video/webm", "video/quicktime"}
+	// ImageContentTypes are the accepted thumbnail upload types.
+	ImageContentTypes = []string{"image/jpeg", "image/png", "image/webp"}
+)
+
+// uploadKind describes where and what a client may upload.
+type uploadKind struct {
+	dir     string
+	types   []string
+	maxSize int64
+	image   bool // small enough to inspect and clean before storing
+}
+
+var uploadKinds = map[string]uploadKind{
+	"video":     {dir: "videos", types: VideoContentTypes, maxSize: MaxResumableUploadSize},
+	"thumbnail": {dir: "thumbnails", types: ImageContentTypes, maxSize: MaxThumbnailSize, image: true},
+}
+
+// Upload statuses, kept up to date by StorageUsecase.Reconcile.
+const (
+	UploadPending  = "pending"  // not used by any video yet
+	UploadAttached = "attached" // used by a video or as an avatar
+	UploadDeleted  = "deleted"  // collected as an orphan or with its video
+	UploadRejected = "rejected" // deleted because the scanner flagged it
+)
+
+// Upload is an object a user put into the bucket.
+type Upload struct {
+	ID          uint64
+	UserID      uint64
+	ObjectName  string
+	Kind        string
+	ContentType string
+	Size        int64
+	SHA256      string // hex, empty until verified
+	Status      string
+	// DuplicateOf is the object kept instead of this one, which had the
+	// same content.
+	DuplicateOf string
+	URL         string
+	CreatedAt   time.Time
+}
+
+// PresignedUpload is a POST policy for uploading one object to MinIO.
+type PresignedUpload struct {
+	URL        string
+	FormData   map[string]string
+	ObjectName string
+	ExpiresAt  time.Time
+}
+
+// ResumableUpload is the server-side state of a tus upload. Bytes go to a
+// MinIO multipart upload; the state itself lives in Redis until it expires
+// or the finished object is claimed by CreateVideo.
+type ResumableUpload struct {
+	ID          string
+	UserID      uint64
+	Length      int64
+	Offset      int64
+	ContentType string
+	ObjectName  string
+	URL         string
+	Metadata    map[string]string
+	ExpiresAt   time.Time
+	Done        bool
+}
+
+type UploadRepo interface {
+	CreateResumable(ctx context.Context, up *ResumableUpload) error
+	// GetResumable returns nil if the upload does not exist or has expired.
+	GetResumable(ctx context.Context, id string) (*ResumableUpload, error)
+	// AppendResumable writes r at up.Offset and returns the updated state.
+	// The last chunk completes the underlying multipart upload.
+	// Returns ErrUploadLocked if another append is in progress, and
+	// ErrUploadOffsetMismatch if one has moved the offset since up was read.
+	AppendResumable(ctx context.Context, up *ResumableUpload, r io.Reader) (*ResumableUpload, error)
+	// DeleteResumable drops the state and aborts the multipart upload if it
+	// has not completed. The finished object, if any, is kept.
+	DeleteResumable(ctx context.Context, up *ResumableUpload) error
+	// AbortExpiredResumables discards the parts of at most limit unfinished
+	// uploads that have expired and returns how many it discarded.
+	AbortExpiredResumables(ctx context.Context, limit int) (int, error)
+
+	// CreateUpload records an object as owned by its uploader. Recording
+	// the same object twice is a no-op.
+	CreateUpload(ctx context.Context, u *Upload) (*Upload, error)
+	// GetUpload returns nil if the object is not a recorded upload.
+	GetUpload(ctx context.Context, objectName string) (*Upload, error)
+	// SetUploadHash records the SHA-256 of a verified object.
+	SetUploadHash(ctx context.Context, objectName, sha256 string) error
+	// RejectUpload marks an upload rejected, as a copy of duplicateOf if
+	// set, and hides any video using it.
+	RejectUpload(ctx context.Context, objectName, duplicateOf string) error
+	// FindUploadsByHash returns the stored (pending or attached) uploads
+	// with a SHA-256, oldest first.
+	FindUploadsByHash(ctx context.Context, sha256 string) ([]*Upload, error)
+	// FindPublishedSource returns the first of objectNames that is the
+	// source of a published video of a user other than userID, or "".
+	FindPublishedSource(ctx context.Context, objectNames []string, userID uint64) (string, error)
+	// HasSource reports whether videoURL is the source or pending source
+	// of one of userID's videos.
+	HasSource(ctx context.Context, userID uint64, videoURL string) (bool, error)
+	// MarkDuplicate records that duplicateOf is kept instead of objectName.
+	// It returns false, changing nothing, once a video uses objectName.
+	MarkDuplicate(ctx context.Context, objectName, duplicateOf string) (bool, error)
+}
+
+var (
+	ErrUploadLocked         = errors.New(423, "UPLOAD_LOCKED", "upload is being written by another request")
+	ErrUploadOffsetMismatch = errors.Conflict("UPLOAD_OFFSET_MISMATCH", "offset does not match upload state")
+)
+
+// UploadUsecase checks quotas when an upload starts. Uploads that slip past
+// them (e.g. several started at once) cannot be used by CreateVideo, which
+// checks again, and are collected as orphans.
+type UploadUsecase struct {
+	repo     UploadRepo
+	uploader *upload.MinIOUploader
+	quotas   *QuotaUsecase
+	scanner  Scanner
+	log      *log.Helper
+}
+
+func NewUploadUsecase(repo UploadRepo, uploader *upload.MinIOUploader, quotas *QuotaUsecase, scanner Scanner, logger log.Logger) *UploadUsecase {
+	return &UploadUsecase{
+		repo:     repo,
+		uploader: uploader,
+		quotas:   quotas,
+		scanner:  scanner,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// CreateResumable starts a resumable video upload of length bytes.
+// metadata is the decoded tus Upload-Metadata; "filetype" selects the type.
+func (uc *UploadUsecase) CreateResumable(ctx context.Context, userID uint64, length int64, metadata map[string]string) (*ResumableUpload, error) {
+	if length <= 0 {
+		return nil, errors.BadRequest("UPLOAD_INVALID", "upload length is required")
+	}
+	if length > MaxResumableUploadSize {
+		return nil, errors.New(413, "UPLOAD_TOO_LARGE", "upload exceeds maximum size")
+	}
+	ct := metadata["filetype"]
+	if !containsString(VideoContentTypes, ct) {
+		return nil, errors.BadRequest("UPLOAD_TYPE_UNSUPPORTED", "unsupported content type: "+ct)
+	}
+	if err := uc.quotas.CheckUpload(ctx, userID, "video", length); err != nil {
+		return nil, err
+	}
+
+	objectName := upload.NewObjectName("videos", upload.ExtFromContentType(ct))
+	up := &ResumableUpload{
+		ID:          uuid.New().String(),
+		UserID:      userID,
+		Length:      length,
+		ContentType: ct,
+		ObjectName:  objectName,
+		URL:         uc.uploader.GetURL(objectName),
+		Metadata:    metadata,
+		ExpiresAt:   time.Now().Add(ResumableUploadTTL),
+	}
+	if err := uc.repo.CreateResumable(ctx, up); err != nil {
+		uc.log.Errorf("create resumable upload: %v", err)
+		return nil, errors.InternalServer("INTERNAL", "failed to create upload")
+	}
+	return up, nil
+}
+
+// GetResumable returns an upload owned by userID.
+func (uc *UploadUsecase) GetResumable(ctx context.Context, userID uint64, id string) (*ResumableUpload, error) {
+	up, err := uc.repo.GetResumable(ctx, id)
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to load upload")
+	}
+	if up == nil || up.UserID != userID {
+		return nil, errors.NotFound("UPLOAD_NOT_FOUND", "upload not found")
+	}
+	return up, nil
+}
+
+// AppendResumable writes a chunk that must start at the current offset.
+func (uc *UploadUsecase) AppendResumable(ctx context.Context, userID uint64, id string, offset int64, r io.Reader) (*ResumableUpload, error) {
+	up, err := uc.GetResumable(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	if up.Done {
+		return nil, errors.Conflict("UPLOAD_OFFSET_MISMATCH", "upload already completed")
+	}
+	if offset != up.Offset {
+		return nil, ErrUploadOffsetMismatch
+	}
+	if offset == 0 {
+		br := bufio.NewReaderSize(r, filetype.HeadSize)
+		head, _ := br.Peek(filetype.HeadSize)
+		if err := checkHead(up.ContentType, head); err != nil {
+			return nil, err
+		}
+		r = br
+	}
+
+	updated, err := uc.repo.AppendResumable(ctx, up, r)
+	if err != nil {
+		if errors.Is(err, ErrUploadLocked) || errors.Is(err, ErrUploadOffsetMismatch) {
+			return nil, err
+		}
+		uc.log.Errorf("append to upload %s: %v", id, err)
+		return nil, errors.InternalServer("INTERNAL", "failed to write upload")
+	}
+	if updated.Done {
+		u, err := uc.repo.CreateUpload(ctx, &Upload{
+			UserID:      userID,
+			ObjectName:  updated.ObjectName,
+			Kind:        "video",
+			ContentType: updated.ContentType,
+			Size:        updated.Length,
+		})
+		if err != nil {
+			uc.log.Warnf("record upload %s: %v", updated.ObjectName, err)
+		} else {
+			uc.verifyLater(u)
+		}
+	}
+	return updated, nil
+}
+
+// Run discards the parts of expired resumable uploads every hour until ctx
+// is done.
+func (uc *UploadUsecase) Run(ctx context.Context) {
+	ticker := time.NewTicker(uploadSweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			n, err := uc.AbortExpiredResumables(ctx)
+			if err != nil {
+				uc.log.Errorf("abort expired uploads: %v", err)
+			}
+			if n > 0 {
+				uc.log.Infof("aborted %d expired uploads", n)
+			}
+		}
+	}
+}
+
+// AbortExpiredResumables discards the parts of every expired resumable
+// upload. An upload that fails is retried on the next run.
+func (uc *UploadUsecase) AbortExpiredResumables(ctx context.Context) (int, error) {
+	aborted := 0
+	for {
+		n, err := uc.repo.AbortExpiredResumables(ctx, uploadSweepBatchSize)
+		aborted += n
+		// Stop rather than spin on a batch that keeps failing.
+		if err != nil || n < uploadSweepBatchSize {
+			return aborted, err
+		}
+	}
+}
+
+// TerminateResumable cancels an upload and discards its bytes.
+func (uc *UploadUsecase) TerminateResumable(ctx context.Context, userID uint64, id string) error {
+	up, err := uc.GetResumable(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if err := uc.repo.DeleteResumable(ctx, up); err != nil {
+		uc.log.Errorf("terminate upload %s: %v", id, err)
+		return errors.InternalServer("INTERNAL", "failed to terminate upload")
+	}
+	if up.Done {
+		if err := uc.uploader.Delete(ctx, up.ObjectName); err != nil {
+			uc.log.Warnf("delete terminated upload object %s: %v", up.ObjectName, err)
+		}
+	}
+	return nil
+}
+
+// ClaimResumable hands a finished upload to CreateVideo: it returns the
+// object URL and forgets the upload so it cannot be claimed twice.
+func (uc *UploadUsecase) ClaimResumable(ctx context.Context, userID uint64, id string) (string, error) {
+	up, err := uc.GetResumable(ctx, userID, id)
+	if err != nil {
+		return "", err
+	}
+	if !up.Done {
+		return "", errors.BadRequest("UPLOAD_INCOMPLETE", "upload is not complete")
+	}
+	if err := uc.repo.DeleteResumable(ctx, up); err != nil {
+		return "", errors.InternalServer("INTERNAL", "failed to claim upload")
+	}
+	return up.URL, nil
+}
+
+// CheckThumbnail accepts only a thumbnail image the user uploaded and that
+// was not rejected.
+func (uc *UploadUsecase) CheckThumbnail(ctx context.Context, userID uint64, thumbnailURL string) error {
+	objectName, ok := uc.uploader.ObjectName(thumbnailURL)
+	if !ok || !isUploadObject(objectName) || !strings.HasPrefix(objectName, uploadKinds["thumbnail"].dir+"/") {
+		return ErrThumbnailInvalid
+	}
+	u, err := uc.repo.GetUpload(ctx, objectName)
+	if err != nil {
+		uc.log.Errorf("load upload %s: %v", objectName, err)
+		return errors.InternalServer("INTERNAL", "failed to load upload")
+	}
+	if u == nil || u.UserID != userID || u.Status == UploadRejected || u.Status == UploadDeleted {
+		return ErrThumbnailInvalid
+	}
+	return nil
+}
+
+// CreatePresigned issues a POST policy for uploading a kind ("video" or
+// "thumbnail") object of at most size bytes directly to MinIO.
+func (uc *UploadUsecase) CreatePresigned(ctx context.Context, userID uint64, kind, contentType string, size int64) (*PresignedUpload, error) {
+	k, ok := uploadKinds[kind]
+	if !ok {
+		return nil, errors.BadRequest("UPLOAD_INVALID", "unknown upload kind: "+kind)
+	}
+	if !containsString(k.types, contentType) {
+		return nil, errors.BadRequest("UPLOAD_TYPE_UNSUPPORTED", "unsupported content type: "+contentType)
+	}
+	if size <= 0 {
+		return nil, errors.BadRequest("UPLOAD_INVALID", "upload size is required")
+	}
+	if size > k.maxSize {
+		return nil, errors.New(413, "UPLOAD_TOO_LARGE", "upload exceeds maximum size")
+	}
+	if err := uc.quotas.CheckUpload(ctx, userID, kind, size); err != nil {
+		return nil, err
+	}
+
+	objectName := upload.NewObjectName(k.dir, upload.ExtFromContentType(contentType))
+	expiresAt := time.Now().Add(PresignedUploadTTL)
+	url, formData, err := uc.uploader.PresignPost(ctx, objectName, contentType, size, expiresAt, map[string]string{
+		uploaderMetaKey: strconv.FormatUint(userID, 10),
+	})
+	if err != nil {
+		uc.log.Errorf("presign upload: %v", err)
+		return nil, errors.InternalServer("INTERNAL", "failed to presign upload")
+	}
+	return &PresignedUpload{URL: url, FormData: formData, ObjectName: objectName, ExpiresAt: expiresAt}, nil
+}
+
+// CompleteUpload verifies that a presigned upload reached MinIO with an
+// allowed type and size, and records it as owned by userID. Objects that
+// fail validation are deleted, as are images the user already stored,
+// whose earlier upload is returned instead.
+//
+// The POST policy stays valid until it expires, so the client could still
+// replace the object after it was checked. It is moved to a name only the
+// server knows first, and that copy is what gets checked and recorded.
+func (uc *UploadUsecase) CompleteUpload(ctx context.Context, userID uint64, objectName string) (*Upload, error) {
+	dir, _, _ := strings.Cut(objectName, "/")
+	var kindName string
+	var kind uploadKind
+	for name, k := range uploadKinds {
+		if k.dir == dir {
+			kindName, kind = name, k
+		}
+	}
+	if kindName == "" {
+		return nil, errors.NotFound("UPLOAD_NOT_FOUND", "upload not found")
+	}
+
+	info, err := uc.uploader.Stat(ctx, objectName)
+	if err != nil {
+		if upload.IsNotFound(err) {
+			return nil, errors.NotFound("UPLOAD_NOT_FOUND", "upload not found")
+		}
+		uc.log.Errorf("stat upload %s: %v", objectName, err)
+		return nil, errors.InternalServer("INTERNAL", "failed to verify upload")
+	}
+	// Objects presigned for someone else look the same as missing ones.
+	if info.UserMetadata[uploaderMetaKey] != strconv.FormatUint(userID, 10) {
+		return nil, errors.NotFound("UPLOAD_NOT_FOUND", "upload not found")
+	}
+	if !containsString(kind.types, info.ContentType) || info.Size > kind.maxSize {
+		if err := uc.uploader.Delete(ctx, objectName); err != nil {
+			uc.log.Warnf("delete rejected upload %s: %v", objectName, err)
+		}
+		return nil, errors.BadRequest("UPLOAD_TYPE_UNSUPPORTED", "uploaded object does not match the policy")
+	}
+
+	// Reading the object back outlives the server timeout.
+	ctx = context.WithoutCancel(ctx)
+	presigned := objectName
+	objectName = upload.NewObjectName(kind.dir, path.Ext(presigned))
+	if err := uc.uploader.Copy(ctx, presigned, objectName, info.ETag); err != nil {
+		uc.log.Errorf("move upload %s: %v", presigned, err)
+		return nil, errors.InternalServer("INTERNAL", "failed to verify upload")
+	}
+	// Anything posted to the old name from now on is collected as an orphan.
+	if err := uc.uploader.Delete(ctx, presigned); err != nil {
+		uc.log.Warnf("delete moved upload %s: %v", presigned, err)
+	}
+	size, sum, err := uc.inspectStored(ctx, kind, objectName, info)
+	if err != nil {
+		if errors.Is(err, ErrUploadContentMismatch) || errors.Is(err, ErrUploadRejected) {
+			if err := uc.uploader.Delete(ctx, objectName); err != nil {
+				uc.log.Warnf("delete rejected upload %s: %v", objectName, err)
+			}
+			return nil, err
+		}
+		uc.log.Errorf("inspect upload %s: %v", objectName, err)
+		return nil, errors.InternalServer("INTERNAL", "failed to verify upload")
+	}
+
+	u := &Upload{
+		UserID:      userID,
+		ObjectName:  objectName,
+		Kind:        kindName,
+		ContentType: info.ContentType,
+		Size:        size,
+		SHA256:      sum,
+	}
+	// Videos are hashed later; see verify.
+	if sum != "" {
+		if own, _ := uc.duplicateOf(ctx, u); own != nil {
+			uc.discardDuplicate(ctx, u, own, "")
+			own.URL = uc.uploader.GetURL(own.ObjectName)
+			return own, nil
+		}
+	}
+
+	recorded, err := uc.repo.CreateUpload(ctx, u)
+	if err != nil {
+		uc.log.Errorf("record upload %s: %v", objectName, err)
+		return nil, errors.InternalServer("INTERNAL", "failed to record upload")
+	}
+	recorded.URL = uc.uploader.GetURL(recorded.ObjectName)
+	uc.verifyLater(recorded)
+	return recorded, nil
+}
+
+// UploadStream stores exactly size bytes of a video read from r and records
+// it as owned by userID. Used by the client-streaming gRPC upload.
+func (uc *UploadUsecase) UploadStream(ctx context.Context, userID uint64, contentType string, size int64, r io.Reader) (*Upload, error) {
+	return uc.UploadFile(ctx, userID, "video", contentType, size, r)
+}
+
+// UploadFile stores a kind ("video" or "thumbnail") object of exactly size
+// bytes read from r and records it as owned by userID. Its content must
+// match contentType; images are stored without their metadata. If the
+// user already stored the same content, that upload is returned instead.
+func (uc *UploadUsecase) UploadFile(ctx context.Context, userID uint64, kindName, contentType string, size int64, r io.Reader) (*Upload, error) {
+	kind, ok := uploadKinds[kindName]
+	if !ok {
+		return nil, errors.BadRequest("UPLOAD_INVALID", "unknown upload kind: "+kindName)
+	}
+	if !containsString(kind.types, contentType) {
+		return nil, errors.BadRequest("UPLOAD_TYPE_UNSUPPORTED", "unsupported content type: "+contentType)
+	}
+	if size <= 0 {
+		return nil, errors.BadRequest("UPLOAD_INVALID", "upload size is required")
+	}
+	if size > kind.maxSize {
+		return nil, errors.New(413, "UPLOAD_TOO_LARGE", "upload exceeds maximum size")
+	}
+	if err := uc.quotas.CheckUpload(ctx, userID, kindName, size); err != nil {
+		return nil, err
+	}
+
+	u := &Upload{UserID: userID, Kind: kindName, ContentType: contentType, Size: size}
+	if kind.image {
+		data, sum, err := uc.readImage(ctx, contentType, size, r)
+		if err != nil {
+			return nil, err
+		}
+		u.SHA256, u.Size = sum, int64(len(data))
+		// Reuse an identical image of the user's rather than store it twice.
+		if own, _ := uc.duplicateOf(ctx, u); own != nil {
+			own.URL = uc.uploader.GetURL(own.ObjectName)
+			return own, nil
+		}
+		u.ObjectName, err = uc.uploader.Upload(ctx, bytes.NewReader(data), u.Size, contentType, kind.dir, upload.ExtFromContentType(contentType))
+		if err != nil {
+			uc.log.Errorf("upload image: %v", err)
+			return nil, errors.InternalServer("INTERNAL", "upload failed")
+		}
+	} else {
+		var err error
+		u.ObjectName, u.SHA256, err = uc.storeStream(ctx, kind, contentType, size, r)
+		if err != nil {
+			return nil, err
+		}
+		original, err := uc.duplicateOf(ctx, u)
+		if err != nil {
+			uc.discardDuplicate(ctx, u, original, UploadRejected)
+			return nil, err
+		}
+		if original != nil {
+			uc.discardDuplicate(ctx, u, original, "")
+			original.URL = uc.uploader.GetURL(original.ObjectName)
+			return original, nil
+		}
+	}
+
+	recorded, err := uc.repo.CreateUpload(ctx, u)
+	if err != nil {
+		uc.log.Errorf("record upload %s: %v", u.ObjectName, err)
+		return nil, errors.InternalServer("INTERNAL", "failed to record upload")
+	}
+	recorded.URL = uc.uploader.GetURL(recorded.ObjectName)
+	uc.verifyLater(recorded)
+	return recorded, nil
+}
+
+// readImage reads a whole image and inspects it, returning the cleaned
+// image and its SHA-256.
+func (uc *UploadUsecase) readImage(ctx context.Context, contentType string, size int64, r io.Reader) ([]byte, string, error) {
+	data, err := io.ReadAll(io.LimitReader(r, size+1))
+	if err != nil {
+		return nil, "", errors.BadRequest("UPLOAD_INVALID", "failed to read upload")
+	}
+	if int64(len(data)) < size {
+		return nil, "", errors.BadRequest("UPLOAD_INVALID", "stream ended before the declared size")
+	}
+	if int64(len(data)) > size {
+		return nil, "", errors.BadRequest("UPLOAD_INVALID", "stream exceeds the declared size")
+	}
+	return uc.inspectImage(ctx, contentType, data)
+}
+
+// storeStream checks the signature of a video and stores exactly size
+// bytes of it, hashing them on the way.
+func (uc *UploadUsecase) storeStream(ctx context.Context, kind uploadKind, contentType string, size int64, r io.Reader) (string, string, error) {
+	br := bufio.NewReaderSize(r, filetype.HeadSize)
+	head, _ := br.Peek(filetype.HeadSize) // shorter at the end of the stream
+	if err := checkHead(contentType, head); err != nil {
+		return "", "", err
+	}
+
+	h := sha256.New()
+	cr := &countingReader{r: io.TeeReader(br, h)}
+	objectName, err := uc.uploader.Upload(ctx, cr, size, contentType, kind.dir, upload.ExtFromContentType(contentType))
+	if err != nil {
+		if cr.n < size {
+			return "", "", errors.BadRequest("UPLOAD_INVALID", "stream ended before the declared size")
+		}
+		uc.log.Errorf("upload video: %v", err)
+		return "", "", errors.InternalServer("INTERNAL", "upload failed")
+	}
+	// PutObject stops at size; anything left means the header lied.
+	if n, _ := io.Copy(io.Discard, io.LimitReader(br, 1)); n > 0 {
+		if err := uc.uploader.Delete(ctx, objectName); err != nil {
+			uc.log.Warnf("delete oversized upload %s: %v", objectName, err)
+		}
+		return "", "", errors.BadRequest("UPLOAD_INVALID", "stream exceeds the declared size")
+	}
+	return objectName, hex.EncodeToString(h.Sum(nil)), nil
+}
+
+type countingReader struct {
+	r io.Reader
+	n int64
+}
+
+func (c *countingReader) Read(p []byte) (int, error) {
+	n, err := c.r.Read(p)
+	c.n += int64(n)
+	return n, err
+}
+
+func containsString(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -2,45 +2,128 @@ package biz
 
 import (
 	"context"
+	"math/rand"
 	"time"
 
+	"backend/internal/pkg/media"
 	"backend/internal/pkg/pagination"
+	"backend/internal/pkg/playback"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 )
 
 type Video struct {
-	ID             uint64
-	UserID         uint64
-	Username       string
-	CategoryID     uint64
-	CategoryName   string
-	Title          string
-	Description    string
-	VideoURL       string
-	ThumbnailURL   string
-	Duration       uint32
+	ID           uint64
+	UserID       uint64
+	Username     string
+	CategoryID   uint64
+	CategoryName string
+	Title        string
+	Description  string
+	VideoURL     string
+	ThumbnailURL string
+	Duration     uint32
+	Width        uint32
+	Height       uint32
+	VideoCodec   string
+	AudioCodec   string
+	Bitrate      uint64
+	FileSize     uint64
+	// Status is one of the Video* lifecycle constants and Visibility one of
+	// the Visibility* constants (see video_status.go).
+	Status             string
+	Visibility         string
+	ProcessingProgress uint32 // percent, while VideoProcessing or replacing the source
+	ProcessingError    string // set when VideoFailed, or when transcoding or a replacement source failed
+	// PendingVideoURL is a replacement source being processed; VideoURL
+	// keeps playing until it is swapped in.
+	PendingVideoURL string
+	// TranscodeStatus is one of the Transcode* constants. HLSURL is the
+	// stored master playlist URL once it is TranscodeReady.
+	TranscodeStatus string
+	HLSURL          string
+	// ThumbnailVariants are generated from ThumbnailURL after processing.
+	ThumbnailVariants ThumbnailVariants
+	// SeekPreviewURL is the stored WebVTT thumbnails track for scrubbing.
+	SeekPreviewURL string
+	// Captions and Chapters are only loaded by GetVideo.
+	Captions       []*Caption
+	Chapters       []*Chapter
 	ViewsMember    uint64
 	ViewsNonMember uint64
 	AccessTier     int8
-	IsPublished    bool
 	IsHidden       bool
-	Tags           []*Tag
-	CreatedAt      time.Time
+	// PublishAt is when a scheduled video, private until then, is
+	// published.
+	PublishAt *time.Time
+	// Version counts metadata changes, for optimistic concurrency.
+	Version   uint64
+	Tags      []*Tag
+	CreatedAt time.Time
 }
 
+// Methods of VideoRepo taking a rev record it as a revision, unless nil, in
+// the same transaction as the change, which fails if it cannot be recorded.
 type VideoRepo interface {
-	Create(ctx context.Context, video *Video) (*Video, error)
-	Update(ctx context.Context, video *Video) (*Video, error)
+	// Create stores a video with its tags; rev gets the new video's ID.
+	Create(ctx context.Context, video *Video, rev *VideoRevision) (*Video, error)
+	// Update applies a partial update, replacing tags too when they are
+	// among its fields, and bumps the version. It reports false if the
+	// video is no longer at update.Version.
+	Update(ctx context.Context, update *VideoUpdate, rev *VideoRevision) (bool, error)
+	// Delete moves a video to the trash; see TrashRepo.
 	Delete(ctx context.Context, id uint64) error
 	FindByID(ctx context.Context, id uint64) (*Video, error)
-	ListByTags(ctx context.Context, tagIDs []uint64, offset, limit int) ([]*Video, int64, error)
-	ListRandom(ctx context.Context, offset, limit int) ([]*Video, int64, error)
+	// ListByTags and ListRandom shuffle by page.Seed when set, else ORDER BY RAND().
+	ListByTags(ctx context.Context, tagIDs []uint64, page pagination.Request) ([]*Video, int64, error)
+	ListRandom(ctx context.Context, page pagination.Request) ([]*Video, int64, error)
 	IncrementViews(ctx context.Context, id uint64, isMember bool) error
-	TogglePublish(ctx context.Context, id uint64, published bool) error
+	// SetVisibility also cancels a schedule and bumps the version.
+	SetVisibility(ctx context.Context, id uint64, visibility string, rev *VideoRevision) error
+	// UpdateStatus changes the status only if it is still from, reporting
+	// whether it did.
+	UpdateStatus(ctx context.Context, id uint64, from, to, errMsg string) (bool, error)
+	UpdateProgress(ctx context.Context, id uint64, percent uint32) error
 	GetTagIDsByVideo(ctx context.Context, videoID uint64) ([]uint64, error)
 	SetVideoTags(ctx context.Context, videoID uint64, tagIDs []uint64) error
+	UpdateTranscode(ctx context.Context, id uint64, status, hlsURL string) error
+	// SetThumbnail replaces the thumbnail and its variants only if the
+	// thumbnail is still expected, reporting whether it did. A new
+	// thumbnail bumps the version and records rev.
+	SetThumbnail(ctx context.Context, id uint64, expected, url string, variants *ThumbnailVariants, rev *VideoRevision) (bool, error)
+	ReplaceThumbnailCandidates(ctx context.Context, videoID uint64, candidates []*ThumbnailCandidate) error
+	ListThumbnailCandidates(ctx context.Context, videoID uint64) ([]*ThumbnailCandidate, error)
+	SetSeekPreview(ctx context.Context, id uint64, url string) error
+	// SetPendingSource starts replacing the source of a ready video,
+	// superseding any replacement in progress. It reports false if the
+	// video is not ready.
+	SetPendingSource(ctx context.Context, id uint64, url string) (bool, error)
+	// CancelPendingSource drops the replacement url, if still pending,
+	// recording why.
+	CancelPendingSource(ctx context.Context, id uint64, url, errMsg string) error
+	// ReplaceSource swaps in to's source with its media facts, renditions
+	// and seek preview, provided the video still has from's source and
+	// pending source, then deletes the files of the old source. It reports
+	// false if either changed.
+	ReplaceSource(ctx context.Context, from, to *Video) (bool, error)
+	// ReplaceChapters replaces all chapters of a video, ordered by start.
+	ReplaceChapters(ctx context.Context, videoID uint64, chapters []*Chapter) error
+	ListChapters(ctx context.Context, videoID uint64) ([]*Chapter, error)
+	// ListScheduled lists a user's videos waiting for their publish time,
+	// soonest first.
+	ListScheduled(ctx context.Context, userID uint64, offset, limit int) ([]*Video, int64, error)
+	// ListRevisions returns a video's revisions, newest first.
+	ListRevisions(ctx context.Context, videoID uint64, offset, limit int) ([]*VideoRevision, int64, error)
+	// FindRevision returns nil if the video has no such revision.
+	FindRevision(ctx context.Context, videoID, revisionID uint64) (*VideoRevision, error)
+	CreateShare(ctx context.Context, share *VideoShare) (*VideoShare, error)
+	// ListShares returns a video's share links, newest first.
+	ListShares(ctx context.Context, videoID uint64) ([]*VideoShare, error)
+	// FindShareByToken returns nil if no share link has the token.
+	FindShareByToken(ctx context.Context, token string) (*VideoShare, error)
+	// DeleteShare reports whether the video had that share link.
+	DeleteShare(ctx context.Context, videoID, shareID uint64) (bool, error)
 }
 
 // MembershipChecker checks if a user has a membership to a channel.
@@ -49,47 +132,191 @@ type MembershipChecker interface {
 	HasMembership(ctx context.Context, userID, channelOwnerUserID uint64) (tier int8, err error)
 }
 
+// MediaInfo is what probing an uploaded video file reveals.
+type MediaInfo struct {
+	Duration   uint32 // seconds, rounded up
+	Width      uint32
+	Height     uint32
+	VideoCodec string
+	AudioCodec string
+	Bitrate    uint64
+	FileSize   uint64
+}
+
+// MediaProber inspects the file behind a stored video URL.
+// Implemented with ffprobe in the data layer.
+type MediaProber interface {
+	// Probe returns an error wrapping media.ErrNotVideo if the file cannot
+	// be decoded, or ErrVideoSourceInvalid if the URL is not in storage.
+	Probe(ctx context.Context, videoURL string) (*MediaInfo, error)
+}
+
+var ErrVideoSourceInvalid = errors.BadRequest("VIDEO_SOURCE_INVALID", "video must be uploaded to storage first")
+
 type VideoUsecase struct {
 	repo       VideoRepo
 	tagUsecase *TagUsecase
 	membership MembershipChecker
+	cursors    *pagination.CursorCodec
+	playback   *playback.Signer
+	prober     MediaProber
+	transcodes TranscodeQueue
+	captions   CaptionRepo
+	quotas     *QuotaUsecase
+	uploads    *UploadUsecase
 	log        *log.Helper
 }
 
-func NewVideoUsecase(repo VideoRepo, tagUsecase *TagUsecase, membership MembershipChecker, logger log.Logger) *VideoUsecase {
+func NewVideoUsecase(repo VideoRepo, tagUsecase *TagUsecase, membership MembershipChecker, cursors *pagination.CursorCodec, playback *playback.Signer, prober MediaProber, transcodes TranscodeQueue, captions CaptionRepo, quotas *QuotaUsecase, uploads *UploadUsecase, logger log.Logger) *VideoUsecase {
 	return &VideoUsecase{
 		repo:       repo,
 		tagUsecase: tagUsecase,
 		membership: membership,
+		cursors:    cursors,
+		playback:   playback,
+		prober:     prober,
+		transcodes: transcodes,
+		captions:   captions,
+		quotas:     quotas,
+		uploads:    uploads,
 		log:        log.NewHelper(logger),
 	}
 }
 
 func (uc *VideoUsecase) CreateVideo(ctx context.Context, userID uint64, video *Video) (*Video, error) {
 	video.UserID = userID
-	video.IsPublished = true
 	video.IsHidden = false
+	if video.Visibility == "" {
+		video.Visibility = VisibilityPublished
+	}
+	if !validVisibility(video.Visibility) {
+		return nil, ErrVideoInvalidVisibility
+	}
+	if video.PublishAt != nil {
+		if err := checkSchedule(video.PublishAt, video.Visibility); err != nil {
+			return nil, err
+		}
+		video.Visibility = VisibilityPrivate
+	}
+	if video.ThumbnailURL != "" {
+		if err := uc.uploads.CheckThumbnail(ctx, userID, video.ThumbnailURL); err != nil {
+			return nil, err
+		}
+	}
+	if err := uc.quotas.CheckVideo(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	if err := uc.probe(ctx, video); err != nil {
+		return nil, err
+	}
+	// The probe has vetted the file; without transcoding it plays as is.
+	video.Status = VideoReady
+	video.TranscodeStatus = TranscodeNone
+	if uc.transcodes != nil {
+		video.Status = VideoProcessing
+		video.TranscodeStatus = TranscodePending
+	}
 
-	created, err := uc.repo.Create(ctx, video)
+	created, err := uc.repo.Create(ctx, video, &VideoRevision{ActorID: userID, Action: RevisionCreated})
 	if err != nil {
 		return nil, errors.InternalServer("INTERNAL", "failed to create video")
 	}
 
-	// Associate tags
-	if len(video.Tags) > 0 {
-		tagIDs := make([]uint64, len(video.Tags))
-		for i, t := range video.Tags {
-			tagIDs[i] = t.ID
+	uc.chaptersFromDescription(ctx, created.ID, video.Description, video.Duration)
+	uc.enqueueTranscode(ctx, created)
+
+	return uc.repo.FindByID(ctx, created.ID)
+}
+
+// probe replaces the client-supplied media facts of video (duration etc.)
+// with what the file actually contains, rejecting undecodable files.
+// Without a prober the client's duration is kept.
+func (uc *VideoUsecase) probe(ctx context.Context, video *Video) error {
+	if uc.prober == nil {
+		return nil
+	}
+	info, err := uc.prober.Probe(ctx, video.VideoURL)
+	if err != nil {
+		if errors.Is(err, media.ErrNotVideo) {
+			return errors.BadRequest("VIDEO_UNDECODABLE", "file is not a decodable video")
 		}
-		if err := uc.repo.SetVideoTags(ctx, created.ID, tagIDs); err != nil {
-			uc.log.Warnf("failed to set video tags: %v", err)
+		if errors.Is(err, ErrVideoSourceInvalid) {
+			return ErrVideoSourceInvalid
 		}
+		uc.log.Errorf("probe %s: %v", video.VideoURL, err)
+		return errors.InternalServer("INTERNAL", "failed to inspect video")
 	}
+	video.Duration = info.Duration
+	video.Width = info.Width
+	video.Height = info.Height
+	video.VideoCodec = info.VideoCodec
+	video.AudioCodec = info.AudioCodec
+	video.Bitrate = info.Bitrate
+	video.FileSize = info.FileSize
+	return nil
+}
 
-	return uc.repo.FindByID(ctx, created.ID)
+// enqueueTranscode schedules HLS renditions for a new video. Failing to
+// enqueue is not fatal: the video becomes ready with its original file.
+func (uc *VideoUsecase) enqueueTranscode(ctx context.Context, video *Video) {
+	if uc.transcodes == nil {
+		return
+	}
+	err := uc.transcodes.Enqueue(ctx, &TranscodeJob{VideoID: video.ID, VideoURL: video.VideoURL})
+	if err == nil {
+		return
+	}
+	uc.log.Errorf("enqueue transcode for video %d: %v", video.ID, err)
+	if err := uc.repo.UpdateTranscode(ctx, video.ID, TranscodeNone, ""); err != nil {
+		uc.log.Warnf("reset transcode status of video %d: %v", video.ID, err)
+	}
+	if err := uc.Transition(ctx, video.ID, VideoReady, ""); err != nil {
+		uc.log.Warnf("mark video %d ready: %v", video.ID, err)
+	}
 }
 
 func (uc *VideoUsecase) GetVideo(ctx context.Context, videoID uint64, viewerID *uint64, viewerRole string) (*Video, error) {
+	video, err := uc.CheckAccess(ctx, videoID, viewerID, viewerRole)
+	if err != nil {
+		return nil, err
+	}
+
+	// Increment views
+	isMember := viewerID != nil
+	_ = uc.repo.IncrementViews(ctx, videoID, isMember)
+
+	// The bucket is private: hand out a short-lived URL bound to this viewer
+	// instead of the permanent object path. Once transcoded, that is the HLS
+	// master playlist rather than the original file.
+	var uid uint64
+	if viewerID != nil {
+		uid = *viewerID
+	}
+	if video.TranscodeStatus == TranscodeReady && video.HLSURL != "" {
+		video.VideoURL = uc.playback.SignHLSURL(video.HLSURL, uid)
+	} else {
+		video.VideoURL = uc.playback.SignURL(video.VideoURL, uid)
+	}
+	if video.SeekPreviewURL != "" {
+		video.SeekPreviewURL = uc.playback.SignHLSURL(video.SeekPreviewURL, uid)
+	}
+	captions, err := uc.captions.List(ctx, videoID)
+	if err != nil {
+		uc.log.Warnf("list captions of video %d: %v", videoID, err)
+	}
+	video.Captions = uc.signCaptions(captions, viewerID)
+	if video.Chapters, err = uc.repo.ListChapters(ctx, videoID); err != nil {
+		uc.log.Warnf("list chapters of video %d: %v", videoID, err)
+	}
+
+	return video, nil
+}
+
+// CheckAccess loads a video and enforces visibility and access tier for the
+// viewer, without counting a view. Shared by GetVideo and the stream proxy.
+// A share token in ctx that grants the video lifts both.
+func (uc *VideoUsecase) CheckAccess(ctx context.Context, videoID uint64, viewerID *uint64, viewerRole string) (*Video, error) {
 	video, err := uc.repo.FindByID(ctx, videoID)
 	if err != nil {
 		return nil, errors.NotFound("VIDEO_NOT_FOUND", "video not found")
@@ -97,19 +324,26 @@ func (uc *VideoUsecase) GetVideo(ctx context.Context, videoID uint64, viewerID *
 
 	isOwner := viewerID != nil && *viewerID == video.UserID
 	isAdmin := viewerRole == "admin"
+	isShared := !isOwner && !isAdmin && uc.sharedWith(ctx, videoID)
 
 	// Hidden check: only admin or owner can see
 	if video.IsHidden && !isAdmin && !isOwner {
 		return nil, errors.NotFound("VIDEO_NOT_FOUND", "video not found")
 	}
 
-	// Published check: only owner can see unpublished
-	if !video.IsPublished && !isOwner {
+	// Private check: only owner can see private videos
+	if video.Visibility == VisibilityPrivate && !isOwner && !isShared {
+		return nil, errors.NotFound("VIDEO_NOT_FOUND", "video not found")
+	}
+
+	// Lifecycle check: videos still processing (or failed) are not playable
+	// by others yet
+	if video.Status != VideoReady && !isAdmin && !isOwner {
 		return nil, errors.NotFound("VIDEO_NOT_FOUND", "video not found")
 	}
 
 	// Access tier check
-	if video.AccessTier > 0 && !isOwner && !isAdmin {
+	if video.AccessTier > 0 && !isOwner && !isAdmin && !isShared {
 		if viewerID == nil {
 			return nil, errors.Forbidden("VIDEO_ACCESS_DENIED", "membership required")
 		}
@@ -121,39 +355,11 @@ func (uc *VideoUsecase) GetVideo(ctx context.Context, videoID uint64, viewerID *
 		}
 	}
 
-	// Increment views
-	isMember := viewerID != nil
-	_ = uc.repo.IncrementViews(ctx, videoID, isMember)
-
 	return video, nil
 }
 
-func (uc *VideoUsecase) UpdateVideo(ctx context.Context, userID uint64, video *Video) (*Video, error) {
-	existing, err := uc.repo.FindByID(ctx, video.ID)
-	if err != nil {
-		return nil, errors.NotFound("VIDEO_NOT_FOUND", "video not found")
-	}
-	if existing.UserID != userID {
-		return nil, errors.Forbidden("VIDEO_NOT_OWNER", "not the owner of this video")
-	}
-
-	updated, err := uc.repo.Update(ctx, video)
-	if err != nil {
-		return nil, errors.InternalServer("INTERNAL", "failed to update video")
-	}
-
-	// Update tags if provided
-	if len(video.Tags) > 0 {
-		tagIDs := make([]uint64, len(video.Tags))
-		for i, t := range video.Tags {
-			tagIDs[i] = t.ID
-		}
-		_ = uc.repo.SetVideoTags(ctx, video.ID, tagIDs)
-	}
-
-	return uc.repo.FindByID(ctx, updated.ID)
-}
-
+// DeleteVideo moves the owner's video to the trash, where it can be
+// restored until it is purged.
 func (uc *VideoUsecase) DeleteVideo(ctx context.Context, userID, videoID uint64) error {
 	video, err := uc.repo.FindByID(ctx, videoID)
 	if err != nil {
@@ -162,12 +368,14 @@ func (uc *VideoUsecase) DeleteVideo(ctx context.Context, userID, videoID uint64)
 	if video.UserID != userID {
 		return errors.Forbidden("VIDEO_NOT_OWNER", "not the owner of this video")
 	}
-	if video.IsPublished {
+	if video.Status == VideoReady && video.Visibility == VisibilityPublished {
 		return errors.BadRequest("VIDEO_ACCESS_DENIED", "cannot delete a published video; unpublish first")
 	}
 	return uc.repo.Delete(ctx, videoID)
 }
 
+// TogglePublish switches a video between published and private, cancelling
+// any scheduled publishing.
 func (uc *VideoUsecase) TogglePublish(ctx context.Context, userID, videoID uint64, published bool) (*Video, error) {
 	video, err := uc.repo.FindByID(ctx, videoID)
 	if err != nil {
@@ -177,21 +385,66 @@ func (uc *VideoUsecase) TogglePublish(ctx context.Context, userID, videoID uint6
 		return nil, errors.Forbidden("VIDEO_NOT_OWNER", "not the owner of this video")
 	}
 
-	if err := uc.repo.TogglePublish(ctx, videoID, published); err != nil {
+	visibility := VisibilityPrivate
+	if published {
+		visibility = VisibilityPublished
+	}
+	if err := uc.repo.SetVisibility(ctx, videoID, visibility, &VideoRevision{
+		VideoID: videoID,
+		ActorID: userID,
+		Action:  RevisionPublished,
+		Fields:  []string{VideoFieldVisibility},
+	}); err != nil {
 		return nil, errors.InternalServer("INTERNAL", "failed to toggle publish")
 	}
 
 	return uc.repo.FindByID(ctx, videoID)
 }
 
-func (uc *VideoUsecase) GetRecommended(ctx context.Context, userID *uint64, sessionID *string, page, pageSize int32) ([]*Video, int64, error) {
-	offset, limit := pagination.Normalize(page, pageSize)
+// GetRecommended returns a shuffled page of recommendations.
+//
+// The first page draws a fresh shuffle seed and the returned cursor carries
+// it, so following cursors walks one stable shuffle without repeats. Plain
+// page > 1 requests keep the old ORDER BY RAND() behaviour.
+func (uc *VideoUsecase) GetRecommended(ctx context.Context, userID *uint64, sessionID *string, page, pageSize int32, cursor string) ([]*Video, int64, string, error) {
+	req, limit, err := newPageRequest(uc.cursors, page, pageSize, cursor, sortShuffle)
+	if err != nil {
+		return nil, 0, "", err
+	}
+	if !req.Keyset() && req.Offset == 0 {
+		req.Seed = rand.Uint32() | 1 // never 0, which means "unseeded"
+	}
+
+	// A seeded shuffle spans several requests, so it must cover all preferred
+	// tags instead of a per-request random subset.
+	var tagIDs []uint64
+	if req.Seed != 0 {
+		tagIDs, err = uc.tagUsecase.GetPreferredTagIDs(ctx, userID, sessionID)
+	} else {
+		tagIDs, err = uc.tagUsecase.GetRecommendedTagIDs(ctx, userID, sessionID)
+	}
 
-	tagIDs, err := uc.tagUsecase.GetRecommendedTagIDs(ctx, userID, sessionID)
+	var videos []*Video
+	var total int64
 	if err != nil || len(tagIDs) == 0 {
 		// No tags: fallback to random published videos
-		return uc.repo.ListRandom(ctx, offset, limit)
+		videos, total, err = uc.repo.ListRandom(ctx, req)
+	} else {
+		videos, total, err = uc.repo.ListByTags(ctx, tagIDs, req)
+	}
+	if err != nil {
+		return nil, 0, "", err
 	}
 
-	return uc.repo.ListByTags(ctx, tagIDs, offset, limit)
+	if req.Seed == 0 {
+		if len(videos) > limit {
+			videos = videos[:limit]
+		}
+		return videos, total, "", nil
+	}
+	seed := req.Seed
+	videos, next := nextPage(uc.cursors, videos, limit, func(v *Video) *pagination.Cursor {
+		return &pagination.Cursor{Sort: sortShuffle, Key: pagination.ShuffleKey(v.ID, seed), ID: v.ID, Seed: seed}
+	})
+	return videos, total, next, nil
 }
@@ -0,0 +1,86 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Video lifecycle. A video only appears in feeds, search and to other
+// viewers once it is VideoReady:
+//
+//	processing ──> ready
+//	  │  ^  ^        │
+//	  │  │  └────────┘ (re-processing)
+//	  v  │
+//	failed (retry)
+//
+// A video whose transcoding fails is still ready: it plays its original
+// file, with TranscodeFailed and the reason as its processing error.
+const (
+	VideoProcessing = "processing"
+	VideoReady      = "ready"
+	VideoFailed     = "failed"
+)
+
+// Visibility decides who may watch a ready video. Unlisted videos play for
+// anyone with the link but are left out of feeds and search.
+const (
+	VisibilityPublished = "published"
+	VisibilityUnlisted  = "unlisted"
+	VisibilityPrivate   = "private"
+)
+
+var videoTransitions = map[string][]string{
+	VideoProcessing: {VideoReady, VideoFailed},
+	VideoReady:      {VideoProcessing},
+	VideoFailed:     {VideoProcessing},
+}
+
+var (
+	ErrVideoInvalidTransition = errors.Conflict("VIDEO_INVALID_TRANSITION", "video cannot change to that status")
+	ErrVideoInvalidVisibility = errors.BadRequest("VIDEO_INVALID_VISIBILITY", "visibility must be published, unlisted or private")
+)
+
+func canTransition(from, to string) bool {
+	return containsString(videoTransitions[from], to)
+}
+
+func validVisibility(v string) bool {
+	return v == VisibilityPublished || v == VisibilityUnlisted || v == VisibilityPrivate
+}
+
+// Listed reports whether v may appear in feeds and search.
+func (v *Video) Listed() bool {
+	return v.Status == VideoReady && v.Visibility == VisibilityPublished && !v.IsHidden
+}
+
+// Transition moves a video to status, recording errMsg as its processing
+// error (cleared when empty).
+// The change is a compare-and-set on the current status, so concurrent
+// workers cannot both move a video out of the same state.
+func (uc *VideoUsecase) Transition(ctx context.Context, videoID uint64, to, errMsg string) error {
+	video, err := uc.repo.FindByID(ctx, videoID)
+	if err != nil {
+		return errors.NotFound("VIDEO_NOT_FOUND", "video not found")
+	}
+	if !canTransition(video.Status, to) {
+		return ErrVideoInvalidTransition
+	}
+	ok, err := uc.repo.UpdateStatus(ctx, videoID, video.Status, to, errMsg)
+	if err != nil {
+		return errors.InternalServer("INTERNAL", "failed to update video status")
+	}
+	if !ok {
+		return ErrVideoInvalidTransition
+	}
+	return nil
+}
+
+// ReportProgress records how far processing has got, in percent.
+func (uc *VideoUsecase) ReportProgress(ctx context.Context, videoID uint64, percent uint32) error {
+	if percent > 100 {
+		percent = 100
+	}
+	return uc.repo.UpdateProgress(ctx, videoID, percent)
+}
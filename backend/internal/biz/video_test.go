@@ -0,0 +1,140 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"backend/internal/conf"
+	"backend/internal/pkg/media"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// fakeProber answers every probe with info or err.
+type fakeProber struct {
+	info *MediaInfo
+	err  error
+}
+
+func (p *fakeProber) Probe(ctx context.Context, videoURL string) (*MediaInfo, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	info := *p.info
+	return &info, nil
+}
+
+// fakeVideoRepo keeps created videos in memory. Methods CreateVideo does
+// not call panic through the nil embedded VideoRepo.
+type fakeVideoRepo struct {
+	VideoRepo
+	videos map[uint64]*Video
+}
+
+func (r *fakeVideoRepo) Create(ctx context.Context, video *Video, rev *VideoRevision) (*Video, error) {
+	if r.videos == nil {
+		r.videos = make(map[uint64]*Video)
+	}
+	v := *video
+	v.ID = uint64(len(r.videos) + 1)
+	r.videos[v.ID] = &v
+	return &v, nil
+}
+
+func (r *fakeVideoRepo) FindByID(ctx context.Context, id uint64) (*Video, error) {
+	v, ok := r.videos[id]
+	if !ok {
+		return nil, errors.NotFound("VIDEO_NOT_FOUND", "video not found")
+	}
+	return v, nil
+}
+
+// emptyQuotaRepo reports a user who has stored and uploaded nothing.
+type emptyQuotaRepo struct{}
+
+func (emptyQuotaRepo) GetStorageUsage(ctx context.Context, userID uint64, since time.Time) (*StorageUsage, error) {
+	return &StorageUsage{Role: "user"}, nil
+}
+
+func newTestVideoUsecase(repo VideoRepo, prober MediaProber) *VideoUsecase {
+	logger := log.NewStdLogger(io.Discard)
+	quotas := NewQuotaUsecase(emptyQuotaRepo{}, &conf.Storage{}, logger)
+	return NewVideoUsecase(repo, nil, nil, nil, nil, prober, nil, nil, quotas, nil, logger)
+}
+
+func TestProbeErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		reason string
+	}{
+		{name: "undecodable", err: fmt.Errorf("ffprobe: %w", media.ErrNotVideo), reason: "VIDEO_UNDECODABLE"},
+		{name: "not in storage", err: ErrVideoSourceInvalid, reason: "VIDEO_SOURCE_INVALID"},
+		{name: "tool failure", err: fmt.Errorf("ffprobe: exec: not found"), reason: "INTERNAL"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := newTestVideoUsecase(&fakeVideoRepo{}, &fakeProber{err: tt.err})
+			err := uc.probe(context.Background(), &Video{VideoURL: "/videos/videos/a.mp4"})
+			if got := errors.Reason(err); got != tt.reason {
+				t.Errorf("probe error = %v, want reason %s", err, tt.reason)
+			}
+		})
+	}
+}
+
+func TestCreateVideoRejectsUndecodable(t *testing.T) {
+	repo := &fakeVideoRepo{}
+	uc := newTestVideoUsecase(repo, &fakeProber{err: fmt.Errorf("ffprobe: %w", media.ErrNotVideo)})
+
+	_, err := uc.CreateVideo(context.Background(), 1, &Video{Title: "t", VideoURL: "/videos/videos/a.mp4"})
+	if got := errors.Reason(err); got != "VIDEO_UNDECODABLE" {
+		t.Fatalf("CreateVideo error = %v, want VIDEO_UNDECODABLE", err)
+	}
+	if len(repo.videos) != 0 {
+		t.Errorf("CreateVideo stored %d videos, want none", len(repo.videos))
+	}
+}
+
+func TestCreateVideoUsesProbedFacts(t *testing.T) {
+	probed := &MediaInfo{
+		Duration:   125,
+		Width:      1920,
+		Height:     1080,
+		VideoCodec: "h264",
+		AudioCodec: "aac",
+		Bitrate:    4_000_000,
+		FileSize:   62_500_000,
+	}
+	uc := newTestVideoUsecase(&fakeVideoRepo{}, &fakeProber{info: probed})
+
+	// The client's claims about the file are ignored.
+	video, err := uc.CreateVideo(context.Background(), 1, &Video{
+		Title:    "t",
+		VideoURL: "/videos/videos/a.mp4",
+		Duration: 9999,
+		FileSize: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := MediaInfo{
+		Duration:   video.Duration,
+		Width:      video.Width,
+		Height:     video.Height,
+		VideoCodec: video.VideoCodec,
+		AudioCodec: video.AudioCodec,
+		Bitrate:    video.Bitrate,
+		FileSize:   video.FileSize,
+	}
+	if got != *probed {
+		t.Errorf("created video has %+v, want the probed %+v", got, *probed)
+	}
+	if video.Status != VideoReady {
+		t.Errorf("Status = %q, want %q without transcoding", video.Status, VideoReady)
+	}
+}
@@ -0,0 +1,139 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// Fields UpdateVideo can change, named like the UpdateVideoRequest fields
+// that carry them.
+const (
+	VideoFieldTitle        = "title"
+	VideoFieldDescription  = "description"
+	VideoFieldCategoryID   = "category_id"
+	VideoFieldTagIDs       = "tag_ids"
+	VideoFieldAccessTier   = "access_tier"
+	VideoFieldThumbnailURL = "thumbnail_url"
+	VideoFieldVisibility   = "visibility"
+	VideoFieldPublishAt    = "publish_at"
+)
+
+var videoFields = []string{
+	VideoFieldTitle, VideoFieldDescription, VideoFieldCategoryID, VideoFieldTagIDs,
+	VideoFieldAccessTier, VideoFieldThumbnailURL, VideoFieldVisibility, VideoFieldPublishAt,
+}
+
+var ErrVideoVersionConflict = errors.Conflict("VIDEO_VERSION_CONFLICT", "video was changed by someone else; reload it and try again")
+
+func errVideoInvalidUpdate(format string, args ...interface{}) error {
+	return errors.BadRequest("VIDEO_INVALID_UPDATE", fmt.Sprintf(format, args...))
+}
+
+// VideoUpdate sets the Fields of a video to their values in Video, so zero
+// values clear them: an empty description or thumbnail, no tags, tier 0.
+// A nil PublishAt cancels a schedule. With Version set, the update only
+// applies to a video still at that version.
+type VideoUpdate struct {
+	Video   *Video
+	Fields  []string
+	Version uint64
+}
+
+// Has reports whether field is to be updated.
+func (u *VideoUpdate) Has(field string) bool {
+	return containsString(u.Fields, field)
+}
+
+// TagIDs returns the IDs of the new tags.
+func (u *VideoUpdate) TagIDs() []uint64 {
+	ids := make([]uint64, len(u.Video.Tags))
+	for i, t := range u.Video.Tags {
+		ids[i] = t.ID
+	}
+	return ids
+}
+
+func (u *VideoUpdate) validate() error {
+	for _, f := range u.Fields {
+		if !containsString(videoFields, f) {
+			return errVideoInvalidUpdate("unknown field %q", f)
+		}
+	}
+	v := u.Video
+	if u.Has(VideoFieldTitle) && v.Title == "" {
+		return errVideoInvalidUpdate("title cannot be empty")
+	}
+	if u.Has(VideoFieldCategoryID) && v.CategoryID == 0 {
+		return errVideoInvalidUpdate("category_id is required")
+	}
+	if u.Has(VideoFieldAccessTier) && v.AccessTier < 0 {
+		return errVideoInvalidUpdate("access_tier cannot be negative")
+	}
+	if u.Has(VideoFieldVisibility) && !validVisibility(v.Visibility) {
+		return ErrVideoInvalidVisibility
+	}
+	if u.Has(VideoFieldPublishAt) && v.PublishAt != nil {
+		if u.Has(VideoFieldVisibility) {
+			return ErrVideoInvalidSchedule
+		}
+		if err := checkSchedule(v.PublishAt, VisibilityPublished); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpdateVideo applies a partial update of the owner's video and records it
+// as a revision. A new thumbnail_url must pass UploadUsecase.CheckThumbnail.
+func (uc *VideoUsecase) UpdateVideo(ctx context.Context, userID uint64, update *VideoUpdate) (*Video, error) {
+	existing, err := uc.repo.FindByID(ctx, update.Video.ID)
+	if err != nil {
+		return nil, errors.NotFound("VIDEO_NOT_FOUND", "video not found")
+	}
+	if existing.UserID != userID {
+		return nil, errors.Forbidden("VIDEO_NOT_OWNER", "not the owner of this video")
+	}
+	if thumb := update.Video.ThumbnailURL; update.Has(VideoFieldThumbnailURL) && thumb != "" && thumb != existing.ThumbnailURL {
+		if err := uc.uploads.CheckThumbnail(ctx, userID, thumb); err != nil {
+			return nil, err
+		}
+	}
+	return uc.applyUpdate(ctx, existing, update, &VideoRevision{
+		VideoID: existing.ID,
+		ActorID: userID,
+		Action:  RevisionUpdated,
+		Fields:  update.Fields,
+	})
+}
+
+// applyUpdate updates existing, which the caller may edit, recording rev.
+func (uc *VideoUsecase) applyUpdate(ctx context.Context, existing *Video, update *VideoUpdate, rev *VideoRevision) (*Video, error) {
+	video := update.Video
+	if update.Version != 0 && update.Version != existing.Version {
+		return nil, ErrVideoVersionConflict
+	}
+	if err := update.validate(); err != nil {
+		return nil, err
+	}
+	if len(update.Fields) == 0 {
+		return existing, nil
+	}
+
+	ok, err := uc.repo.Update(ctx, update, rev)
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to update video")
+	}
+	if !ok {
+		return nil, ErrVideoVersionConflict
+	}
+	if update.Has(VideoFieldThumbnailURL) && video.ThumbnailURL != "" && video.ThumbnailURL != existing.ThumbnailURL {
+		uc.enqueueThumbnail(ctx, video.ID, video.ThumbnailURL)
+	}
+	if update.Has(VideoFieldDescription) && video.Description != existing.Description {
+		uc.chaptersFromDescription(ctx, video.ID, video.Description, existing.Duration)
+	}
+
+	return uc.repo.FindByID(ctx, video.ID)
+}
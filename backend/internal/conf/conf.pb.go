@@ -31,6 +31,7 @@ type Bootstrap struct {
 	Paddle        *Paddle                `protobuf:"bytes,5,opt,name=paddle,proto3" json:"paddle,omitempty"`
 	Nats          *NATS                  `protobuf:"bytes,6,opt,name=nats,proto3" json:"nats,omitempty"`
 	Admin         *Admin                 `protobuf:"bytes,7,opt,name=admin,proto3" json:"admin,omitempty"`
+	Media         *Media                 `protobuf:"bytes,8,opt,name=media,proto3" json:"media,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -114,6 +115,13 @@ func (x *Bootstrap) GetAdmin() *Admin {
 	return nil
 }
 
+func (x *Bootstrap) GetMedia() *Media {
+	if x != nil {
+		return x.Media
+	}
+	return nil
+}
+
 type Admin struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
@@ -331,15 +339,29 @@ func (x *Auth) GetRefreshExpiry() *durationpb.Duration {
 }
 
 type Storage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Endpoint      string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	AccessKey     string                 `protobuf:"bytes,2,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
-	SecretKey     string                 `protobuf:"bytes,3,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
-	Bucket        string                 `protobuf:"bytes,4,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	UseSsl        bool                   `protobuf:"varint,5,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
-	Region        string                 `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Endpoint  string                 `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	AccessKey string                 `protobuf:"bytes,2,opt,name=access_key,json=accessKey,proto3" json:"access_key,omitempty"`
+	SecretKey string                 `protobuf:"bytes,3,opt,name=secret_key,json=secretKey,proto3" json:"secret_key,omitempty"`
+	Bucket    string                 `protobuf:"bytes,4,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	UseSsl    bool                   `protobuf:"varint,5,opt,name=use_ssl,json=useSsl,proto3" json:"use_ssl,omitempty"`
+	Region    string                 `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	// Lifetime of signed video playback URLs (default 1h).
+	PlaybackUrlTtl *durationpb.Duration `protobuf:"bytes,7,opt,name=playback_url_ttl,json=playbackUrlTtl,proto3" json:"playback_url_ttl,omitempty"`
+	// How long unreferenced objects are kept before they are deleted
+	// (default 48h, at least the 24h resumable upload lifetime).
+	OrphanGracePeriod *durationpb.Duration `protobuf:"bytes,8,opt,name=orphan_grace_period,json=orphanGracePeriod,proto3" json:"orphan_grace_period,omitempty"`
+	// How often the bucket is reconciled against the database; disabled when
+	// unset.
+	ReconcileInterval *durationpb.Duration `protobuf:"bytes,9,opt,name=reconcile_interval,json=reconcileInterval,proto3" json:"reconcile_interval,omitempty"`
+	// Upload quotas by role, e.g. "user" or "admin". Roles not listed get
+	// the built-in default of 10GB and 20 videos a day.
+	Quotas map[string]*Quota `protobuf:"bytes,10,rep,name=quotas,proto3" json:"quotas,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// How long deleted videos can be restored before they and their files
+	// are purged (default 720h).
+	TrashRetention *durationpb.Duration `protobuf:"bytes,11,opt,name=trash_retention,json=trashRetention,proto3" json:"trash_retention,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Storage) Reset() {
@@ -414,6 +436,96 @@ func (x *Storage) GetRegion() string {
 	return ""
 }
 
+func (x *Storage) GetPlaybackUrlTtl() *durationpb.Duration {
+	if x != nil {
+		return x.PlaybackUrlTtl
+	}
+	return nil
+}
+
+func (x *Storage) GetOrphanGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.OrphanGracePeriod
+	}
+	return nil
+}
+
+func (x *Storage) GetReconcileInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ReconcileInterval
+	}
+	return nil
+}
+
+func (x *Storage) GetQuotas() map[string]*Quota {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+func (x *Storage) GetTrashRetention() *durationpb.Duration {
+	if x != nil {
+		return x.TrashRetention
+	}
+	return nil
+}
+
+type Quota struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Bytes a user may store across videos, thumbnails and renditions; 0 is
+	// unlimited.
+	StorageBytes int64 `protobuf:"varint,1,opt,name=storage_bytes,json=storageBytes,proto3" json:"storage_bytes,omitempty"`
+	// Videos a user may upload in 24 hours; 0 is unlimited.
+	DailyUploads  int32 `protobuf:"varint,2,opt,name=daily_uploads,json=dailyUploads,proto3" json:"daily_uploads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Quota) Reset() {
+	*x = Quota{}
+	mi := &file_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quota) ProtoMessage() {}
+
+func (x *Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quota.ProtoReflect.Descriptor instead.
+func (*Quota) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Quota) GetStorageBytes() int64 {
+	if x != nil {
+		return x.StorageBytes
+	}
+	return 0
+}
+
+func (x *Quota) GetDailyUploads() int32 {
+	if x != nil {
+		return x.DailyUploads
+	}
+	return 0
+}
+
 type Paddle struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	ApiKey        string                 `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
@@ -425,7 +537,7 @@ type Paddle struct {
 
 func (x *Paddle) Reset() {
 	*x = Paddle{}
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -437,7 +549,7 @@ func (x *Paddle) String() string {
 func (*Paddle) ProtoMessage() {}
 
 func (x *Paddle) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[6]
+	mi := &file_conf_conf_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -450,7 +562,7 @@ func (x *Paddle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Paddle.ProtoReflect.Descriptor instead.
 func (*Paddle) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{6}
+	return file_conf_conf_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Paddle) GetApiKey() string {
@@ -474,6 +586,114 @@ func (x *Paddle) GetSandbox() bool {
 	return false
 }
 
+type Media struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// ffprobe binary used to verify uploads; probing is disabled when empty.
+	FfprobePath  string               `protobuf:"bytes,1,opt,name=ffprobe_path,json=ffprobePath,proto3" json:"ffprobe_path,omitempty"`
+	ProbeTimeout *durationpb.Duration `protobuf:"bytes,2,opt,name=probe_timeout,json=probeTimeout,proto3" json:"probe_timeout,omitempty"`
+	// ffmpeg binary used to transcode uploads to HLS; transcoding is disabled
+	// when empty and playback stays on the original file.
+	FfmpegPath       string               `protobuf:"bytes,3,opt,name=ffmpeg_path,json=ffmpegPath,proto3" json:"ffmpeg_path,omitempty"`
+	TranscodeTimeout *durationpb.Duration `protobuf:"bytes,4,opt,name=transcode_timeout,json=transcodeTimeout,proto3" json:"transcode_timeout,omitempty"`
+	// Seconds of video per seek-preview sprite tile.
+	PreviewInterval *durationpb.Duration `protobuf:"bytes,5,opt,name=preview_interval,json=previewInterval,proto3" json:"preview_interval,omitempty"`
+	// Index caption text for search, so videos are found by what is said in
+	// them.
+	IndexCaptions bool `protobuf:"varint,6,opt,name=index_captions,json=indexCaptions,proto3" json:"index_captions,omitempty"`
+	// clamd to scan uploads with, as host:port or a Unix socket path;
+	// scanning is disabled when empty.
+	ClamdAddress  string               `protobuf:"bytes,7,opt,name=clamd_address,json=clamdAddress,proto3" json:"clamd_address,omitempty"`
+	ScanTimeout   *durationpb.Duration `protobuf:"bytes,8,opt,name=scan_timeout,json=scanTimeout,proto3" json:"scan_timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Media) Reset() {
+	*x = Media{}
+	mi := &file_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Media) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Media) ProtoMessage() {}
+
+func (x *Media) ProtoReflect() protoreflect.Message {
+	mi := &file_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Media.ProtoReflect.Descriptor instead.
+func (*Media) Descriptor() ([]byte, []int) {
+	return file_conf_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Media) GetFfprobePath() string {
+	if x != nil {
+		return x.FfprobePath
+	}
+	return ""
+}
+
+func (x *Media) GetProbeTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ProbeTimeout
+	}
+	return nil
+}
+
+func (x *Media) GetFfmpegPath() string {
+	if x != nil {
+		return x.FfmpegPath
+	}
+	return ""
+}
+
+func (x *Media) GetTranscodeTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.TranscodeTimeout
+	}
+	return nil
+}
+
+func (x *Media) GetPreviewInterval() *durationpb.Duration {
+	if x != nil {
+		return x.PreviewInterval
+	}
+	return nil
+}
+
+func (x *Media) GetIndexCaptions() bool {
+	if x != nil {
+		return x.IndexCaptions
+	}
+	return false
+}
+
+func (x *Media) GetClamdAddress() string {
+	if x != nil {
+		return x.ClamdAddress
+	}
+	return ""
+}
+
+func (x *Media) GetScanTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ScanTimeout
+	}
+	return nil
+}
+
 type NATS struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Url           string                 `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
@@ -483,7 +703,7 @@ type NATS struct {
 
 func (x *NATS) Reset() {
 	*x = NATS{}
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -495,7 +715,7 @@ func (x *NATS) String() string {
 func (*NATS) ProtoMessage() {}
 
 func (x *NATS) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[7]
+	mi := &file_conf_conf_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -508,7 +728,7 @@ func (x *NATS) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NATS.ProtoReflect.Descriptor instead.
 func (*NATS) Descriptor() ([]byte, []int) {
-	return file_conf_conf_proto_rawDescGZIP(), []int{7}
+	return file_conf_conf_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *NATS) GetUrl() string {
@@ -529,7 +749,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -541,7 +761,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[8]
+	mi := &file_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,7 +809,7 @@ type Server_GRPC struct {
 
 func (x *Server_GRPC) Reset() {
 	*x = Server_GRPC{}
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -601,7 +821,7 @@ func (x *Server_GRPC) String() string {
 func (*Server_GRPC) ProtoMessage() {}
 
 func (x *Server_GRPC) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[9]
+	mi := &file_conf_conf_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -651,7 +871,7 @@ type Data_Database struct {
 
 func (x *Data_Database) Reset() {
 	*x = Data_Database{}
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -663,7 +883,7 @@ func (x *Data_Database) String() string {
 func (*Data_Database) ProtoMessage() {}
 
 func (x *Data_Database) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[10]
+	mi := &file_conf_conf_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +948,7 @@ type Data_Redis struct {
 
 func (x *Data_Redis) Reset() {
 	*x = Data_Redis{}
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -740,7 +960,7 @@ func (x *Data_Redis) String() string {
 func (*Data_Redis) ProtoMessage() {}
 
 func (x *Data_Redis) ProtoReflect() protoreflect.Message {
-	mi := &file_conf_conf_proto_msgTypes[11]
+	mi := &file_conf_conf_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -803,7 +1023,7 @@ var File_conf_conf_proto protoreflect.FileDescriptor
 const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"\x0fconf/conf.proto\x12\n" +
-	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xad\x02\n" +
+	"kratos.api\x1a\x1egoogle/protobuf/duration.proto\"\xd6\x02\n" +
 	"\tBootstrap\x12*\n" +
 	"\x06server\x18\x01 \x01(\v2\x12.kratos.api.ServerR\x06server\x12$\n" +
 	"\x04data\x18\x02 \x01(\v2\x10.kratos.api.DataR\x04data\x12$\n" +
@@ -811,7 +1031,8 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\astorage\x18\x04 \x01(\v2\x13.kratos.api.StorageR\astorage\x12*\n" +
 	"\x06paddle\x18\x05 \x01(\v2\x12.kratos.api.PaddleR\x06paddle\x12$\n" +
 	"\x04nats\x18\x06 \x01(\v2\x10.kratos.api.NATSR\x04nats\x12'\n" +
-	"\x05admin\x18\a \x01(\v2\x11.kratos.api.AdminR\x05admin\"?\n" +
+	"\x05admin\x18\a \x01(\v2\x11.kratos.api.AdminR\x05admin\x12'\n" +
+	"\x05media\x18\b \x01(\v2\x11.kratos.api.MediaR\x05media\"?\n" +
 	"\x05Admin\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xb8\x02\n" +
@@ -846,7 +1067,7 @@ const file_conf_conf_proto_rawDesc = "" +
 	"\n" +
 	"jwt_secret\x18\x01 \x01(\tR\tjwtSecret\x12<\n" +
 	"\ftoken_expiry\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vtokenExpiry\x12@\n" +
-	"\x0erefresh_expiry\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rrefreshExpiry\"\xac\x01\n" +
+	"\x0erefresh_expiry\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\rrefreshExpiry\"\xd1\x04\n" +
 	"\aStorage\x12\x1a\n" +
 	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x1d\n" +
 	"\n" +
@@ -855,11 +1076,33 @@ const file_conf_conf_proto_rawDesc = "" +
 	"secret_key\x18\x03 \x01(\tR\tsecretKey\x12\x16\n" +
 	"\x06bucket\x18\x04 \x01(\tR\x06bucket\x12\x17\n" +
 	"\ause_ssl\x18\x05 \x01(\bR\x06useSsl\x12\x16\n" +
-	"\x06region\x18\x06 \x01(\tR\x06region\"b\n" +
+	"\x06region\x18\x06 \x01(\tR\x06region\x12C\n" +
+	"\x10playback_url_ttl\x18\a \x01(\v2\x19.google.protobuf.DurationR\x0eplaybackUrlTtl\x12I\n" +
+	"\x13orphan_grace_period\x18\b \x01(\v2\x19.google.protobuf.DurationR\x11orphanGracePeriod\x12H\n" +
+	"\x12reconcile_interval\x18\t \x01(\v2\x19.google.protobuf.DurationR\x11reconcileInterval\x127\n" +
+	"\x06quotas\x18\n" +
+	" \x03(\v2\x1f.kratos.api.Storage.QuotasEntryR\x06quotas\x12B\n" +
+	"\x0ftrash_retention\x18\v \x01(\v2\x19.google.protobuf.DurationR\x0etrashRetention\x1aL\n" +
+	"\vQuotasEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12'\n" +
+	"\x05value\x18\x02 \x01(\v2\x11.kratos.api.QuotaR\x05value:\x028\x01\"Q\n" +
+	"\x05Quota\x12#\n" +
+	"\rstorage_bytes\x18\x01 \x01(\x03R\fstorageBytes\x12#\n" +
+	"\rdaily_uploads\x18\x02 \x01(\x05R\fdailyUploads\"b\n" +
 	"\x06Paddle\x12\x17\n" +
 	"\aapi_key\x18\x01 \x01(\tR\x06apiKey\x12%\n" +
 	"\x0ewebhook_secret\x18\x02 \x01(\tR\rwebhookSecret\x12\x18\n" +
-	"\asandbox\x18\x03 \x01(\bR\asandbox\"\x18\n" +
+	"\asandbox\x18\x03 \x01(\bR\asandbox\"\xa3\x03\n" +
+	"\x05Media\x12!\n" +
+	"\fffprobe_path\x18\x01 \x01(\tR\vffprobePath\x12>\n" +
+	"\rprobe_timeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\fprobeTimeout\x12\x1f\n" +
+	"\vffmpeg_path\x18\x03 \x01(\tR\n" +
+	"ffmpegPath\x12F\n" +
+	"\x11transcode_timeout\x18\x04 \x01(\v2\x19.google.protobuf.DurationR\x10transcodeTimeout\x12D\n" +
+	"\x10preview_interval\x18\x05 \x01(\v2\x19.google.protobuf.DurationR\x0fpreviewInterval\x12%\n" +
+	"\x0eindex_captions\x18\x06 \x01(\bR\rindexCaptions\x12#\n" +
+	"\rclamd_address\x18\a \x01(\tR\fclamdAddress\x12<\n" +
+	"\fscan_timeout\x18\b \x01(\v2\x19.google.protobuf.DurationR\vscanTimeout\"\x18\n" +
 	"\x04NATS\x12\x10\n" +
 	"\x03url\x18\x01 \x01(\tR\x03urlB\x1cZ\x1abackend/internal/conf;confb\x06proto3"
 
@@ -875,7 +1118,7 @@ func file_conf_conf_proto_rawDescGZIP() []byte {
 	return file_conf_conf_proto_rawDescData
 }
 
-var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
+var file_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
 var file_conf_conf_proto_goTypes = []any{
 	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
 	(*Admin)(nil),               // 1: kratos.api.Admin
@@ -883,38 +1126,52 @@ var file_conf_conf_proto_goTypes = []any{
 	(*Data)(nil),                // 3: kratos.api.Data
 	(*Auth)(nil),                // 4: kratos.api.Auth
 	(*Storage)(nil),             // 5: kratos.api.Storage
-	(*Paddle)(nil),              // 6: kratos.api.Paddle
-	(*NATS)(nil),                // 7: kratos.api.NATS
-	(*Server_HTTP)(nil),         // 8: kratos.api.Server.HTTP
-	(*Server_GRPC)(nil),         // 9: kratos.api.Server.GRPC
-	(*Data_Database)(nil),       // 10: kratos.api.Data.Database
-	(*Data_Redis)(nil),          // 11: kratos.api.Data.Redis
-	(*durationpb.Duration)(nil), // 12: google.protobuf.Duration
+	(*Quota)(nil),               // 6: kratos.api.Quota
+	(*Paddle)(nil),              // 7: kratos.api.Paddle
+	(*Media)(nil),               // 8: kratos.api.Media
+	(*NATS)(nil),                // 9: kratos.api.NATS
+	(*Server_HTTP)(nil),         // 10: kratos.api.Server.HTTP
+	(*Server_GRPC)(nil),         // 11: kratos.api.Server.GRPC
+	(*Data_Database)(nil),       // 12: kratos.api.Data.Database
+	(*Data_Redis)(nil),          // 13: kratos.api.Data.Redis
+	nil,                         // 14: kratos.api.Storage.QuotasEntry
+	(*durationpb.Duration)(nil), // 15: google.protobuf.Duration
 }
 var file_conf_conf_proto_depIdxs = []int32{
 	2,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
 	3,  // 1: kratos.api.Bootstrap.data:type_name -> kratos.api.Data
 	4,  // 2: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
 	5,  // 3: kratos.api.Bootstrap.storage:type_name -> kratos.api.Storage
-	6,  // 4: kratos.api.Bootstrap.paddle:type_name -> kratos.api.Paddle
-	7,  // 5: kratos.api.Bootstrap.nats:type_name -> kratos.api.NATS
+	7,  // 4: kratos.api.Bootstrap.paddle:type_name -> kratos.api.Paddle
+	9,  // 5: kratos.api.Bootstrap.nats:type_name -> kratos.api.NATS
 	1,  // 6: kratos.api.Bootstrap.admin:type_name -> kratos.api.Admin
-	8,  // 7: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	9,  // 8: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
-	10, // 9: kratos.api.Data.database:type_name -> kratos.api.Data.Database
-	11, // 10: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
-	12, // 11: kratos.api.Auth.token_expiry:type_name -> google.protobuf.Duration
-	12, // 12: kratos.api.Auth.refresh_expiry:type_name -> google.protobuf.Duration
-	12, // 13: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	12, // 14: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
-	12, // 15: kratos.api.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
-	12, // 16: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
-	12, // 17: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	8,  // 7: kratos.api.Bootstrap.media:type_name -> kratos.api.Media
+	10, // 8: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	11, // 9: kratos.api.Server.grpc:type_name -> kratos.api.Server.GRPC
+	12, // 10: kratos.api.Data.database:type_name -> kratos.api.Data.Database
+	13, // 11: kratos.api.Data.redis:type_name -> kratos.api.Data.Redis
+	15, // 12: kratos.api.Auth.token_expiry:type_name -> google.protobuf.Duration
+	15, // 13: kratos.api.Auth.refresh_expiry:type_name -> google.protobuf.Duration
+	15, // 14: kratos.api.Storage.playback_url_ttl:type_name -> google.protobuf.Duration
+	15, // 15: kratos.api.Storage.orphan_grace_period:type_name -> google.protobuf.Duration
+	15, // 16: kratos.api.Storage.reconcile_interval:type_name -> google.protobuf.Duration
+	14, // 17: kratos.api.Storage.quotas:type_name -> kratos.api.Storage.QuotasEntry
+	15, // 18: kratos.api.Storage.trash_retention:type_name -> google.protobuf.Duration
+	15, // 19: kratos.api.Media.probe_timeout:type_name -> google.protobuf.Duration
+	15, // 20: kratos.api.Media.transcode_timeout:type_name -> google.protobuf.Duration
+	15, // 21: kratos.api.Media.preview_interval:type_name -> google.protobuf.Duration
+	15, // 22: kratos.api.Media.scan_timeout:type_name -> google.protobuf.Duration
+	15, // 23: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	15, // 24: kratos.api.Server.GRPC.timeout:type_name -> google.protobuf.Duration
+	15, // 25: kratos.api.Data.Database.conn_max_lifetime:type_name -> google.protobuf.Duration
+	15, // 26: kratos.api.Data.Redis.read_timeout:type_name -> google.protobuf.Duration
+	15, // 27: kratos.api.Data.Redis.write_timeout:type_name -> google.protobuf.Duration
+	6,  // 28: kratos.api.Storage.QuotasEntry.value:type_name -> kratos.api.Quota
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_conf_conf_proto_init() }
@@ -928,7 +1185,7 @@ func file_conf_conf_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_conf_conf_proto_rawDesc), len(file_conf_conf_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   12,
+			NumMessages:   15,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -2,36 +2,44 @@ package data
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"backend/internal/biz"
 	"backend/internal/data/model"
+	"backend/internal/pkg/pagination"
+	"backend/internal/pkg/upload"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
 )
 
 type adminRepo struct {
-	data *Data
-	log  *log.Helper
+	data     *Data
+	uploader *upload.MinIOUploader
+	log      *log.Helper
 }
 
-func NewAdminRepo(data *Data, logger log.Logger) biz.AdminRepo {
+func NewAdminRepo(data *Data, uploader *upload.MinIOUploader, logger log.Logger) biz.AdminRepo {
 	return &adminRepo{
-		data: data,
-		log:  log.NewHelper(logger),
+		data:     data,
+		uploader: uploader,
+		log:      log.NewHelper(logger),
 	}
 }
 
-func (r *adminRepo) ListUsers(ctx context.Context, offset, limit int) ([]*biz.AdminUser, int64, error) {
+func (r *adminRepo) ListUsers(ctx context.Context, page pagination.Request) ([]*biz.AdminUser, int64, error) {
 	var users []model.User
 	var total int64
 
 	db := r.data.DB.WithContext(ctx).Model(&model.User{})
-	if err := db.Count(&total).Error; err != nil {
+	if page.Keyset() {
+		db = db.Where("id < ?", page.After.ID)
+	} else if err := db.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
-	if err := db.Offset(offset).Limit(limit).Order("id DESC").Find(&users).Error; err != nil {
+	if err := db.Offset(page.Offset).Limit(page.Limit).Order("id DESC").Find(&users).Error; err != nil {
 		return nil, 0, err
 	}
 
@@ -69,7 +77,9 @@ func (r *adminRepo) DeleteUser(ctx context.Context, id uint64) error {
 		if err := tx.Where("from_user_id = ? OR to_user_id = ?", id, id).Delete(&model.Donation{}).Error; err != nil {
 			return err
 		}
-		// Delete user's videos
+		// Move the user's videos to the trash, which cannot restore them
+		// without their owner and purges them with their files once the
+		// retention period is over.
 		if err := tx.Where("user_id = ?", id).Delete(&model.Video{}).Error; err != nil {
 			return err
 		}
@@ -85,17 +95,19 @@ func (r *adminRepo) DeleteUser(ctx context.Context, id uint64) error {
 	})
 }
 
-func (r *adminRepo) ListAllVideos(ctx context.Context, offset, limit int) ([]*biz.AdminVideo, int64, error) {
+func (r *adminRepo) ListAllVideos(ctx context.Context, page pagination.Request) ([]*biz.AdminVideo, int64, error) {
 	var videos []model.Video
 	var total int64
 
 	db := r.data.DB.WithContext(ctx).Model(&model.Video{}).Unscoped()
-	if err := db.Count(&total).Error; err != nil {
+	if page.Keyset() {
+		db = db.Where("id < ?", page.After.ID)
+	} else if err := db.Count(&total).Error; err != nil {
 		return nil, 0, err
 	}
 
 	if err := db.Preload("User").Preload("Category").
-		Offset(offset).Limit(limit).Order("id DESC").
+		Offset(page.Offset).Limit(page.Limit).Order("id DESC").
 		Find(&videos).Error; err != nil {
 		return nil, 0, err
 	}
@@ -108,25 +120,18 @@ func (r *adminRepo) ListAllVideos(ctx context.Context, offset, limit int) ([]*bi
 }
 
 func (r *adminRepo) DeleteVideo(ctx context.Context, id uint64) error {
-	return r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		// Remove video_tags associations
-		if err := tx.Exec("DELETE FROM video_tags WHERE video_id = ?", id).Error; err != nil {
-			return err
-		}
-		// Delete view records
-		if err := tx.Where("video_id = ?", id).Delete(&model.ViewRecord{}).Error; err != nil {
-			return err
-		}
-		// Delete donations for this video
-		if err := tx.Where("video_id = ?", id).Delete(&model.Donation{}).Error; err != nil {
-			return err
-		}
-		// Hard delete the video (Unscoped bypasses soft delete)
-		if err := tx.Unscoped().Delete(&model.Video{}, id).Error; err != nil {
-			return err
-		}
-		return nil
+	var video model.Video
+	if err := r.data.DB.WithContext(ctx).Unscoped().First(&video, id).Error; err != nil {
+		return err
+	}
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return deleteVideoRecords(tx, id)
 	})
+	if err != nil {
+		return err
+	}
+	purgeVideoMedia(r.data, r.uploader, r.log, []model.Video{video})
+	return nil
 }
 
 func (r *adminRepo) CreateTag(ctx context.Context, tag *biz.AdminTag) (*biz.AdminTag, error) {
@@ -185,6 +190,219 @@ func (r *adminRepo) FindTagByName(ctx context.Context, name string) (*biz.AdminT
 	return &biz.AdminTag{ID: tag.ID, Name: tag.Name, Slug: tag.Slug}, nil
 }
 
+// searchStatsQuery sums search_query_dailies per query from since onwards.
+func (r *adminRepo) searchStatsQuery(ctx context.Context, since time.Time) *gorm.DB {
+	return r.data.DB.WithContext(ctx).
+		Model(&model.SearchQueryDaily{}).
+		Select("query, SUM(searches) AS searches, SUM(zero_results) AS zero_results, "+
+			"SUM(clicked_searches) AS clicked_searches, SUM(total_latency_ms) AS total_latency_ms").
+		Where("day >= ?", since).
+		Group("query")
+}
+
+func (r *adminRepo) TopSearchQueries(ctx context.Context, since time.Time, limit int) ([]*biz.AdminSearchQueryStat, error) {
+	var stats []*biz.AdminSearchQueryStat
+	err := r.searchStatsQuery(ctx, since).
+		Order("searches DESC").
+		Limit(limit).
+		Scan(&stats).Error
+	return stats, err
+}
+
+func (r *adminRepo) TopZeroResultQueries(ctx context.Context, since time.Time, limit int) ([]*biz.AdminSearchQueryStat, error) {
+	var stats []*biz.AdminSearchQueryStat
+	err := r.searchStatsQuery(ctx, since).
+		Having("SUM(zero_results) > 0").
+		Order("zero_results DESC").
+		Limit(limit).
+		Scan(&stats).Error
+	return stats, err
+}
+
+func (r *adminRepo) SearchQueryCTR(ctx context.Context, since time.Time, query string, limit int) ([]*biz.AdminSearchQueryStat, error) {
+	var stats []*biz.AdminSearchQueryStat
+	q := r.searchStatsQuery(ctx, since)
+	if query != "" {
+		q = q.Where("query = ?", query)
+	}
+	err := q.Order("searches DESC").
+		Limit(limit).
+		Scan(&stats).Error
+	return stats, err
+}
+
+func (r *adminRepo) ListSynonymGroups(ctx context.Context, offset, limit int) ([]*biz.AdminSynonymGroup, int64, error) {
+	var groups []model.SearchSynonymGroup
+	var total int64
+
+	db := r.data.DB.WithContext(ctx).Model(&model.SearchSynonymGroup{})
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := db.Preload("Terms").Offset(offset).Limit(limit).Order("id DESC").Find(&groups).Error; err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]*biz.AdminSynonymGroup, len(groups))
+	for i := range groups {
+		result[i] = toBizAdminSynonymGroup(&groups[i])
+	}
+	return result, total, nil
+}
+
+func (r *adminRepo) FindSynonymGroupByID(ctx context.Context, id uint64) (*biz.AdminSynonymGroup, error) {
+	var group model.SearchSynonymGroup
+	if err := r.data.DB.WithContext(ctx).Preload("Terms").First(&group, id).Error; err != nil {
+		return nil, err
+	}
+	return toBizAdminSynonymGroup(&group), nil
+}
+
+func (r *adminRepo) FindSynonymGroupIDsByTerms(ctx context.Context, terms []string) (map[string]uint64, error) {
+	var rows []model.SearchSynonym
+	if err := r.data.DB.WithContext(ctx).Where("term IN ?", terms).Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	result := make(map[string]uint64, len(rows))
+	for _, row := range rows {
+		result[row.Term] = row.GroupID
+	}
+	return result, nil
+}
+
+func (r *adminRepo) CreateSynonymGroup(ctx context.Context, group *biz.AdminSynonymGroup) (*biz.AdminSynonymGroup, error) {
+	m := &model.SearchSynonymGroup{}
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(m).Error; err != nil {
+			return err
+		}
+		return createSynonymTerms(tx, m.ID, group.Terms)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.FindSynonymGroupByID(ctx, m.ID)
+}
+
+func (r *adminRepo) UpdateSynonymGroup(ctx context.Context, group *biz.AdminSynonymGroup) (*biz.AdminSynonymGroup, error) {
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Replace the whole term set
+		if err := tx.Where("group_id = ?", group.ID).Delete(&model.SearchSynonym{}).Error; err != nil {
+			return err
+		}
+		if err := createSynonymTerms(tx, group.ID, group.Terms); err != nil {
+			return err
+		}
+		return tx.Model(&model.SearchSynonymGroup{}).Where("id = ?", group.ID).Update("updated_at", time.Now()).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+	return r.FindSynonymGroupByID(ctx, group.ID)
+}
+
+func (r *adminRepo) DeleteSynonymGroup(ctx context.Context, id uint64) error {
+	return r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("group_id = ?", id).Delete(&model.SearchSynonym{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&model.SearchSynonymGroup{}, id).Error
+	})
+}
+
+// createSynonymTerms fails with biz.ErrSynonymTermExists if a term was
+// added to another group since it was checked.
+func createSynonymTerms(tx *gorm.DB, groupID uint64, terms []string) error {
+	rows := make([]model.SearchSynonym, len(terms))
+	for i, t := range terms {
+		rows[i] = model.SearchSynonym{GroupID: groupID, Term: t}
+	}
+	err := tx.Create(&rows).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return biz.ErrSynonymTermExists
+	}
+	return err
+}
+
+func (r *adminRepo) ListSpellingCorrections(ctx context.Context, offset, limit int) ([]*biz.AdminSpellingCorrection, int64, error) {
+	var rows []model.SearchSpellingCorrection
+	var total int64
+
+	db := r.data.DB.WithContext(ctx).Model(&model.SearchSpellingCorrection{})
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	if err := db.Offset(offset).Limit(limit).Order("misspelling ASC").Find(&rows).Error; err != nil {
+		return nil, 0, err
+	}
+
+	result := make([]*biz.AdminSpellingCorrection, len(rows))
+	for i := range rows {
+		result[i] = toBizAdminSpellingCorrection(&rows[i])
+	}
+	return result, total, nil
+}
+
+func (r *adminRepo) FindSpellingCorrectionByID(ctx context.Context, id uint64) (*biz.AdminSpellingCorrection, error) {
+	var row model.SearchSpellingCorrection
+	if err := r.data.DB.WithContext(ctx).First(&row, id).Error; err != nil {
+		return nil, err
+	}
+	return toBizAdminSpellingCorrection(&row), nil
+}
+
+func (r *adminRepo) FindSpellingCorrectionByMisspelling(ctx context.Context, misspelling string) (*biz.AdminSpellingCorrection, error) {
+	var row model.SearchSpellingCorrection
+	if err := r.data.DB.WithContext(ctx).Where("misspelling = ?", misspelling).First(&row).Error; err != nil {
+		return nil, err
+	}
+	return toBizAdminSpellingCorrection(&row), nil
+}
+
+func (r *adminRepo) CreateSpellingCorrection(ctx context.Context, c *biz.AdminSpellingCorrection) (*biz.AdminSpellingCorrection, error) {
+	m := &model.SearchSpellingCorrection{
+		Misspelling: c.Misspelling,
+		Correction:  c.Correction,
+	}
+	if err := r.data.DB.WithContext(ctx).Create(m).Error; err != nil {
+		return nil, err
+	}
+	return toBizAdminSpellingCorrection(m), nil
+}
+
+func (r *adminRepo) UpdateSpellingCorrection(ctx context.Context, c *biz.AdminSpellingCorrection) (*biz.AdminSpellingCorrection, error) {
+	if err := r.data.DB.WithContext(ctx).Model(&model.SearchSpellingCorrection{}).Where("id = ?", c.ID).
+		Updates(map[string]interface{}{
+			"misspelling": c.Misspelling,
+			"correction":  c.Correction,
+		}).Error; err != nil {
+		return nil, err
+	}
+	return r.FindSpellingCorrectionByID(ctx, c.ID)
+}
+
+func (r *adminRepo) DeleteSpellingCorrection(ctx context.Context, id uint64) error {
+	return r.data.DB.WithContext(ctx).Delete(&model.SearchSpellingCorrection{}, id).Error
+}
+
+func toBizAdminSynonymGroup(m *model.SearchSynonymGroup) *biz.AdminSynonymGroup {
+	terms := make([]string, len(m.Terms))
+	for i, t := range m.Terms {
+		terms[i] = t.Term
+	}
+	return &biz.AdminSynonymGroup{ID: m.ID, Terms: terms}
+}
+
+func toBizAdminSpellingCorrection(m *model.SearchSpellingCorrection) *biz.AdminSpellingCorrection {
+	return &biz.AdminSpellingCorrection{
+		ID:          m.ID,
+		Misspelling: m.Misspelling,
+		Correction:  m.Correction,
+	}
+}
+
 func toBizAdminUser(m *model.User) *biz.AdminUser {
 	return &biz.AdminUser{
 		ID:          m.ID,
@@ -202,7 +420,8 @@ func toBizAdminVideo(m *model.Video) *biz.AdminVideo {
 		Title:          m.Title,
 		UserID:         m.UserID,
 		AccessTier:     m.AccessTier,
-		IsPublished:    m.IsPublished,
+		Status:         m.Status,
+		Visibility:     m.Visibility,
 		IsHidden:       m.IsHidden,
 		ViewsMember:    m.ViewsMember,
 		ViewsNonMember: m.ViewsNonMember,
@@ -216,3 +435,79 @@ func toBizAdminVideo(m *model.Video) *biz.AdminVideo {
 	}
 	return v
 }
+
+func (r *adminRepo) ListDuplicateContent(ctx context.Context, offset, limit int) ([]*biz.AdminDuplicateGroup, int64, error) {
+	db := r.data.DB.WithContext(ctx)
+	shared := db.Model(&model.Upload{}).
+		Select("sha256, MAX(size) AS size, MAX(created_at) AS last_upload").
+		Where("sha256 <> ''").
+		Group("sha256").
+		Having("COUNT(DISTINCT user_id) > 1")
+
+	var total int64
+	if err := db.Table("(?) AS shared", shared).Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rows []struct {
+		SHA256 string `gorm:"column:sha256"`
+		Size   int64
+	}
+	if err := shared.Session(&gorm.Session{}).Order("last_upload DESC").Offset(offset).Limit(limit).Scan(&rows).Error; err != nil {
+		return nil, 0, err
+	}
+	if len(rows) == 0 {
+		return []*biz.AdminDuplicateGroup{}, total, nil
+	}
+
+	groups := make([]*biz.AdminDuplicateGroup, len(rows))
+	byHash := make(map[string]*biz.AdminDuplicateGroup, len(rows))
+	hashes := make([]string, len(rows))
+	for i, row := range rows {
+		groups[i] = &biz.AdminDuplicateGroup{SHA256: row.SHA256, Size: row.Size}
+		byHash[row.SHA256] = groups[i]
+		hashes[i] = row.SHA256
+	}
+
+	var uploads []struct {
+		model.Upload
+		Username string
+	}
+	if err := db.Model(&model.Upload{}).
+		Select("uploads.*, users.username").
+		Joins("LEFT JOIN users ON users.id = uploads.user_id").
+		Where("uploads.sha256 IN ?", hashes).
+		Order("uploads.id").
+		Scan(&uploads).Error; err != nil {
+		return nil, 0, err
+	}
+
+	urls := make([]string, len(uploads))
+	for i := range uploads {
+		urls[i] = r.uploader.GetURL(uploads[i].ObjectName)
+	}
+	var videos []model.Video
+	if err := db.Unscoped().Select("id", "video_url").Where("video_url IN ?", urls).Order("id").Find(&videos).Error; err != nil {
+		return nil, 0, err
+	}
+	videoIDs := make(map[string][]uint64)
+	for _, v := range videos {
+		videoIDs[v.VideoURL] = append(videoIDs[v.VideoURL], v.ID)
+	}
+
+	for i := range uploads {
+		u := &uploads[i]
+		g := byHash[u.SHA256]
+		g.Uploads = append(g.Uploads, &biz.AdminDuplicateUpload{
+			UploadID:    u.ID,
+			UserID:      u.UserID,
+			Username:    u.Username,
+			ObjectName:  u.ObjectName,
+			Status:      u.Status,
+			DuplicateOf: u.DuplicateOf,
+			VideoIDs:    videoIDs[urls[i]],
+			CreatedAt:   u.CreatedAt,
+		})
+	}
+	return groups, total, nil
+}
@@ -46,13 +46,13 @@ func (d *Data) WarmUpCache(ctx context.Context, logger log.Logger) {
 	for _, tag := range tags {
 		tagKey := fmt.Sprintf("%s%d", cacheTagKeyPrefix, tag.ID)
 
-		// 2. Query public, published, non-hidden videos for this tag
+		// 2. Query public, listed (ready, published, non-hidden) videos for this tag
 		var videoIDs []uint64
 		err := d.DB.Table("video_tags").
 			Select("video_tags.video_id").
 			Joins("INNER JOIN videos ON videos.id = video_tags.video_id").
 			Where("video_tags.tag_id = ?", tag.ID).
-			Where("videos.is_published = ? AND videos.is_hidden = ? AND videos.deleted_at IS NULL", true, false).
+			Scopes(listedVideos).
 			Where("videos.access_tier = 0").
 			Pluck("video_id", &videoIDs).Error
 		if err != nil {
@@ -93,14 +93,17 @@ func (d *Data) WarmUpCache(ctx context.Context, logger log.Logger) {
 			}
 
 			d.Redis.HSet(ctx, videoKey, map[string]interface{}{
-				"id":          video.ID,
-				"title":       video.Title,
-				"duration":    video.Duration,
-				"views":       video.ViewsMember + video.ViewsNonMember,
-				"thumbnail":   thumbnail,
-				"category_id": video.CategoryID,
-				"user_id":     video.UserID,
-				"video_url":   video.VideoURL,
+				"id":               video.ID,
+				"title":            video.Title,
+				"duration":         video.Duration,
+				"views":            video.ViewsMember + video.ViewsNonMember,
+				"thumbnail":        thumbnail,
+				"thumbnail_small":  derefString(video.ThumbnailSmallURL),
+				"thumbnail_medium": derefString(video.ThumbnailMediumURL),
+				"thumbnail_large":  derefString(video.ThumbnailLargeURL),
+				"category_id":      video.CategoryID,
+				"user_id":          video.UserID,
+				"video_url":        video.VideoURL,
 			})
 			d.Redis.Expire(ctx, videoKey, cacheVideoTTL)
 			videosCached++
@@ -0,0 +1,113 @@
+package data
+
+import (
+	"bytes"
+	"context"
+
+	"backend/internal/biz"
+	"backend/internal/conf"
+	"backend/internal/data/model"
+	"backend/internal/pkg/upload"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+)
+
+type captionRepo struct {
+	data     *Data
+	uploader *upload.MinIOUploader
+	index    bool
+	log      *log.Helper
+}
+
+func NewCaptionRepo(data *Data, uploader *upload.MinIOUploader, c *conf.Media, logger log.Logger) biz.CaptionRepo {
+	return &captionRepo{
+		data:     data,
+		uploader: uploader,
+		index:    c != nil && c.IndexCaptions,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// captionObject is where a video's track in a language is stored.
+func captionObject(videoID uint64, language string) string {
+	return captionDir(videoID) + language + ".vtt"
+}
+
+// Save overwrites the stored file in place, then upserts the row on
+// (video_id, language). The text is only kept when captions are indexed.
+func (r *captionRepo) Save(ctx context.Context, c *biz.Caption, vtt []byte) (*biz.Caption, error) {
+	object := captionObject(c.VideoID, c.Language)
+	if err := r.uploader.Put(ctx, object, bytes.NewReader(vtt), int64(len(vtt)), "text/vtt"); err != nil {
+		return nil, err
+	}
+
+	m := model.VideoCaption{
+		VideoID:  c.VideoID,
+		Language: c.Language,
+		Label:    c.Label,
+		URL:      r.uploader.GetURL(object),
+	}
+	if r.index {
+		m.Text = c.Text
+	}
+	if err := r.data.DB.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "video_id"}, {Name: "language"}},
+		DoUpdates: clause.AssignmentColumns([]string{"label", "url", "text", "updated_at"}),
+	}).Create(&m).Error; err != nil {
+		return nil, err
+	}
+
+	// On conflict MySQL does not report the existing row's ID.
+	if err := r.data.DB.WithContext(ctx).
+		Where("video_id = ? AND language = ?", c.VideoID, c.Language).
+		First(&m).Error; err != nil {
+		return nil, err
+	}
+	return toBizCaption(&m), nil
+}
+
+func (r *captionRepo) List(ctx context.Context, videoID uint64) ([]*biz.Caption, error) {
+	var rows []model.VideoCaption
+	if err := r.data.DB.WithContext(ctx).
+		Omit("text").
+		Where("video_id = ?", videoID).
+		Order("language").
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	captions := make([]*biz.Caption, len(rows))
+	for i := range rows {
+		captions[i] = toBizCaption(&rows[i])
+	}
+	return captions, nil
+}
+
+func (r *captionRepo) Delete(ctx context.Context, videoID uint64, language string) error {
+	res := r.data.DB.WithContext(ctx).
+		Where("video_id = ? AND language = ?", videoID, language).
+		Delete(&model.VideoCaption{})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return biz.ErrCaptionNotFound
+	}
+	// The row is gone, so a leftover object is unreachable; just log.
+	if err := r.uploader.Delete(ctx, captionObject(videoID, language)); err != nil {
+		r.log.Warnf("delete caption object of video %d (%s): %v", videoID, language, err)
+	}
+	return nil
+}
+
+func toBizCaption(m *model.VideoCaption) *biz.Caption {
+	return &biz.Caption{
+		ID:        m.ID,
+		VideoID:   m.VideoID,
+		Language:  m.Language,
+		Label:     m.Label,
+		URL:       m.URL,
+		Text:      m.Text,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
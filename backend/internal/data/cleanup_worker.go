@@ -20,9 +20,10 @@ const (
 // StartBackgroundWorkers launches cache maintenance goroutines.
 // Called from NewData after all resources are initialized.
 //
-// Two workers run continuously:
+// Three workers run continuously:
 //  1. View flush ticker — every 30s, drains views:buffer → batch UPDATE MySQL
 //  2. Cleanup worker  — retries failed cache evictions from cleanup:queue
+//  3. Search log flush — every 30s, drains search:log → search_logs + daily rollup
 //
 // Why Redis LIST for cleanup queue (not Go channel): survives app restarts.
 // Why background goroutine for views (not synchronous): decouples write latency
@@ -65,7 +66,22 @@ func StartBackgroundWorkers(ctx context.Context, d *Data, logger log.Logger) {
 		}
 	}()
 
-	l.Info("background workers started (view flush, cleanup)")
+	// Worker 3: persist search analytics events
+	go func() {
+		ticker := time.NewTicker(searchLogFlushInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				l.Info("search log worker stopped")
+				return
+			case <-ticker.C:
+				flushSearchLog(ctx, d, l)
+			}
+		}
+	}()
+
+	l.Info("background workers started (view flush, cleanup, search log)")
 }
 
 // flushViewBuffer reads all buffered view increments from Redis and applies them
@@ -5,8 +5,10 @@ import (
 	"backend/internal/conf"
 	"backend/internal/data/model"
 	"backend/internal/pkg/hash"
+	"backend/internal/pkg/playback"
 	"backend/internal/pkg/upload"
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -29,8 +31,19 @@ var ProviderSet = wire.NewSet(
 	NewSearchRepo,
 	NewChannelRepo,
 	NewAdminRepo,
+	NewUploadRepo,
+	NewCaptionRepo,
+	NewStorageRepo,
+	NewTrashRepo,
+	NewQuotaRepo,
 	NewMembershipChecker,
 	NewUploader,
+	NewPlaybackSigner,
+	NewMediaProber,
+	NewTranscodeQueue,
+	NewTranscoder,
+	NewScanner,
+	NewThumbnailer,
 	NewVideoCache,
 )
 
@@ -46,6 +59,12 @@ func NewUploader(mc *minio.Client, c *conf.Storage) *upload.MinIOUploader {
 	return upload.NewMinIOUploader(mc, c.Bucket)
 }
 
+// NewPlaybackSigner signs video playback URLs with a key derived from the
+// JWT secret.
+func NewPlaybackSigner(c *conf.Storage, ac *conf.Auth) *playback.Signer {
+	return playback.NewSigner(c.Bucket, hash.DeriveKey(ac.JwtSecret, "playback"), c.PlaybackUrlTtl.AsDuration())
+}
+
 type Data struct {
 	DB    *gorm.DB
 	Redis *redis.Client
@@ -69,9 +88,10 @@ func NewData(db *gorm.DB, rdb *redis.Client, mc *minio.Client, nc *nats.Conn, ac
 	// Warm up recommendation cache before servers accept traffic.
 	d.WarmUpCache(context.Background(), logger)
 
-	// Start background workers (view flush, cleanup retry) with cancelable context.
+	// Start background workers (view flush, cleanup retry, scheduled publishing) with cancelable context.
 	bgCtx, bgCancel := context.WithCancel(context.Background())
 	StartBackgroundWorkers(bgCtx, d, logger)
+	StartPublishScheduler(bgCtx, d, logger)
 
 	cleanup := func() {
 		log.NewHelper(logger).Info("closing the data resources")
@@ -141,6 +161,8 @@ func NewDB(c *conf.Data, logger log.Logger) *gorm.DB {
 
 	db, err := gorm.Open(mysql.Open(c.Database.Source), &gorm.Config{
 		Logger: gormLogger.Default.LogMode(gormLogger.Info),
+		// Report unique index violations as gorm.ErrDuplicatedKey.
+		TranslateError: true,
 	})
 	if err != nil {
 		l.Fatalf("failed to connect database: %v", err)
@@ -161,6 +183,13 @@ func NewDB(c *conf.Data, logger log.Logger) *gorm.DB {
 		sqlDB.SetConnMaxLifetime(c.Database.ConnMaxLifetime.AsDuration())
 	}
 
+	// videos.is_published became videos.visibility; remember whether this
+	// database still needs converting before AutoMigrate adds the column.
+	convertPublished := !db.Migrator().HasColumn(&model.Video{}, "Visibility") &&
+		db.Migrator().HasColumn(&model.Video{}, "is_published")
+	// Likewise, videos from before revisions need a baseline revision.
+	addRevisions := !db.Migrator().HasTable(&model.VideoRevision{})
+
 	if err := db.AutoMigrate(
 		&model.User{},
 		&model.Channel{},
@@ -172,22 +201,54 @@ func NewDB(c *conf.Data, logger log.Logger) *gorm.DB {
 		&model.ViewRecord{},
 		&model.Notification{},
 		&model.Donation{},
+		&model.SearchLog{},
+		&model.SearchQueryDaily{},
+		&model.SearchSynonymGroup{},
+		&model.SearchSynonym{},
+		&model.SearchSpellingCorrection{},
+		&model.Upload{},
+		&model.VideoThumbnailCandidate{},
+		&model.VideoCaption{},
+		&model.VideoChapter{},
+		&model.VideoShare{},
+		&model.VideoRevision{},
 	); err != nil {
 		l.Fatalf("failed to auto-migrate database: %v", err)
 	}
 
-	// Create FULLTEXT index for search (GORM AutoMigrate cannot create FULLTEXT indexes).
-	// MySQL doesn't support IF NOT EXISTS for CREATE INDEX, so check first.
-	var count int64
-	db.Raw("SELECT COUNT(*) FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = 'videos' AND index_name = 'idx_videos_title_fulltext'").Scan(&count)
-	if count == 0 {
-		db.Exec("CREATE FULLTEXT INDEX idx_videos_title_fulltext ON videos(title)")
+	if convertPublished {
+		if err := db.Exec("UPDATE videos SET visibility = 'private' WHERE is_published = false").Error; err != nil {
+			l.Fatalf("failed to migrate video visibility: %v", err)
+		}
+		if err := db.Migrator().DropColumn(&model.Video{}, "is_published"); err != nil {
+			l.Warnf("failed to drop videos.is_published: %v", err)
+		}
+	}
+
+	if addRevisions {
+		if err := backfillRevisions(db); err != nil {
+			l.Warnf("failed to record baseline video revisions: %v", err)
+		}
 	}
 
+	// Create FULLTEXT indexes for search (GORM AutoMigrate cannot create FULLTEXT indexes).
+	ensureFulltextIndex(db, "videos", "idx_videos_title_fulltext", "title")
+	ensureFulltextIndex(db, "video_captions", "idx_video_captions_text_fulltext", "text")
+
 	l.Info("database connected and migrated")
 	return db
 }
 
+// ensureFulltextIndex creates a FULLTEXT index unless it exists. MySQL
+// doesn't support IF NOT EXISTS for CREATE INDEX, so check first.
+func ensureFulltextIndex(db *gorm.DB, table, index, column string) {
+	var count int64
+	db.Raw("SELECT COUNT(*) FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?", table, index).Scan(&count)
+	if count == 0 {
+		db.Exec(fmt.Sprintf("CREATE FULLTEXT INDEX %s ON %s(%s)", index, table, column))
+	}
+}
+
 func NewRedisClient(c *conf.Data, logger log.Logger) *redis.Client {
 	l := log.NewHelper(logger)
 
@@ -239,10 +300,20 @@ func NewMinIOClient(c *conf.Storage, logger log.Logger) *minio.Client {
 		}
 	}
 
+	// Only thumbnails are publicly readable; videos are served through
+	// signed playback URLs.
+	if err := mc.SetBucketPolicy(ctx, c.Bucket, publicThumbnailsPolicy(c.Bucket)); err != nil {
+		l.Warnf("failed to set MinIO bucket policy: %v", err)
+	}
+
 	l.Info("MinIO client initialized")
 	return mc
 }
 
+func publicThumbnailsPolicy(bucket string) string {
+	return fmt.Sprintf(`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":{"AWS":["*"]},"Action":["s3:GetObject"],"Resource":["arn:aws:s3:::%s/thumbnails/*"]}]}`, bucket)
+}
+
 func NewNATSConn(c *conf.NATS, logger log.Logger) *nats.Conn {
 	l := log.NewHelper(logger)
 
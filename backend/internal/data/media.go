@@ -0,0 +1,73 @@
+package data
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"backend/internal/biz"
+	"backend/internal/conf"
+	"backend/internal/pkg/media"
+	"backend/internal/pkg/upload"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const defaultProbeTimeout = 30 * time.Second
+
+type mediaProber struct {
+	prober   media.Prober
+	uploader *upload.MinIOUploader
+	timeout  time.Duration
+	log      *log.Helper
+}
+
+// NewMediaProber returns nil (probing disabled) when no ffprobe binary is
+// configured.
+func NewMediaProber(c *conf.Media, uploader *upload.MinIOUploader, logger log.Logger) biz.MediaProber {
+	if c == nil || c.FfprobePath == "" {
+		log.NewHelper(logger).Warn("media probing disabled: no ffprobe_path configured")
+		return nil
+	}
+	timeout := c.ProbeTimeout.AsDuration()
+	if timeout <= 0 {
+		timeout = defaultProbeTimeout
+	}
+	return &mediaProber{
+		prober:   media.NewFFprobe(c.FfprobePath),
+		uploader: uploader,
+		timeout:  timeout,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Probe lets ffprobe read the object through a short-lived presigned URL,
+// so only the bytes it needs (headers, moov atom) are fetched. Only bucket
+// objects are probed; arbitrary URLs would let clients make the server
+// fetch anything.
+func (p *mediaProber) Probe(ctx context.Context, videoURL string) (*biz.MediaInfo, error) {
+	object, ok := p.uploader.ObjectName(videoURL)
+	if !ok {
+		return nil, biz.ErrVideoSourceInvalid
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	src, err := p.uploader.PresignGet(ctx, object, p.timeout)
+	if err != nil {
+		return nil, err
+	}
+	info, err := p.prober.Probe(ctx, src)
+	if err != nil {
+		return nil, err
+	}
+	return &biz.MediaInfo{
+		Duration:   uint32(math.Ceil(info.DurationSec)),
+		Width:      info.Width,
+		Height:     info.Height,
+		VideoCodec: info.VideoCodec,
+		AudioCodec: info.AudioCodec,
+		Bitrate:    info.Bitrate,
+		FileSize:   info.Size,
+	}, nil
+}
@@ -4,7 +4,7 @@ import "time"
 
 type Donation struct {
 	ID                  uint64    `gorm:"primaryKey;autoIncrement"`
-	VideoID             uint64    `gorm:"index;not null"`
+	VideoID             *uint64   `gorm:"index"` // nil once the video is purged from the trash
 	DonorID             uint64    `gorm:"index;not null"`
 	CreatorID           uint64    `gorm:"index;not null"`
 	Amount              float64   `gorm:"type:decimal(10,2);not null"`
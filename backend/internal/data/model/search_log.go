@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"gorm.io/datatypes"
+)
+
+type SearchLog struct {
+	ID             uint64         `gorm:"primaryKey;autoIncrement"`
+	SearchID       string         `gorm:"type:varchar(36);uniqueIndex;not null"`
+	Query          string         `gorm:"type:varchar(200);index;not null"` // normalized
+	Filters        datatypes.JSON `gorm:"type:json"`
+	ResultCount    int64          `gorm:"not null;default:0"`
+	LatencyMs      uint32         `gorm:"not null;default:0"`
+	UserID         *uint64        `gorm:"index"`
+	SessionID      *string        `gorm:"type:varchar(100);index"`
+	ClickedVideoID *uint64
+	ClickPosition  *int32
+	ClickedAt      *time.Time
+	CreatedAt      time.Time `gorm:"index"`
+}
+
+// SearchQueryDaily is the per-day rollup of SearchLog, keyed by normalized query.
+type SearchQueryDaily struct {
+	ID              uint64    `gorm:"primaryKey;autoIncrement"`
+	Day             time.Time `gorm:"type:date;not null;uniqueIndex:idx_search_day_query"`
+	Query           string    `gorm:"type:varchar(200);not null;uniqueIndex:idx_search_day_query"`
+	Searches        int64     `gorm:"not null;default:0"`
+	ZeroResults     int64     `gorm:"not null;default:0"`
+	ClickedSearches int64     `gorm:"not null;default:0"`
+	TotalLatencyMs  uint64    `gorm:"not null;default:0"`
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
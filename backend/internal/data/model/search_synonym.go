@@ -0,0 +1,28 @@
+package model
+
+import "time"
+
+// SearchSynonymGroup is a set of interchangeable search terms,
+// e.g. 教學 ↔ tutorial ↔ 教程.
+type SearchSynonymGroup struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"`
+	CreatedAt time.Time
+	UpdatedAt time.Time
+
+	// Relations
+	Terms []SearchSynonym `gorm:"foreignKey:GroupID"`
+}
+
+type SearchSynonym struct {
+	ID      uint64 `gorm:"primaryKey;autoIncrement"`
+	GroupID uint64 `gorm:"index;not null"`
+	Term    string `gorm:"type:varchar(100);uniqueIndex;not null"` // normalized
+}
+
+type SearchSpellingCorrection struct {
+	ID          uint64 `gorm:"primaryKey;autoIncrement"`
+	Misspelling string `gorm:"type:varchar(100);uniqueIndex;not null"` // normalized
+	Correction  string `gorm:"type:varchar(100);not null"`
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
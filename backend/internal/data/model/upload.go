@@ -0,0 +1,17 @@
+package model
+
+import "time"
+
+// Upload is an object a user put into the bucket.
+type Upload struct {
+	ID          uint64 `gorm:"primaryKey;autoIncrement"`
+	UserID      uint64 `gorm:"index;not null"`
+	ObjectName  string `gorm:"type:varchar(255);uniqueIndex;not null"`
+	Kind        string `gorm:"type:varchar(20);not null"` // video, thumbnail
+	ContentType string `gorm:"type:varchar(100);not null"`
+	Size        int64  `gorm:"not null;default:0"`
+	SHA256      string `gorm:"column:sha256;type:char(64);not null;default:'';index"` // hex; empty until verified
+	Status      string `gorm:"type:varchar(20);not null;default:'pending';index"`     // pending, attached, deleted, rejected
+	DuplicateOf string `gorm:"type:varchar(255);not null;default:''"`                 // object kept instead, with the same content
+	CreatedAt   time.Time
+}
@@ -7,22 +7,41 @@ import (
 )
 
 type Video struct {
-	ID             uint64         `gorm:"primaryKey;autoIncrement"`
-	UserID         uint64         `gorm:"index;not null"`
-	CategoryID     uint64         `gorm:"index;not null"`
-	Title          string         `gorm:"type:varchar(200);not null"`
-	Description    *string        `gorm:"type:text"`
-	VideoURL       string         `gorm:"type:varchar(500);not null"`
-	ThumbnailURL   *string        `gorm:"type:varchar(500)"`
-	Duration       uint32         `gorm:"not null;default:0"`
-	ViewsMember    uint64         `gorm:"not null;default:0"`
-	ViewsNonMember uint64         `gorm:"not null;default:0"`
-	AccessTier     int8           `gorm:"not null;default:0"` // 0=public, 1=subscriber, 2=premium
-	IsPublished    bool           `gorm:"not null;default:true"`
-	IsHidden       bool           `gorm:"not null;default:false"`
-	CreatedAt      time.Time      `gorm:"index"`
-	UpdatedAt      time.Time
-	DeletedAt      gorm.DeletedAt `gorm:"index"`
+	ID                 uint64     `gorm:"primaryKey;autoIncrement"`
+	UserID             uint64     `gorm:"index;not null"`
+	CategoryID         uint64     `gorm:"index;not null"`
+	Title              string     `gorm:"type:varchar(200);not null"`
+	Description        *string    `gorm:"type:text"`
+	VideoURL           string     `gorm:"type:varchar(500);not null"`
+	PendingVideoURL    *string    `gorm:"type:varchar(500)"` // replacement source being processed; VideoURL plays until it is swapped in
+	ThumbnailURL       *string    `gorm:"type:varchar(500)"`
+	ThumbnailSmallURL  *string    `gorm:"type:varchar(500)"` // WebP variants of ThumbnailURL
+	ThumbnailMediumURL *string    `gorm:"type:varchar(500)"`
+	ThumbnailLargeURL  *string    `gorm:"type:varchar(500)"`
+	Duration           uint32     `gorm:"not null;default:0"`
+	Width              uint32     `gorm:"not null;default:0"`
+	Height             uint32     `gorm:"not null;default:0"`
+	VideoCodec         string     `gorm:"type:varchar(32);not null;default:''"`
+	AudioCodec         string     `gorm:"type:varchar(32);not null;default:''"`
+	Bitrate            uint64     `gorm:"not null;default:0"`                              // bits per second
+	FileSize           uint64     `gorm:"not null;default:0"`                              // bytes
+	StorageSize        int64      `gorm:"not null;default:0"`                              // bytes stored for the video, tallied by the storage reconciler
+	Status             string     `gorm:"type:varchar(16);not null;default:'ready';index"` // processing, ready, failed
+	Visibility         string     `gorm:"type:varchar(16);not null;default:'published'"`   // published, unlisted, private
+	ProcessingProgress uint32     `gorm:"not null;default:0"`                              // percent
+	ProcessingError    string     `gorm:"type:varchar(255);not null;default:''"`
+	TranscodeStatus    string     `gorm:"type:varchar(16);not null;default:'none'"` // none, pending, running, ready, failed
+	HLSURL             *string    `gorm:"column:hls_url;type:varchar(500)"`         // master playlist
+	SeekPreviewURL     *string    `gorm:"type:varchar(500)"`                        // WebVTT thumbnails track
+	ViewsMember        uint64     `gorm:"not null;default:0"`
+	ViewsNonMember     uint64     `gorm:"not null;default:0"`
+	AccessTier         int8       `gorm:"not null;default:0"` // 0=public, 1=subscriber, 2=premium
+	IsHidden           bool       `gorm:"not null;default:false"`
+	PublishAt          *time.Time `gorm:"index"`              // when a scheduled video is published; it stays private until then
+	Version            uint64     `gorm:"not null;default:1"` // bumped by every metadata change, for optimistic concurrency
+	CreatedAt          time.Time  `gorm:"index"`
+	UpdatedAt          time.Time
+	DeletedAt          gorm.DeletedAt `gorm:"index"`
 
 	// Relations
 	User     User     `gorm:"foreignKey:UserID"`
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// VideoCaption is a WebVTT caption track of a video, one per language.
+type VideoCaption struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"`
+	VideoID   uint64 `gorm:"not null;uniqueIndex:idx_video_captions_language"`
+	Language  string `gorm:"type:varchar(35);not null;uniqueIndex:idx_video_captions_language"` // BCP 47
+	Label     string `gorm:"type:varchar(100);not null"`
+	URL       string `gorm:"type:varchar(500);not null"`
+	Text      string `gorm:"type:mediumtext"` // cue text for search; empty unless indexed
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
@@ -0,0 +1,9 @@
+package model
+
+// VideoChapter is a titled section of a video.
+type VideoChapter struct {
+	ID      uint64 `gorm:"primaryKey;autoIncrement"`
+	VideoID uint64 `gorm:"not null;uniqueIndex:idx_video_chapters_start"`
+	Start   uint32 `gorm:"not null;uniqueIndex:idx_video_chapters_start"` // seconds
+	Title   string `gorm:"type:varchar(100);not null"`
+}
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+// VideoRevision is a snapshot of a video's editable metadata right after a
+// change, with who made it.
+type VideoRevision struct {
+	ID           uint64  `gorm:"primaryKey;autoIncrement"`
+	VideoID      uint64  `gorm:"index;not null"`
+	Version      uint64  `gorm:"not null"`                              // the video's version after the change
+	ActorID      uint64  `gorm:"not null;default:0"`                    // 0 for the system, e.g. the publish scheduler
+	Action       string  `gorm:"type:varchar(20);not null"`             // baseline, created, updated, restored, published, thumbnail, scheduled
+	Fields       string  `gorm:"type:varchar(255);not null;default:''"` // comma-separated fields the change set
+	RestoredFrom uint64  `gorm:"not null;default:0"`                    // revision a restore copied
+	Title        string  `gorm:"type:varchar(200);not null"`
+	Description  *string `gorm:"type:text"`
+	CategoryID   uint64  `gorm:"not null"`
+	TagIDs       string  `gorm:"type:text"` // comma-separated
+	AccessTier   int8    `gorm:"not null;default:0"`
+	ThumbnailURL *string `gorm:"type:varchar(500)"`
+	Visibility   string  `gorm:"type:varchar(16);not null"`
+	CreatedAt    time.Time
+}
@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// VideoShare is a link token that lets whoever holds it watch one video,
+// even if it is private or members-only.
+type VideoShare struct {
+	ID        uint64     `gorm:"primaryKey;autoIncrement"`
+	VideoID   uint64     `gorm:"index;not null"`
+	Token     string     `gorm:"type:varchar(64);uniqueIndex;not null"`
+	ExpiresAt *time.Time // nil never expires
+	CreatedAt time.Time
+}
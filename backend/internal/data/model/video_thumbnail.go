@@ -0,0 +1,13 @@
+package model
+
+import "time"
+
+// VideoThumbnailCandidate is a frame extracted from a video for its owner to
+// pick as the thumbnail.
+type VideoThumbnailCandidate struct {
+	ID        uint64 `gorm:"primaryKey;autoIncrement"`
+	VideoID   uint64 `gorm:"index;not null"`
+	URL       string `gorm:"type:varchar(500);not null"`
+	Position  uint32 `gorm:"not null"` // percent of the duration
+	CreatedAt time.Time
+}
@@ -0,0 +1,136 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"backend/internal/biz"
+	"backend/internal/data/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+const (
+	publishSchedulerInterval = 10 * time.Second
+	publishBatchSize         = 100
+
+	// VideoPublishedSubject is the NATS subject a VideoPublishedEvent is
+	// sent on when a scheduled video goes live.
+	VideoPublishedSubject = "videos.published"
+)
+
+// VideoPublishedEvent announces that a scheduled video was published.
+type VideoPublishedEvent struct {
+	VideoID     uint64    `json:"video_id"`
+	UserID      uint64    `json:"user_id"`
+	Title       string    `json:"title"`
+	PublishedAt time.Time `json:"published_at"`
+}
+
+// StartPublishScheduler publishes scheduled videos once their publish_at
+// has passed. Called from NewData next to StartBackgroundWorkers.
+//
+// Every instance runs the scheduler; publishing is a compare-and-set on
+// publish_at, so each video is published, cached and announced once.
+func StartPublishScheduler(ctx context.Context, d *Data, logger log.Logger) {
+	l := log.NewHelper(logger)
+	// Only FindByID and syncCache are used, which need no uploader.
+	videos := &videoRepo{data: d, cache: NewVideoCache(d, logger), log: l}
+
+	go func() {
+		ticker := time.NewTicker(publishSchedulerInterval)
+		defer ticker.Stop()
+		for {
+			publishDueVideos(ctx, d, videos, l)
+			select {
+			case <-ctx.Done():
+				l.Info("publish scheduler stopped")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// publishDueVideos publishes videos whose publish_at has passed, oldest
+// first, a batch at a time. It stops at a batch it cannot publish any of,
+// which would otherwise come back forever; the next tick retries it.
+func publishDueVideos(ctx context.Context, d *Data, videos *videoRepo, l *log.Helper) {
+	for {
+		var due []model.Video
+		if err := d.DB.WithContext(ctx).
+			Where("publish_at <= ?", time.Now()).
+			Order("publish_at").
+			Limit(publishBatchSize).
+			Find(&due).Error; err != nil {
+			l.Warnf("publish scheduler: list due videos: %v", err)
+			return
+		}
+		published := 0
+		for i := range due {
+			if publishScheduled(ctx, d, videos, l, &due[i]) {
+				published++
+			}
+		}
+		if len(due) < publishBatchSize || published == 0 {
+			return
+		}
+	}
+}
+
+// errNotScheduled rolls back publishing a video that was rescheduled, or
+// published by another instance, meanwhile.
+var errNotScheduled = errors.New("video no longer scheduled")
+
+// publishScheduled reports whether it published m.
+func publishScheduled(ctx context.Context, d *Data, videos *videoRepo, l *log.Helper, m *model.Video) bool {
+	err := d.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&model.Video{}).
+			Where("id = ? AND publish_at = ?", m.ID, *m.PublishAt).
+			Updates(map[string]interface{}{
+				"visibility": biz.VisibilityPublished,
+				"publish_at": nil,
+				"version":    gorm.Expr("version + 1"),
+			})
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return errNotScheduled
+		}
+		return recordRevision(tx, &biz.VideoRevision{
+			VideoID: m.ID,
+			Action:  biz.RevisionScheduled,
+			Fields:  []string{biz.VideoFieldVisibility, biz.VideoFieldPublishAt},
+		})
+	})
+	if errors.Is(err, errNotScheduled) {
+		return false
+	}
+	if err != nil {
+		l.Warnf("publish scheduled video %d: %v", m.ID, err)
+		return false
+	}
+	l.Infof("published scheduled video %d", m.ID)
+	videos.syncCache(ctx, m.ID)
+
+	if d.NATS == nil {
+		return true
+	}
+	raw, err := json.Marshal(&VideoPublishedEvent{
+		VideoID:     m.ID,
+		UserID:      m.UserID,
+		Title:       m.Title,
+		PublishedAt: time.Now().UTC(),
+	})
+	if err == nil {
+		err = d.NATS.Publish(VideoPublishedSubject, raw)
+	}
+	if err != nil {
+		l.Warnf("announce published video %d: %v", m.ID, err)
+	}
+	return true
+}
@@ -0,0 +1,75 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/internal/biz"
+	"backend/internal/data/model"
+	"backend/internal/pkg/upload"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type quotaRepo struct {
+	data     *Data
+	uploader *upload.MinIOUploader
+	log      *log.Helper
+}
+
+func NewQuotaRepo(data *Data, uploader *upload.MinIOUploader, logger log.Logger) biz.QuotaRepo {
+	return &quotaRepo{
+		data:     data,
+		uploader: uploader,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// GetStorageUsage adds the uploads no video uses yet to the size of the
+// user's videos, so a source is counted once either way. A video the
+// reconciler has not tallied yet counts with the size of its source.
+// Videos in the trash keep their files until purged, so they count too,
+// and restoring one cannot take a user over their quota.
+func (r *quotaRepo) GetStorageUsage(ctx context.Context, userID uint64, since time.Time) (*biz.StorageUsage, error) {
+	db := r.data.DB.WithContext(ctx)
+
+	var user model.User
+	if err := db.Select("id", "role").First(&user, userID).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, biz.ErrUserNotFound
+		}
+		return nil, err
+	}
+	usage := &biz.StorageUsage{Role: user.Role}
+
+	var pending, stored int64
+	if err := db.Model(&model.Upload{}).
+		Where("user_id = ? AND status = ?", userID, biz.UploadPending).
+		// The reconciler only marks the uploads videos use attached later.
+		Where("NOT EXISTS (SELECT 1 FROM videos WHERE videos.video_url = CONCAT(?, uploads.object_name))",
+			r.uploader.GetURL("")).
+		Select("COALESCE(SUM(size), 0)").Scan(&pending).Error; err != nil {
+		return nil, err
+	}
+	if err := db.Unscoped().Model(&model.Video{}).
+		Where("user_id = ?", userID).
+		Select("COALESCE(SUM(CASE WHEN storage_size > 0 THEN storage_size ELSE file_size END), 0)").Scan(&stored).Error; err != nil {
+		return nil, err
+	}
+	usage.UsedBytes = pending + stored
+
+	if err := db.Model(&model.Upload{}).
+		Where("user_id = ? AND kind = ? AND created_at >= ?", userID, "video", since).
+		Count(&usage.Uploads).Error; err != nil {
+		return nil, err
+	}
+	// Deleting a video does not give back its place in the limit.
+	if err := db.Unscoped().Model(&model.Video{}).
+		Where("user_id = ? AND created_at >= ?", userID, since).
+		Count(&usage.Videos).Error; err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
@@ -0,0 +1,148 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"backend/internal/biz"
+	"backend/internal/data/model"
+
+	"gorm.io/gorm"
+)
+
+// recordRevision snapshots the video as changed so far by tx, the
+// transaction of the change, so history cannot miss a committed change or
+// pick up a later one.
+func recordRevision(tx *gorm.DB, rev *biz.VideoRevision) error {
+	var v model.Video
+	if err := tx.First(&v, rev.VideoID).Error; err != nil {
+		return err
+	}
+	var tagIDs []uint64
+	if err := tx.Table("video_tags").Where("video_id = ?", rev.VideoID).Order("tag_id").Pluck("tag_id", &tagIDs).Error; err != nil {
+		return err
+	}
+	return tx.Create(&model.VideoRevision{
+		VideoID:      v.ID,
+		Version:      v.Version,
+		ActorID:      rev.ActorID,
+		Action:       rev.Action,
+		Fields:       strings.Join(rev.Fields, ","),
+		RestoredFrom: rev.RestoredFrom,
+		Title:        v.Title,
+		Description:  v.Description,
+		CategoryID:   v.CategoryID,
+		TagIDs:       joinIDs(tagIDs),
+		AccessTier:   v.AccessTier,
+		ThumbnailURL: v.ThumbnailURL,
+		Visibility:   v.Visibility,
+	}).Error
+}
+
+// revisionRow is a revision with its actor's display name.
+type revisionRow struct {
+	model.VideoRevision
+	ActorName string
+}
+
+func (r *videoRepo) revisions(ctx context.Context) *gorm.DB {
+	return r.data.DB.WithContext(ctx).Model(&model.VideoRevision{}).
+		Select("video_revisions.*, users.display_name AS actor_name").
+		Joins("LEFT JOIN users ON users.id = video_revisions.actor_id")
+}
+
+func (r *videoRepo) ListRevisions(ctx context.Context, videoID uint64, offset, limit int) ([]*biz.VideoRevision, int64, error) {
+	var total int64
+	if err := r.data.DB.WithContext(ctx).Model(&model.VideoRevision{}).
+		Where("video_id = ?", videoID).
+		Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var rows []revisionRow
+	if err := r.revisions(ctx).
+		Where("video_revisions.video_id = ?", videoID).
+		Order("video_revisions.id DESC").
+		Offset(offset).Limit(limit).
+		Scan(&rows).Error; err != nil {
+		return nil, 0, err
+	}
+	revisions := make([]*biz.VideoRevision, len(rows))
+	for i := range rows {
+		revisions[i] = toBizVideoRevision(&rows[i])
+	}
+	return revisions, total, nil
+}
+
+func (r *videoRepo) FindRevision(ctx context.Context, videoID, revisionID uint64) (*biz.VideoRevision, error) {
+	var row revisionRow
+	err := r.revisions(ctx).
+		Where("video_revisions.id = ? AND video_revisions.video_id = ?", revisionID, videoID).
+		Take(&row).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toBizVideoRevision(&row), nil
+}
+
+// backfillRevisions records the current state of every video as its
+// baseline revision, so the first edit after revisions were introduced
+// can still be undone.
+func backfillRevisions(db *gorm.DB) error {
+	return db.Exec(`INSERT INTO video_revisions
+		(video_id, version, actor_id, action, fields, restored_from, title, description,
+		 category_id, tag_ids, access_tier, thumbnail_url, visibility, created_at)
+		SELECT v.id, v.version, 0, ?, '', 0, v.title, v.description, v.category_id,
+			COALESCE((SELECT GROUP_CONCAT(vt.tag_id ORDER BY vt.tag_id) FROM video_tags vt WHERE vt.video_id = v.id), ''),
+			v.access_tier, v.thumbnail_url, v.visibility, v.updated_at
+		FROM videos v
+		WHERE v.deleted_at IS NULL`, biz.RevisionBaseline).Error
+}
+
+func toBizVideoRevision(m *revisionRow) *biz.VideoRevision {
+	var fields []string
+	if m.Fields != "" {
+		fields = strings.Split(m.Fields, ",")
+	}
+	return &biz.VideoRevision{
+		ID:           m.ID,
+		VideoID:      m.VideoID,
+		Version:      m.Version,
+		ActorID:      m.ActorID,
+		ActorName:    m.ActorName,
+		Action:       m.Action,
+		Fields:       fields,
+		RestoredFrom: m.RestoredFrom,
+		Title:        m.Title,
+		Description:  derefString(m.Description),
+		CategoryID:   m.CategoryID,
+		TagIDs:       splitIDs(m.TagIDs),
+		AccessTier:   m.AccessTier,
+		ThumbnailURL: derefString(m.ThumbnailURL),
+		Visibility:   m.Visibility,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+func joinIDs(ids []uint64) string {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatUint(id, 10)
+	}
+	return strings.Join(parts, ",")
+}
+
+func splitIDs(s string) []uint64 {
+	var ids []uint64
+	for _, part := range strings.Split(s, ",") {
+		if id, err := strconv.ParseUint(part, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
@@ -0,0 +1,57 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"backend/internal/biz"
+	"backend/internal/conf"
+	"backend/internal/pkg/clamav"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const defaultScanTimeout = 5 * time.Minute
+
+type clamavScanner struct {
+	client *clamav.Client
+	log    *log.Helper
+}
+
+// NewScanner returns nil (scanning disabled) when no clamd address is
+// configured. Addresses starting with "/" are Unix sockets.
+func NewScanner(c *conf.Media, logger log.Logger) biz.Scanner {
+	if c == nil || c.ClamdAddress == "" {
+		log.NewHelper(logger).Warn("malware scanning disabled: no clamd_address configured")
+		return nil
+	}
+	network := "tcp"
+	if strings.HasPrefix(c.ClamdAddress, "/") {
+		network = "unix"
+	}
+	timeout := c.ScanTimeout.AsDuration()
+	if timeout <= 0 {
+		timeout = defaultScanTimeout
+	}
+	return &clamavScanner{
+		client: clamav.New(network, c.ClamdAddress, timeout),
+		log:    log.NewHelper(logger),
+	}
+}
+
+// Scan reports a file beyond clamd's StreamMaxLength as clean: clamd only
+// accepts its beginning, so the rest goes unscanned rather than rejected.
+func (s *clamavScanner) Scan(ctx context.Context, r io.Reader) (*biz.ScanResult, error) {
+	result, err := s.client.Scan(ctx, r)
+	if errors.Is(err, clamav.ErrStreamTooLarge) {
+		s.log.Infof("scan stopped at clamd's stream size limit")
+		return &biz.ScanResult{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &biz.ScanResult{Infected: result.Infected, Signature: result.Signature}, nil
+}
@@ -2,8 +2,12 @@ package data
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
+	"time"
 
 	"backend/internal/biz"
+	"backend/internal/conf"
 	"backend/internal/data/model"
 	"backend/internal/pkg/pagination"
 
@@ -11,25 +15,35 @@ import (
 )
 
 type searchRepo struct {
-	data *Data
-	log  *log.Helper
+	data     *Data
+	captions bool // also match caption text
+	log      *log.Helper
 }
 
-func NewSearchRepo(data *Data, logger log.Logger) biz.SearchRepo {
+func NewSearchRepo(data *Data, c *conf.Media, logger log.Logger) biz.SearchRepo {
 	return &searchRepo{
-		data: data,
-		log:  log.NewHelper(logger),
+		data:     data,
+		captions: c != nil && c.IndexCaptions,
+		log:      log.NewHelper(logger),
 	}
 }
 
-func (r *searchRepo) Search(ctx context.Context, params *biz.SearchParams) ([]*biz.Video, int64, error) {
+func (r *searchRepo) Search(ctx context.Context, params *biz.SearchParams, page pagination.Request) ([]*biz.Video, int64, error) {
 	query := r.data.DB.WithContext(ctx).
 		Model(&model.Video{}).
-		Where("videos.is_published = ? AND videos.is_hidden = ? AND videos.deleted_at IS NULL", true, false)
+		Scopes(listedVideos)
 
-	// FULLTEXT search on title (BOOLEAN MODE for small datasets)
+	// FULLTEXT search on title (BOOLEAN MODE for small datasets),
+	// with each term widened to its admin-defined synonyms, and on what is
+	// said in the video when captions are indexed
 	if params.Query != "" {
-		query = query.Where("MATCH(videos.title) AGAINST(? IN BOOLEAN MODE)", params.Query)
+		q := r.expandQuery(ctx, params.Query)
+		if r.captions {
+			query = query.Where("(MATCH(videos.title) AGAINST(? IN BOOLEAN MODE) OR videos.id IN "+
+				"(SELECT video_id FROM video_captions WHERE MATCH(video_captions.text) AGAINST(? IN BOOLEAN MODE)))", q, q)
+		} else {
+			query = query.Where("MATCH(videos.title) AGAINST(? IN BOOLEAN MODE)", q)
+		}
 	}
 
 	// Filters
@@ -57,34 +71,231 @@ func (r *searchRepo) Search(ctx context.Context, params *biz.SearchParams) ([]*b
 		}
 	}
 
-	// Count before pagination
+	// Count before pagination (skipped when paging by cursor)
 	var total int64
-	if err := query.Count(&total).Error; err != nil {
-		return nil, 0, err
+	if !page.Keyset() {
+		if err := query.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
-	// Sorting
+	// Sorting, with ID as tie-breaker so the keyset is unique
+	column, cmp, dir := "videos.created_at", "<", "DESC"
 	switch params.SortBy {
 	case "views_desc":
-		query = query.Order("(videos.views_member + videos.views_non_member) DESC")
+		column = "(videos.views_member + videos.views_non_member)"
 	case "views_asc":
-		query = query.Order("(videos.views_member + videos.views_non_member) ASC")
+		column, cmp, dir = "(videos.views_member + videos.views_non_member)", ">", "ASC"
 	case "date_asc":
-		query = query.Order("videos.created_at ASC")
-	default:
-		query = query.Order("videos.created_at DESC")
+		cmp, dir = ">", "ASC"
 	}
+	query = query.Order(column + " " + dir).Order("videos.id " + dir)
 
-	// Pagination
-	offset, limit := pagination.Normalize(params.Page, params.PageSize)
+	if page.Keyset() {
+		var key interface{} = page.After.Key
+		if column == "videos.created_at" {
+			key = time.UnixMicro(page.After.Key)
+		}
+		query = query.Where(
+			column+" "+cmp+" ? OR ("+column+" = ? AND videos.id "+cmp+" ?)",
+			key, key, page.After.ID,
+		)
+	}
 
 	var videos []model.Video
 	if err := query.
 		Preload("Tags").Preload("Category").Preload("User").
-		Offset(offset).Limit(limit).
+		Offset(page.Offset).Limit(page.Limit).
 		Find(&videos).Error; err != nil {
 		return nil, 0, err
 	}
 
 	return toBizVideos(videos), total, nil
 }
+
+// booleanOperators are the FULLTEXT BOOLEAN MODE operator characters that may
+// wrap a term, e.g. +term, -term, term*, "phrase".
+const booleanOperators = `+-~<>*"()@`
+
+// expandQuery rewrites a BOOLEAN MODE query so that every term with synonyms
+// matches any of them: with the group {教學, tutorial, 教程}, "+教學 go"
+// becomes "+(教學 tutorial 教程) go". Excluded (-term) and phrase terms are left
+// untouched. On lookup failure the original query is returned.
+func (r *searchRepo) expandQuery(ctx context.Context, q string) string {
+	tokens := strings.Fields(q)
+	bare := make([]string, len(tokens))
+	lookup := make([]string, 0, len(tokens)+1)
+	for i, t := range tokens {
+		bare[i] = strings.ToLower(strings.Trim(t, booleanOperators))
+		if bare[i] != "" {
+			lookup = append(lookup, bare[i])
+		}
+	}
+	if len(lookup) == 0 {
+		return q
+	}
+
+	synonyms, err := r.findSynonyms(ctx, lookup)
+	if err != nil {
+		r.log.Warnf("synonym lookup failed: %v", err)
+		return q
+	}
+	if len(synonyms) == 0 {
+		return q
+	}
+
+	out := make([]string, len(tokens))
+	for i, t := range tokens {
+		syns := synonyms[bare[i]]
+		if len(syns) == 0 || strings.HasPrefix(t, "-") || strings.ContainsAny(t, `"()`) {
+			out[i] = t
+			continue
+		}
+		prefix := ""
+		if strings.HasPrefix(t, "+") {
+			prefix = "+"
+		}
+		group := make([]string, 0, len(syns)+1)
+		group = append(group, strings.TrimPrefix(t, "+"))
+		for _, s := range syns {
+			group = append(group, booleanTerm(s))
+		}
+		out[i] = prefix + "(" + strings.Join(group, " ") + ")"
+	}
+	return strings.Join(out, " ")
+}
+
+// findSynonyms returns, for each term that belongs to a synonym group,
+// the other terms of its group.
+func (r *searchRepo) findSynonyms(ctx context.Context, terms []string) (map[string][]string, error) {
+	var hits []model.SearchSynonym
+	if err := r.data.DB.WithContext(ctx).Where("term IN ?", terms).Find(&hits).Error; err != nil {
+		return nil, err
+	}
+	if len(hits) == 0 {
+		return nil, nil
+	}
+
+	groupIDs := make([]uint64, len(hits))
+	for i, h := range hits {
+		groupIDs[i] = h.GroupID
+	}
+	var members []model.SearchSynonym
+	if err := r.data.DB.WithContext(ctx).Where("group_id IN ?", groupIDs).Find(&members).Error; err != nil {
+		return nil, err
+	}
+
+	byGroup := make(map[uint64][]string)
+	for _, m := range members {
+		byGroup[m.GroupID] = append(byGroup[m.GroupID], m.Term)
+	}
+	result := make(map[string][]string, len(hits))
+	for _, h := range hits {
+		for _, t := range byGroup[h.GroupID] {
+			if t != h.Term {
+				result[h.Term] = append(result[h.Term], t)
+			}
+		}
+	}
+	return result, nil
+}
+
+// booleanTerm quotes multi-word or hyphenated terms so MySQL treats them as
+// one phrase instead of splitting them into separate words.
+func booleanTerm(term string) string {
+	if strings.ContainsAny(term, " -") {
+		return `"` + term + `"`
+	}
+	return term
+}
+
+// SuggestCorrection looks up the whole normalized query first, then
+// corrects it word by word.
+func (r *searchRepo) SuggestCorrection(ctx context.Context, q string) (string, error) {
+	normalized := biz.NormalizeSearchQuery(q)
+	words := strings.Fields(normalized)
+	bare := make([]string, len(words))
+	for i, w := range words {
+		bare[i] = strings.Trim(w, booleanOperators)
+	}
+	lookup := append([]string{normalized}, bare...)
+
+	var rows []model.SearchSpellingCorrection
+	if err := r.data.DB.WithContext(ctx).Where("misspelling IN ?", lookup).Find(&rows).Error; err != nil {
+		return "", err
+	}
+	if len(rows) == 0 {
+		return "", nil
+	}
+	corrections := make(map[string]string, len(rows))
+	for _, c := range rows {
+		corrections[c.Misspelling] = c.Correction
+	}
+
+	if c, ok := corrections[normalized]; ok {
+		return c, nil
+	}
+	changed := false
+	for i, w := range bare {
+		if c, ok := corrections[w]; ok {
+			words[i] = c
+			changed = true
+		} else {
+			words[i] = w
+		}
+	}
+	if !changed {
+		return "", nil
+	}
+	return strings.Join(words, " "), nil
+}
+
+// LogSearch buffers a search event in Redis; flushSearchLog persists it.
+func (r *searchRepo) LogSearch(ctx context.Context, entry *biz.SearchLogEntry) error {
+	p := entry.Params
+	ev := searchLogEvent{
+		Type:        searchEventSearch,
+		SearchID:    entry.SearchID,
+		Query:       entry.Query,
+		ResultCount: entry.ResultCount,
+		LatencyMs:   uint32(entry.Latency.Milliseconds()),
+		UserID:      p.ViewerID,
+		SessionID:   p.SessionID,
+		At:          entry.CreatedAt,
+		Filters: &searchLogFilters{
+			CategoryID:  p.CategoryID,
+			MinDuration: p.MinDuration,
+			MaxDuration: p.MaxDuration,
+			DateFrom:    p.DateFrom,
+			DateTo:      p.DateTo,
+			SortBy:      p.SortBy,
+			AccessType:  p.AccessType,
+			Page:        p.Page,
+			PageSize:    p.PageSize,
+		},
+	}
+	return r.pushSearchEvent(ctx, &ev)
+}
+
+// LogClick buffers a click-through event for a previously logged search.
+func (r *searchRepo) LogClick(ctx context.Context, click *biz.SearchClick) error {
+	ev := searchLogEvent{
+		Type:     searchEventClick,
+		SearchID: click.SearchID,
+		VideoID:  click.VideoID,
+		Position: click.Position,
+		At:       click.ClickedAt,
+	}
+	return r.pushSearchEvent(ctx, &ev)
+}
+
+func (r *searchRepo) pushSearchEvent(ctx context.Context, ev *searchLogEvent) error {
+	if r.data.Redis == nil {
+		return nil
+	}
+	b, err := json.Marshal(ev)
+	if err != nil {
+		return err
+	}
+	return r.data.Redis.RPush(ctx, searchLogQueue, b).Err()
+}
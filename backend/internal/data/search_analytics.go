@@ -0,0 +1,172 @@
+package data
+
+import (
+	"backend/internal/data/model"
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	searchLogQueue         = "search:log"
+	searchLogFlushInterval = 30 * time.Second
+	searchLogBatchSize     = 500
+	searchQueryMaxRunes    = 200
+
+	searchEventSearch = "search"
+	searchEventClick  = "click"
+)
+
+// searchLogEvent is the JSON payload stored in the search:log LIST.
+// Searches and clicks share one queue so the worker sees them in order.
+type searchLogEvent struct {
+	Type        string            `json:"type"`
+	SearchID    string            `json:"search_id"`
+	Query       string            `json:"query,omitempty"`
+	Filters     *searchLogFilters `json:"filters,omitempty"`
+	ResultCount int64             `json:"result_count,omitempty"`
+	LatencyMs   uint32            `json:"latency_ms,omitempty"`
+	UserID      *uint64           `json:"user_id,omitempty"`
+	SessionID   *string           `json:"session_id,omitempty"`
+	VideoID     uint64            `json:"video_id,omitempty"`
+	Position    int32             `json:"position,omitempty"`
+	At          time.Time         `json:"at"`
+}
+
+type searchLogFilters struct {
+	CategoryID  *uint64    `json:"category_id,omitempty"`
+	MinDuration *uint32    `json:"min_duration,omitempty"`
+	MaxDuration *uint32    `json:"max_duration,omitempty"`
+	DateFrom    *time.Time `json:"date_from,omitempty"`
+	DateTo      *time.Time `json:"date_to,omitempty"`
+	SortBy      string     `json:"sort_by,omitempty"`
+	AccessType  string     `json:"access_type,omitempty"`
+	Page        int32      `json:"page,omitempty"`
+	PageSize    int32      `json:"page_size,omitempty"`
+}
+
+type searchRollupKey struct {
+	day   time.Time
+	query string
+}
+
+// flushSearchLog drains up to searchLogBatchSize events from search:log,
+// writes raw rows to search_logs and folds them into search_query_dailies,
+// all in one transaction.
+//
+// The queue is only trimmed after commit. Replaying a batch is harmless:
+// duplicate search IDs are skipped and a click only counts once per search,
+// and the daily counters are bumped only for rows actually written.
+func flushSearchLog(ctx context.Context, d *Data, l *log.Helper) {
+	raw, err := d.Redis.LRange(ctx, searchLogQueue, 0, searchLogBatchSize-1).Result()
+	if err != nil || len(raw) == 0 {
+		return
+	}
+
+	rollups := make(map[searchRollupKey]*model.SearchQueryDaily)
+	rollup := func(at time.Time, query string) *model.SearchQueryDaily {
+		day := time.Date(at.Year(), at.Month(), at.Day(), 0, 0, 0, 0, at.Location())
+		key := searchRollupKey{day: day, query: query}
+		r, ok := rollups[key]
+		if !ok {
+			r = &model.SearchQueryDaily{Day: day, Query: query}
+			rollups[key] = r
+		}
+		return r
+	}
+
+	err = d.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, item := range raw {
+			var ev searchLogEvent
+			if err := json.Unmarshal([]byte(item), &ev); err != nil || ev.SearchID == "" {
+				continue // malformed, drop
+			}
+
+			switch ev.Type {
+			case searchEventSearch:
+				query := truncateRunes(ev.Query, searchQueryMaxRunes)
+				filters, _ := json.Marshal(ev.Filters)
+				row := model.SearchLog{
+					SearchID:    ev.SearchID,
+					Query:       query,
+					Filters:     filters,
+					ResultCount: ev.ResultCount,
+					LatencyMs:   ev.LatencyMs,
+					UserID:      ev.UserID,
+					SessionID:   ev.SessionID,
+					CreatedAt:   ev.At,
+				}
+				res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&row)
+				if res.Error != nil {
+					return res.Error
+				}
+				if res.RowsAffected == 0 {
+					continue // already flushed
+				}
+				r := rollup(ev.At, query)
+				r.Searches++
+				if ev.ResultCount == 0 {
+					r.ZeroResults++
+				}
+				r.TotalLatencyMs += uint64(ev.LatencyMs)
+
+			case searchEventClick:
+				var sl model.SearchLog
+				if err := tx.Where("search_id = ?", ev.SearchID).First(&sl).Error; err != nil {
+					continue // unknown search, drop
+				}
+				// Only the first click of a search counts towards CTR.
+				res := tx.Model(&model.SearchLog{}).
+					Where("id = ? AND clicked_video_id IS NULL", sl.ID).
+					Updates(map[string]interface{}{
+						"clicked_video_id": ev.VideoID,
+						"click_position":   ev.Position,
+						"clicked_at":       ev.At,
+					})
+				if res.Error != nil {
+					return res.Error
+				}
+				if res.RowsAffected == 1 {
+					rollup(sl.CreatedAt, sl.Query).ClickedSearches++
+				}
+			}
+		}
+
+		for _, r := range rollups {
+			if err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "day"}, {Name: "query"}},
+				DoUpdates: clause.Assignments(map[string]interface{}{
+					"searches":         gorm.Expr("searches + ?", r.Searches),
+					"zero_results":     gorm.Expr("zero_results + ?", r.ZeroResults),
+					"clicked_searches": gorm.Expr("clicked_searches + ?", r.ClickedSearches),
+					"total_latency_ms": gorm.Expr("total_latency_ms + ?", r.TotalLatencyMs),
+					"updated_at":       time.Now(),
+				}),
+			}).Create(r).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	if err != nil {
+		l.Warnf("search log flush failed (will retry): %v", err)
+		return
+	}
+
+	// Only trim the queue after a successful MySQL flush
+	d.Redis.LTrim(ctx, searchLogQueue, int64(len(raw)), -1)
+	l.Debugf("flushed %d search log events to MySQL", len(raw))
+}
+
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
@@ -0,0 +1,67 @@
+package data
+
+import (
+	"context"
+	"errors"
+
+	"backend/internal/biz"
+	"backend/internal/data/model"
+
+	"gorm.io/gorm"
+)
+
+func (r *videoRepo) CreateShare(ctx context.Context, share *biz.VideoShare) (*biz.VideoShare, error) {
+	m := &model.VideoShare{
+		VideoID:   share.VideoID,
+		Token:     share.Token,
+		ExpiresAt: share.ExpiresAt,
+	}
+	if err := r.data.DB.WithContext(ctx).Create(m).Error; err != nil {
+		return nil, err
+	}
+	return toBizVideoShare(m), nil
+}
+
+func (r *videoRepo) ListShares(ctx context.Context, videoID uint64) ([]*biz.VideoShare, error) {
+	var rows []model.VideoShare
+	if err := r.data.DB.WithContext(ctx).
+		Where("video_id = ?", videoID).
+		Order("id DESC").
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	shares := make([]*biz.VideoShare, len(rows))
+	for i := range rows {
+		shares[i] = toBizVideoShare(&rows[i])
+	}
+	return shares, nil
+}
+
+func (r *videoRepo) FindShareByToken(ctx context.Context, token string) (*biz.VideoShare, error) {
+	var m model.VideoShare
+	err := r.data.DB.WithContext(ctx).Where("token = ?", token).First(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toBizVideoShare(&m), nil
+}
+
+func (r *videoRepo) DeleteShare(ctx context.Context, videoID, shareID uint64) (bool, error) {
+	res := r.data.DB.WithContext(ctx).
+		Where("id = ? AND video_id = ?", shareID, videoID).
+		Delete(&model.VideoShare{})
+	return res.RowsAffected > 0, res.Error
+}
+
+func toBizVideoShare(m *model.VideoShare) *biz.VideoShare {
+	return &biz.VideoShare{
+		ID:        m.ID,
+		VideoID:   m.VideoID,
+		Token:     m.Token,
+		ExpiresAt: m.ExpiresAt,
+		CreatedAt: m.CreatedAt,
+	}
+}
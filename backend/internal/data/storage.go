@@ -0,0 +1,317 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"backend/internal/biz"
+	"backend/internal/data/model"
+	"backend/internal/pkg/upload"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+// purgeTimeout bounds deleting the files of deleted videos, which may
+// include thousands of HLS segments.
+const purgeTimeout = 10 * time.Minute
+
+// Every file derived from a video lives under a prefix computed from its
+// source object "videos/{name}.mp4" or its ID:
+//
+//	videos/{name}/hls/...          renditions and the seek-preview storyboard
+//	thumbnails/{name}/...          extracted thumbnail candidates
+//	captions/{id}/{language}.vtt   caption tracks
+
+// mediaDir is the prefix of the renditions of a source object.
+func mediaDir(object string) string {
+	return strings.TrimSuffix(object, path.Ext(object)) + "/"
+}
+
+// candidateDir is the prefix of the thumbnail candidates of a source object,
+// inside the publicly readable thumbnails/ prefix.
+func candidateDir(object string) string {
+	return "thumbnails/" + strings.TrimSuffix(path.Base(object), path.Ext(object)) + "/"
+}
+
+func captionDir(videoID uint64) string {
+	return fmt.Sprintf("captions/%d/", videoID)
+}
+
+type storageRepo struct {
+	data     *Data
+	uploader *upload.MinIOUploader
+	log      *log.Helper
+}
+
+func NewStorageRepo(data *Data, uploader *upload.MinIOUploader, logger log.Logger) biz.StorageRepo {
+	return &storageRepo{
+		data:     data,
+		uploader: uploader,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// references are the objects and prefixes still in use, mapped to the
+// video using them (0 for avatars).
+type references struct {
+	objects  map[string]uint64
+	prefixes map[string]uint64
+}
+
+// owner reports whether name is in use, and by which video.
+func (refs *references) owner(name string) (uint64, bool) {
+	if id, ok := refs.objects[name]; ok {
+		return id, true
+	}
+	for i := 0; i < len(name); i++ {
+		if name[i] != '/' {
+			continue
+		}
+		if id, ok := refs.prefixes[name[:i+1]]; ok {
+			return id, true
+		}
+	}
+	return 0, false
+}
+
+// loadReferences collects what videos, live or in the trash, other than
+// except (0 for none), and users use. Captions and candidates are covered
+// by their videos' prefixes; revisions keep their thumbnails.
+func loadReferences(ctx context.Context, db *gorm.DB, uploader *upload.MinIOUploader, except uint64) (*references, error) {
+	refs := &references{objects: map[string]uint64{}, prefixes: map[string]uint64{}}
+	add := func(url *string, videoID uint64) {
+		if url == nil {
+			return
+		}
+		if object, ok := uploader.ObjectName(*url); ok {
+			refs.objects[object] = videoID
+		}
+	}
+
+	var videos []model.Video
+	err := db.WithContext(ctx).Unscoped().
+		Where("id <> ?", except).
+		Select("id", "video_url", "pending_video_url", "thumbnail_url", "thumbnail_small_url", "thumbnail_medium_url", "thumbnail_large_url").
+		FindInBatches(&videos, 1000, func(*gorm.DB, int) error {
+			for _, v := range videos {
+				for _, url := range []string{v.VideoURL, derefString(v.PendingVideoURL)} {
+					if object, ok := uploader.ObjectName(url); ok {
+						refs.objects[object] = v.ID
+						refs.prefixes[mediaDir(object)] = v.ID
+						refs.prefixes[candidateDir(object)] = v.ID
+					}
+				}
+				add(v.ThumbnailURL, v.ID)
+				add(v.ThumbnailSmallURL, v.ID)
+				add(v.ThumbnailMediumURL, v.ID)
+				add(v.ThumbnailLargeURL, v.ID)
+				refs.prefixes[captionDir(v.ID)] = v.ID
+			}
+			return nil
+		}).Error
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the thumbnails of past revisions so they can be restored.
+	var revisions []model.VideoRevision
+	if err := db.WithContext(ctx).Model(&model.VideoRevision{}).
+		Select("video_revisions.video_id", "video_revisions.thumbnail_url").
+		Where("video_revisions.thumbnail_url IS NOT NULL AND video_revisions.video_id <> ?", except).
+		Find(&revisions).Error; err != nil {
+		return nil, err
+	}
+	for _, rev := range revisions {
+		add(rev.ThumbnailURL, rev.VideoID)
+	}
+
+	var avatars []*string
+	if err := db.WithContext(ctx).Model(&model.User{}).
+		Where("avatar_url IS NOT NULL").
+		Pluck("avatar_url", &avatars).Error; err != nil {
+		return nil, err
+	}
+	for _, a := range avatars {
+		if object, ok := uploader.ObjectName(derefString(a)); ok {
+			if _, used := refs.objects[object]; !used {
+				refs.objects[object] = 0
+			}
+		}
+	}
+	return refs, nil
+}
+
+func (r *storageRepo) ScanObjects(ctx context.Context, fn func(*biz.StoredObject) error) error {
+	refs, err := loadReferences(ctx, r.data.DB, r.uploader, 0)
+	if err != nil {
+		return err
+	}
+	return r.uploader.Walk(ctx, "", func(obj upload.ListedObject) error {
+		videoID, referenced := refs.owner(obj.Name)
+		return fn(&biz.StoredObject{
+			Name:         obj.Name,
+			Size:         obj.Size,
+			LastModified: obj.LastModified,
+			Referenced:   referenced,
+			VideoID:      videoID,
+		})
+	})
+}
+
+func (r *storageRepo) DeleteObjects(ctx context.Context, names []string) error {
+	if err := r.uploader.DeleteMany(ctx, names); err != nil {
+		return err
+	}
+	return markUploads(ctx, r.data.DB, names, biz.UploadDeleted)
+}
+
+func (r *storageRepo) AttachUploads(ctx context.Context, names []string) error {
+	return r.data.DB.WithContext(ctx).Model(&model.Upload{}).
+		Where("object_name IN ? AND status = ?", names, biz.UploadPending).
+		Update("status", biz.UploadAttached).Error
+}
+
+// SetStorageSizes writes the tallied sizes, those of videos in the trash
+// included, and zeroes those of videos with nothing left in the bucket.
+func (r *storageRepo) SetStorageSizes(ctx context.Context, sizes map[uint64]int64) error {
+	var stale []uint64
+	if err := r.data.DB.WithContext(ctx).Model(&model.Video{}).Unscoped().
+		Where("storage_size > 0").
+		Pluck("id", &stale).Error; err != nil {
+		return err
+	}
+	for _, id := range stale {
+		if _, ok := sizes[id]; !ok {
+			sizes[id] = 0
+		}
+	}
+	for id, size := range sizes {
+		if err := r.data.DB.WithContext(ctx).Model(&model.Video{}).Unscoped().
+			Where("id = ? AND storage_size <> ?", id, size).
+			UpdateColumn("storage_size", size).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ownsUpload reports whether object is an upload of userID's.
+func ownsUpload(ctx context.Context, db *gorm.DB, userID uint64, object string) (bool, error) {
+	var n int64
+	err := db.WithContext(ctx).Model(&model.Upload{}).
+		Where("object_name = ? AND user_id = ?", object, userID).
+		Count(&n).Error
+	return n > 0, err
+}
+
+func markUploads(ctx context.Context, db *gorm.DB, names []string, status string) error {
+	return db.WithContext(ctx).Model(&model.Upload{}).
+		Where("object_name IN ?", names).
+		Update("status", status).Error
+}
+
+// purgeVideoMedia deletes the stored files of deleted videos in the
+// background, since a request cannot wait for them. Files another live
+// video still uses are kept; whatever fails here is left to the
+// reconciler.
+func purgeVideoMedia(d *Data, uploader *upload.MinIOUploader, l *log.Helper, videos []model.Video) {
+	if len(videos) == 0 {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), purgeTimeout)
+		defer cancel()
+		for i := range videos {
+			if err := purgeVideo(ctx, d.DB, uploader, &videos[i]); err != nil {
+				l.Warnf("purge media of video %d: %v", videos[i].ID, err)
+			}
+		}
+	}()
+}
+
+// purgeSourceMedia deletes a replaced source and the renditions made from
+// it in the background, unless another video still uses it. Its
+// thumbnail candidates are left to the reconciler, which keeps those the
+// video's thumbnail or revisions still use.
+func purgeSourceMedia(d *Data, uploader *upload.MinIOUploader, l *log.Helper, videoURL string) {
+	object, ok := uploader.ObjectName(videoURL)
+	if !ok {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), purgeTimeout)
+		defer cancel()
+		var n int64
+		if err := d.DB.WithContext(ctx).Model(&model.Video{}).Unscoped().
+			Where("video_url = ? OR pending_video_url = ?", videoURL, videoURL).
+			Count(&n).Error; err != nil || n > 0 {
+			return
+		}
+		if err := uploader.DeletePrefix(ctx, mediaDir(object)); err != nil {
+			l.Warnf("purge replaced source %s: %v", object, err)
+			return
+		}
+		if err := uploader.DeleteMany(ctx, []string{object}); err != nil {
+			l.Warnf("purge replaced source %s: %v", object, err)
+			return
+		}
+		if err := markUploads(ctx, d.DB, []string{object}, biz.UploadDeleted); err != nil {
+			l.Warnf("purge replaced source %s: %v", object, err)
+		}
+	}()
+}
+
+func purgeVideo(ctx context.Context, db *gorm.DB, uploader *upload.MinIOUploader, v *model.Video) error {
+	// Other videos, including those in the trash, their revisions and
+	// avatars may share a source or thumbnail.
+	refs, err := loadReferences(ctx, db, uploader, v.ID)
+	if err != nil {
+		return err
+	}
+
+	var objects, prefixes []string
+	// A replacement still being processed has files of its own.
+	for _, url := range []string{v.VideoURL, derefString(v.PendingVideoURL)} {
+		if object, ok := uploader.ObjectName(url); ok {
+			if _, used := refs.owner(object); !used {
+				objects = append(objects, object)
+				prefixes = append(prefixes, mediaDir(object), candidateDir(object))
+			}
+		}
+	}
+	// Only a thumbnail the owner uploaded is the video's to delete, with
+	// its variants; candidate frames go with the source's prefixes.
+	if object, ok := uploader.ObjectName(derefString(v.ThumbnailURL)); ok {
+		owned, err := ownsUpload(ctx, db, v.UserID, object)
+		if err != nil {
+			return err
+		}
+		if _, used := refs.owner(object); owned && !used {
+			for _, url := range []*string{v.ThumbnailURL, v.ThumbnailSmallURL, v.ThumbnailMediumURL, v.ThumbnailLargeURL} {
+				if object, ok := uploader.ObjectName(derefString(url)); ok {
+					if _, used := refs.owner(object); !used {
+						objects = append(objects, object)
+					}
+				}
+			}
+		}
+	}
+	prefixes = append(prefixes, captionDir(v.ID))
+
+	for _, p := range prefixes {
+		if err := uploader.DeletePrefix(ctx, p); err != nil {
+			return err
+		}
+	}
+	if len(objects) == 0 {
+		return nil
+	}
+	if err := uploader.DeleteMany(ctx, objects); err != nil {
+		return err
+	}
+	return markUploads(ctx, db, objects, biz.UploadDeleted)
+}
@@ -0,0 +1,174 @@
+package data
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"backend/internal/biz"
+	"backend/internal/conf"
+	"backend/internal/pkg/media"
+	"backend/internal/pkg/upload"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	thumbnailTimeout       = 2 * time.Minute
+	defaultPreviewInterval = 5 * time.Second
+)
+
+type thumbnailer struct {
+	ffmpeg          *media.FFmpeg
+	uploader        *upload.MinIOUploader
+	previewInterval time.Duration
+	previewTimeout  time.Duration
+	log             *log.Helper
+}
+
+// NewThumbnailer returns nil (no generated thumbnails) when no ffmpeg
+// binary is configured.
+func NewThumbnailer(c *conf.Media, uploader *upload.MinIOUploader, logger log.Logger) biz.Thumbnailer {
+	if c == nil || c.FfmpegPath == "" {
+		return nil
+	}
+	interval := c.PreviewInterval.AsDuration()
+	if interval <= 0 {
+		interval = defaultPreviewInterval
+	}
+	// Decoding every frame of the source takes about as long as encoding
+	// one rendition.
+	timeout := c.TranscodeTimeout.AsDuration()
+	if timeout <= 0 {
+		timeout = defaultTranscodeTimeout
+	}
+	return &thumbnailer{
+		ffmpeg:          media.NewFFmpeg(c.FfmpegPath),
+		uploader:        uploader,
+		previewInterval: interval,
+		previewTimeout:  timeout,
+		log:             log.NewHelper(logger),
+	}
+}
+
+// ExtractCandidates stores frames as
+// "thumbnails/{source name}/candidate_{position}.jpg", inside the publicly
+// readable thumbnails/ prefix.
+func (t *thumbnailer) ExtractCandidates(ctx context.Context, video *biz.Video, positions []uint32) ([]string, error) {
+	object, ok := t.uploader.ObjectName(video.VideoURL)
+	if !ok {
+		return nil, biz.ErrVideoSourceInvalid
+	}
+	ctx, cancel := context.WithTimeout(ctx, thumbnailTimeout)
+	defer cancel()
+
+	src, err := t.uploader.PresignGet(ctx, object, thumbnailTimeout)
+	if err != nil {
+		return nil, err
+	}
+	tmp, err := os.MkdirTemp("", "thumbnails-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	dir := candidateDir(object)
+	urls := make([]string, len(positions))
+	for i, pos := range positions {
+		name := fmt.Sprintf("candidate_%d.jpg", pos)
+		file := filepath.Join(tmp, name)
+		at := float64(video.Duration) * float64(pos) / 100
+		if err := t.ffmpeg.ExtractFrame(ctx, src, at, file); err != nil {
+			return nil, fmt.Errorf("frame at %d%%: %w", pos, err)
+		}
+		if err := t.uploader.PutFile(ctx, dir+name, file, "image/jpeg"); err != nil {
+			return nil, err
+		}
+		urls[i] = t.uploader.GetURL(dir + name)
+	}
+	return urls, nil
+}
+
+// MakeVariants stores "{thumbnail without extension}_{size}.webp" next to
+// the thumbnail.
+func (t *thumbnailer) MakeVariants(ctx context.Context, thumbnailURL string) (*biz.ThumbnailVariants, error) {
+	object, ok := t.uploader.ObjectName(thumbnailURL)
+	if !ok || !strings.HasPrefix(object, "thumbnails/") {
+		return nil, biz.ErrThumbnailInvalid
+	}
+	ctx, cancel := context.WithTimeout(ctx, thumbnailTimeout)
+	defer cancel()
+
+	src, err := t.uploader.PresignGet(ctx, object, thumbnailTimeout)
+	if err != nil {
+		return nil, err
+	}
+	tmp, err := os.MkdirTemp("", "thumbnails-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmp)
+
+	base := strings.TrimSuffix(object, path.Ext(object))
+	urls := make(map[string]string, len(media.ThumbnailVariants))
+	for _, v := range media.ThumbnailVariants {
+		file := filepath.Join(tmp, v.Name+".webp")
+		if err := t.ffmpeg.ResizeWebP(ctx, src, v.Width, file); err != nil {
+			return nil, fmt.Errorf("%s variant: %w", v.Name, err)
+		}
+		name := base + "_" + v.Name + ".webp"
+		if err := t.uploader.PutFile(ctx, name, file, "image/webp"); err != nil {
+			return nil, err
+		}
+		urls[v.Name] = t.uploader.GetURL(name)
+	}
+	return &biz.ThumbnailVariants{
+		Small:  urls["small"],
+		Medium: urls["medium"],
+		Large:  urls["large"],
+	}, nil
+}
+
+// SeekPreview stores sprite sheets and their WebVTT track under
+// "{hls dir}/storyboard/", so a signed HLS URL for the track also covers
+// the sheets it references relatively.
+func (t *thumbnailer) SeekPreview(ctx context.Context, video *biz.Video) (string, error) {
+	object, ok := t.uploader.ObjectName(video.VideoURL)
+	if !ok {
+		return "", biz.ErrVideoSourceInvalid
+	}
+	ctx, cancel := context.WithTimeout(ctx, t.previewTimeout)
+	defer cancel()
+
+	src, err := t.uploader.PresignGet(ctx, object, t.previewTimeout)
+	if err != nil {
+		return "", err
+	}
+	tmp, err := os.MkdirTemp("", "storyboard-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+
+	layout := media.NewSpriteLayout(video.Width, video.Height, t.previewInterval)
+	sheets, err := t.ffmpeg.SpriteSheets(ctx, src, tmp, layout)
+	if err != nil {
+		return "", err
+	}
+	if err := media.WriteThumbnailsVTT(filepath.Join(tmp, "thumbnails.vtt"), float64(video.Duration), layout, sheets); err != nil {
+		return "", err
+	}
+
+	dir := hlsDir(object) + "storyboard/"
+	// The track goes last, so a stored track only names stored sheets.
+	for _, name := range append(sheets, "thumbnails.vtt") {
+		if err := t.uploader.PutFile(ctx, dir+name, filepath.Join(tmp, name), media.ContentType(name)); err != nil {
+			return "", err
+		}
+	}
+	return t.uploader.GetURL(dir + "thumbnails.vtt"), nil
+}
@@ -0,0 +1,222 @@
+package data
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"backend/internal/biz"
+	"backend/internal/conf"
+	"backend/internal/pkg/media"
+	"backend/internal/pkg/upload"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/nats-io/nats.go"
+)
+
+// Transcode jobs go through a JetStream work queue rather than plain NATS
+// pub/sub, so jobs published while no worker is running (or whose worker
+// dies mid-encode) are not lost.
+const (
+	transcodeStream     = "TRANSCODE"
+	transcodeSubject    = "video.transcode"
+	transcodeConsumer   = "transcoder"
+	transcodeAckWait    = time.Minute // extended by heartbeats while encoding
+	transcodeMaxDeliver = 3
+	transcodeFetchWait  = 5 * time.Second
+
+	defaultTranscodeTimeout = 2 * time.Hour
+)
+
+type transcodeQueue struct {
+	js  nats.JetStreamContext
+	log *log.Helper
+}
+
+// NewTranscodeQueue returns nil (transcoding disabled) without NATS or when
+// the server has no JetStream.
+func NewTranscodeQueue(d *Data, logger log.Logger) biz.TranscodeQueue {
+	l := log.NewHelper(logger)
+	if d.NATS == nil {
+		l.Warn("transcoding disabled: NATS not available")
+		return nil
+	}
+	js, err := d.NATS.JetStream()
+	if err == nil {
+		_, err = js.StreamInfo(transcodeStream)
+		if errors.Is(err, nats.ErrStreamNotFound) {
+			_, err = js.AddStream(&nats.StreamConfig{
+				Name:      transcodeStream,
+				Subjects:  []string{transcodeSubject},
+				Retention: nats.WorkQueuePolicy,
+				Storage:   nats.FileStorage,
+			})
+		}
+	}
+	if err != nil {
+		l.Warnf("transcoding disabled: JetStream not available: %v", err)
+		return nil
+	}
+	return &transcodeQueue{js: js, log: l}
+}
+
+func (q *transcodeQueue) Enqueue(ctx context.Context, job *biz.TranscodeJob) error {
+	raw, err := json.Marshal(job)
+	if err != nil {
+		return err
+	}
+	_, err = q.js.Publish(transcodeSubject, raw, nats.Context(ctx))
+	return err
+}
+
+func (q *transcodeQueue) Consume(ctx context.Context, handle func(context.Context, *biz.TranscodeJob)) error {
+	sub, err := q.js.PullSubscribe(transcodeSubject, transcodeConsumer,
+		nats.BindStream(transcodeStream),
+		nats.AckExplicit(),
+		nats.AckWait(transcodeAckWait),
+		nats.MaxDeliver(transcodeMaxDeliver),
+	)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	for ctx.Err() == nil {
+		fetchCtx, cancel := context.WithTimeout(ctx, transcodeFetchWait)
+		msgs, err := sub.Fetch(1, nats.Context(fetchCtx))
+		cancel()
+		if err != nil {
+			if !errors.Is(err, context.DeadlineExceeded) && !errors.Is(err, nats.ErrTimeout) && ctx.Err() == nil {
+				q.log.Warnf("fetch transcode job: %v", err)
+				time.Sleep(transcodeFetchWait)
+			}
+			continue
+		}
+		for _, msg := range msgs {
+			q.deliver(ctx, msg, handle)
+		}
+	}
+	return nil
+}
+
+// deliver runs handle for one message, telling JetStream the job is still
+// in progress so a long encode is not redelivered to another worker.
+func (q *transcodeQueue) deliver(ctx context.Context, msg *nats.Msg, handle func(context.Context, *biz.TranscodeJob)) {
+	var job biz.TranscodeJob
+	if err := json.Unmarshal(msg.Data, &job); err != nil {
+		q.log.Warnf("drop malformed transcode job: %v", err)
+		_ = msg.Term()
+		return
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(transcodeAckWait / 2)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				_ = msg.InProgress()
+			}
+		}
+	}()
+	handle(ctx, &job)
+	close(done)
+
+	if ctx.Err() != nil {
+		return // shutting down mid-job: leave it for redelivery
+	}
+	if err := msg.Ack(); err != nil {
+		q.log.Warnf("ack transcode job for video %d: %v", job.VideoID, err)
+	}
+}
+
+// hlsDir is where the HLS output of a source object is stored, e.g.
+// "videos/20240131-<uuid>/hls/" for "videos/20240131-<uuid>.mp4".
+func hlsDir(object string) string {
+	return mediaDir(object) + "hls/"
+}
+
+type hlsTranscoder struct {
+	ffmpeg   *media.FFmpeg
+	uploader *upload.MinIOUploader
+	timeout  time.Duration
+	log      *log.Helper
+}
+
+// NewTranscoder returns nil (this instance does not transcode) when no
+// ffmpeg binary is configured.
+func NewTranscoder(c *conf.Media, uploader *upload.MinIOUploader, logger log.Logger) biz.Transcoder {
+	if c == nil || c.FfmpegPath == "" {
+		log.NewHelper(logger).Warn("transcoding disabled: no ffmpeg_path configured")
+		return nil
+	}
+	timeout := c.TranscodeTimeout.AsDuration()
+	if timeout <= 0 {
+		timeout = defaultTranscodeTimeout
+	}
+	return &hlsTranscoder{
+		ffmpeg:   media.NewFFmpeg(c.FfmpegPath),
+		uploader: uploader,
+		timeout:  timeout,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// Transcode encodes into a temporary directory, then uploads the result to
+// "{source without extension}/hls/", e.g. videos/20240131-<uuid>/hls/.
+// The master playlist is uploaded last, so a stored master always refers
+// to complete renditions.
+func (t *hlsTranscoder) Transcode(ctx context.Context, video *biz.Video, progress func(percent uint32)) (string, error) {
+	object, ok := t.uploader.ObjectName(video.VideoURL)
+	if !ok {
+		return "", biz.ErrVideoSourceInvalid
+	}
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	src, err := t.uploader.PresignGet(ctx, object, t.timeout)
+	if err != nil {
+		return "", err
+	}
+	tmp, err := os.MkdirTemp("", "transcode-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.RemoveAll(tmp)
+
+	info := &media.Info{DurationSec: float64(video.Duration), Width: video.Width, Height: video.Height}
+	renditions := media.SelectRenditions(media.DefaultRenditions, video.Height)
+	err = t.ffmpeg.TranscodeHLS(ctx, src, tmp, info, renditions, func(done float64) {
+		// Leave the last percent for uploading the result.
+		progress(uint32(done * 99))
+	})
+	if err != nil {
+		return "", err
+	}
+
+	prefix := hlsDir(object)
+	err = filepath.WalkDir(tmp, func(p string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() || d.Name() == media.MasterPlaylist {
+			return err
+		}
+		rel, err := filepath.Rel(tmp, p)
+		if err != nil {
+			return err
+		}
+		return t.uploader.PutFile(ctx, prefix+filepath.ToSlash(rel), p, media.ContentType(p))
+	})
+	if err != nil {
+		return "", err
+	}
+	master := prefix + media.MasterPlaylist
+	if err := t.uploader.PutFile(ctx, master, filepath.Join(tmp, media.MasterPlaylist), media.ContentType(master)); err != nil {
+		return "", err
+	}
+	return t.uploader.GetURL(master), nil
+}
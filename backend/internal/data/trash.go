@@ -0,0 +1,149 @@
+package data
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"backend/internal/biz"
+	"backend/internal/data/model"
+	"backend/internal/pkg/upload"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+)
+
+type trashRepo struct {
+	data     *Data
+	videos   *videoRepo
+	uploader *upload.MinIOUploader
+	log      *log.Helper
+}
+
+func NewTrashRepo(data *Data, cache *VideoCache, uploader *upload.MinIOUploader, logger log.Logger) biz.TrashRepo {
+	l := log.NewHelper(logger)
+	return &trashRepo{
+		data:     data,
+		videos:   &videoRepo{data: data, cache: cache, uploader: uploader, log: l},
+		uploader: uploader,
+		log:      l,
+	}
+}
+
+// deletedVideos scopes queries to the videos in the trash.
+func deletedVideos(db *gorm.DB) *gorm.DB {
+	return db.Unscoped().Where("videos.deleted_at IS NOT NULL")
+}
+
+func (r *trashRepo) ListDeleted(ctx context.Context, userID uint64, offset, limit int) ([]*biz.DeletedVideo, int64, error) {
+	db := r.data.DB.WithContext(ctx).Model(&model.Video{}).Scopes(deletedVideos)
+	if userID != 0 {
+		db = db.Where("videos.user_id = ?", userID)
+	}
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var videos []model.Video
+	if err := db.Preload("User").Preload("Category").Preload("Tags").
+		Order("videos.deleted_at DESC").
+		Offset(offset).Limit(limit).
+		Find(&videos).Error; err != nil {
+		return nil, 0, err
+	}
+	result := make([]*biz.DeletedVideo, len(videos))
+	for i := range videos {
+		result[i] = toBizDeletedVideo(&videos[i])
+	}
+	return result, total, nil
+}
+
+func (r *trashRepo) FindDeleted(ctx context.Context, id uint64) (*biz.DeletedVideo, error) {
+	var video model.Video
+	err := r.data.DB.WithContext(ctx).Scopes(deletedVideos).
+		Preload("User").Preload("Category").Preload("Tags").
+		First(&video, id).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toBizDeletedVideo(&video), nil
+}
+
+func (r *trashRepo) Restore(ctx context.Context, id uint64) error {
+	// A schedule that lapsed in the trash is dropped rather than firing
+	// the moment the video is back.
+	if err := r.data.DB.WithContext(ctx).Model(&model.Video{}).Scopes(deletedVideos).
+		Where("id = ?", id).
+		Updates(map[string]interface{}{
+			"deleted_at": nil,
+			"publish_at": gorm.Expr("CASE WHEN publish_at <= ? THEN NULL ELSE publish_at END", time.Now()),
+		}).Error; err != nil {
+		return err
+	}
+	r.videos.syncCache(ctx, id)
+	return nil
+}
+
+func (r *trashRepo) ListExpired(ctx context.Context, cutoff time.Time, limit int) ([]uint64, error) {
+	var ids []uint64
+	err := r.data.DB.WithContext(ctx).Model(&model.Video{}).Scopes(deletedVideos).
+		Where("videos.deleted_at < ?", cutoff).
+		Order("videos.deleted_at").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	return ids, err
+}
+
+func (r *trashRepo) Purge(ctx context.Context, id uint64) error {
+	var video model.Video
+	if err := r.data.DB.WithContext(ctx).Scopes(deletedVideos).First(&video, id).Error; err != nil {
+		return err
+	}
+	if err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Donations are payment records: keep them, detached from the video.
+		if err := tx.Model(&model.Donation{}).Where("video_id = ?", id).
+			Update("video_id", nil).Error; err != nil {
+			return err
+		}
+		return deleteVideoRecords(tx, id)
+	}); err != nil {
+		return err
+	}
+	if err := purgeVideo(ctx, r.data.DB, r.uploader, &video); err != nil {
+		r.log.Warnf("purge media of video %d: %v", id, err)
+	}
+	return nil
+}
+
+// deleteVideoRecords hard-deletes a video and the rows that belong to it.
+func deleteVideoRecords(tx *gorm.DB, id uint64) error {
+	if err := tx.Exec("DELETE FROM video_tags WHERE video_id = ?", id).Error; err != nil {
+		return err
+	}
+	for _, m := range []interface{}{
+		&model.ViewRecord{},
+		&model.Donation{},
+		&model.VideoCaption{},
+		&model.VideoChapter{},
+		&model.VideoThumbnailCandidate{},
+		&model.VideoShare{},
+		&model.VideoRevision{},
+	} {
+		if err := tx.Where("video_id = ?", id).Delete(m).Error; err != nil {
+			return err
+		}
+	}
+	return tx.Unscoped().Delete(&model.Video{}, id).Error
+}
+
+func toBizDeletedVideo(m *model.Video) *biz.DeletedVideo {
+	return &biz.DeletedVideo{
+		Video:        toBizVideo(m),
+		DeletedAt:    m.DeletedAt.Time,
+		OwnerDeleted: m.User.ID == 0,
+	}
+}
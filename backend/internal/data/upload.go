@@ -0,0 +1,466 @@
+package data
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"backend/internal/biz"
+	"backend/internal/data/model"
+	"backend/internal/pkg/upload"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"github.com/minio/minio-go/v7"
+	"github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	tusKeyPrefix  = "upload:tus:"
+	tusLockTTL    = 10 * time.Minute
+	tusMaxPartNum = 10000 // S3 multipart limit
+
+	// tusExpiryKey is a ZSET of the unfinished multipart uploads, scored by
+	// expiry, so their parts can be discarded once the state is gone.
+	tusExpiryKey = tusKeyPrefix + "expiry"
+)
+
+// tusUnlock deletes a lock only if it still holds the caller's token, so a
+// request that outlived tusLockTTL cannot release a lock taken after it.
+var tusUnlock = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+type uploadRepo struct {
+	data     *Data
+	uploader *upload.MinIOUploader
+	cache    *VideoCache
+	log      *log.Helper
+}
+
+func NewUploadRepo(data *Data, cache *VideoCache, uploader *upload.MinIOUploader, logger log.Logger) biz.UploadRepo {
+	return &uploadRepo{
+		data:     data,
+		uploader: uploader,
+		cache:    cache,
+		log:      log.NewHelper(logger),
+	}
+}
+
+// tusState is stored as JSON under upload:tus:{id}. Bytes that do not yet
+// fill a MinIO part (parts must be ≥5MB except the last) are kept in the
+// upload:tus:{id}:tail STRING, so Offset = Uploaded + len(tail).
+type tusState struct {
+	UserID      uint64
+	Length      int64
+	Uploaded    int64
+	ContentType string
+	ObjectName  string
+	URL         string
+	Metadata    map[string]string
+	MultipartID string
+	Parts       []minio.CompletePart
+	ExpiresAt   time.Time
+	Done        bool
+}
+
+func tusKey(id string) string     { return tusKeyPrefix + id }
+func tusTailKey(id string) string { return tusKeyPrefix + id + ":tail" }
+func tusLockKey(id string) string { return tusKeyPrefix + id + ":lock" }
+
+// tusExpiryMember identifies a multipart upload in tusExpiryKey.
+func tusExpiryMember(objectName, multipartID string) string {
+	return objectName + "\n" + multipartID
+}
+
+func (r *uploadRepo) CreateResumable(ctx context.Context, up *biz.ResumableUpload) error {
+	if r.data.Redis == nil {
+		return fmt.Errorf("redis not available")
+	}
+	multipartID, err := r.uploader.NewMultipart(ctx, up.ObjectName, up.ContentType)
+	if err != nil {
+		return err
+	}
+	st := &tusState{
+		UserID:      up.UserID,
+		Length:      up.Length,
+		ContentType: up.ContentType,
+		ObjectName:  up.ObjectName,
+		URL:         up.URL,
+		Metadata:    up.Metadata,
+		MultipartID: multipartID,
+		ExpiresAt:   up.ExpiresAt,
+	}
+	// Track the multipart upload before anything can lose it.
+	member := tusExpiryMember(up.ObjectName, multipartID)
+	err = r.data.Redis.ZAdd(ctx, tusExpiryKey, redis.Z{Score: float64(up.ExpiresAt.Unix()), Member: member}).Err()
+	if err == nil {
+		err = r.saveState(ctx, up.ID, st)
+	}
+	if err != nil {
+		if err := r.uploader.AbortMultipart(ctx, up.ObjectName, multipartID); err == nil {
+			r.data.Redis.ZRem(ctx, tusExpiryKey, member)
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *uploadRepo) GetResumable(ctx context.Context, id string) (*biz.ResumableUpload, error) {
+	if r.data.Redis == nil {
+		return nil, fmt.Errorf("redis not available")
+	}
+	st, err := r.loadState(ctx, id)
+	if err != nil || st == nil {
+		return nil, err
+	}
+	tailLen, err := r.data.Redis.StrLen(ctx, tusTailKey(id)).Result()
+	if err != nil {
+		return nil, err
+	}
+	return toBizResumableUpload(id, st, tailLen), nil
+}
+
+func (r *uploadRepo) AppendResumable(ctx context.Context, up *biz.ResumableUpload, body io.Reader) (*biz.ResumableUpload, error) {
+	token := uuid.NewString()
+	ok, err := r.data.Redis.SetNX(ctx, tusLockKey(up.ID), token, tusLockTTL).Result()
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, biz.ErrUploadLocked
+	}
+	defer func() {
+		// The request may have been cancelled; release the lock regardless.
+		if err := tusUnlock.Run(context.WithoutCancel(ctx), r.data.Redis, []string{tusLockKey(up.ID)}, token).Err(); err != nil {
+			r.log.Warnf("unlock upload %s: %v", up.ID, err)
+		}
+	}()
+
+	st, err := r.loadState(ctx, up.ID)
+	if err != nil {
+		return nil, err
+	}
+	if st == nil {
+		return nil, fmt.Errorf("upload %s expired", up.ID)
+	}
+	tail, err := r.data.Redis.Get(ctx, tusTailKey(up.ID)).Bytes()
+	if err != nil && err != redis.Nil {
+		return nil, err
+	}
+	// up was read before taking the lock; another append may have moved
+	// the offset since.
+	if st.Done || up.Offset != st.Uploaded+int64(len(tail)) {
+		return nil, biz.ErrUploadOffsetMismatch
+	}
+
+	// Re-chunk tail + body into parts of MinPartSize. Whatever is left
+	// below that size becomes the new tail, unless it ends the upload.
+	remaining := st.Length - st.Uploaded - int64(len(tail))
+	src := io.MultiReader(bytes.NewReader(tail), io.LimitReader(body, remaining))
+	buf := make([]byte, upload.MinPartSize)
+	var readErr error
+	for {
+		n, err := io.ReadFull(src, buf)
+		if n == 0 {
+			tail = nil
+			readErr = err
+			break
+		}
+		last := st.Uploaded+int64(n) == st.Length
+		if n < len(buf) && !last {
+			// Short read: the client sent less than a part (or dropped).
+			tail = append([]byte(nil), buf[:n]...)
+			readErr = err
+			break
+		}
+		if len(st.Parts) >= tusMaxPartNum {
+			return nil, fmt.Errorf("upload %s exceeds %d parts", up.ID, tusMaxPartNum)
+		}
+		part, err := r.uploader.PutPart(ctx, st.ObjectName, st.MultipartID, len(st.Parts)+1, bytes.NewReader(buf[:n]), int64(n))
+		if err != nil {
+			return nil, err
+		}
+		st.Parts = append(st.Parts, part)
+		st.Uploaded += int64(n)
+		// Persist after every part so a dropped connection loses at most
+		// the part in flight. This also drops the old tail, now in a part.
+		if err := r.saveState(ctx, up.ID, st); err != nil {
+			return nil, err
+		}
+		if last {
+			tail = nil
+			break
+		}
+	}
+	if readErr != nil && readErr != io.EOF && readErr != io.ErrUnexpectedEOF {
+		r.log.Warnf("upload %s: body read stopped early: %v", up.ID, readErr)
+	}
+
+	if len(tail) > 0 {
+		if err := r.data.Redis.Set(ctx, tusTailKey(up.ID), tail, time.Until(st.ExpiresAt)).Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	if st.Uploaded == st.Length && !st.Done {
+		if err := r.uploader.CompleteMultipart(ctx, st.ObjectName, st.MultipartID, st.Parts); err != nil {
+			return nil, err
+		}
+		st.Done = true
+		if err := r.saveState(ctx, up.ID, st); err != nil {
+			return nil, err
+		}
+		r.data.Redis.ZRem(ctx, tusExpiryKey, tusExpiryMember(st.ObjectName, st.MultipartID))
+	}
+	return toBizResumableUpload(up.ID, st, int64(len(tail))), nil
+}
+
+func (r *uploadRepo) DeleteResumable(ctx context.Context, up *biz.ResumableUpload) error {
+	st, err := r.loadState(ctx, up.ID)
+	if err != nil {
+		return err
+	}
+	if st != nil && !st.Done {
+		// A failed abort stays tracked and is retried once the upload expires.
+		if err := r.uploader.AbortMultipart(ctx, st.ObjectName, st.MultipartID); err != nil {
+			r.log.Warnf("abort multipart upload %s: %v", st.MultipartID, err)
+		} else {
+			r.data.Redis.ZRem(ctx, tusExpiryKey, tusExpiryMember(st.ObjectName, st.MultipartID))
+		}
+	}
+	return r.data.Redis.Del(ctx, tusKey(up.ID), tusTailKey(up.ID)).Err()
+}
+
+func (r *uploadRepo) AbortExpiredResumables(ctx context.Context, limit int) (int, error) {
+	if r.data.Redis == nil {
+		return 0, nil
+	}
+	members, err := r.data.Redis.ZRangeByScore(ctx, tusExpiryKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   fmt.Sprint(time.Now().Unix()),
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return 0, err
+	}
+	aborted := 0
+	for _, member := range members {
+		objectName, multipartID, _ := strings.Cut(member, "\n")
+		err := r.uploader.AbortMultipart(ctx, objectName, multipartID)
+		if err != nil && minio.ToErrorResponse(err).Code != "NoSuchUpload" {
+			r.log.Warnf("abort expired multipart upload %s: %v", multipartID, err)
+			continue
+		}
+		if err := r.data.Redis.ZRem(ctx, tusExpiryKey, member).Err(); err != nil {
+			return aborted, err
+		}
+		aborted++
+	}
+	return aborted, nil
+}
+
+func (r *uploadRepo) loadState(ctx context.Context, id string) (*tusState, error) {
+	raw, err := r.data.Redis.Get(ctx, tusKey(id)).Bytes()
+	if err == redis.Nil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var st tusState
+	if err := json.Unmarshal(raw, &st); err != nil {
+		return nil, err
+	}
+	return &st, nil
+}
+
+// saveState writes st and deletes the tail in one transaction. Callers
+// only save once any previous tail has been folded into st, so a crash
+// before the new tail is written just makes the client resend those bytes.
+func (r *uploadRepo) saveState(ctx context.Context, id string, st *tusState) error {
+	ttl := time.Until(st.ExpiresAt)
+	if ttl <= 0 {
+		return errors.New("upload expired")
+	}
+	raw, err := json.Marshal(st)
+	if err != nil {
+		return err
+	}
+	_, err = r.data.Redis.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, tusKey(id), raw, ttl)
+		pipe.Del(ctx, tusTailKey(id))
+		return nil
+	})
+	return err
+}
+
+func toBizResumableUpload(id string, st *tusState, tailLen int64) *biz.ResumableUpload {
+	return &biz.ResumableUpload{
+		ID:          id,
+		UserID:      st.UserID,
+		Length:      st.Length,
+		Offset:      st.Uploaded + tailLen,
+		ContentType: st.ContentType,
+		ObjectName:  st.ObjectName,
+		URL:         st.URL,
+		Metadata:    st.Metadata,
+		ExpiresAt:   st.ExpiresAt,
+		Done:        st.Done,
+	}
+}
+
+func (r *uploadRepo) CreateUpload(ctx context.Context, u *biz.Upload) (*biz.Upload, error) {
+	m := &model.Upload{
+		UserID:      u.UserID,
+		ObjectName:  u.ObjectName,
+		Kind:        u.Kind,
+		ContentType: u.ContentType,
+		Size:        u.Size,
+		SHA256:      u.SHA256,
+		Status:      u.Status,
+		DuplicateOf: u.DuplicateOf,
+	}
+	if m.Status == "" {
+		m.Status = biz.UploadPending
+	}
+	if err := r.data.DB.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(m).Error; err != nil {
+		return nil, err
+	}
+	if err := r.data.DB.WithContext(ctx).Where("object_name = ?", u.ObjectName).First(m).Error; err != nil {
+		return nil, err
+	}
+	return toBizUpload(m), nil
+}
+
+func (r *uploadRepo) GetUpload(ctx context.Context, objectName string) (*biz.Upload, error) {
+	var m model.Upload
+	err := r.data.DB.WithContext(ctx).Where("object_name = ?", objectName).First(&m).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toBizUpload(&m), nil
+}
+
+func (r *uploadRepo) SetUploadHash(ctx context.Context, objectName, sha256 string) error {
+	return r.data.DB.WithContext(ctx).Model(&model.Upload{}).
+		Where("object_name = ?", objectName).
+		Update("sha256", sha256).Error
+}
+
+// RejectUpload hides the videos already created from the upload (the
+// status machine has no way from ready to failed) until an admin reviews
+// them; their source is gone, so they could not play anyway.
+func (r *uploadRepo) RejectUpload(ctx context.Context, objectName, duplicateOf string) error {
+	if err := r.data.DB.WithContext(ctx).Model(&model.Upload{}).
+		Where("object_name = ?", objectName).
+		Updates(map[string]interface{}{
+			"status":       biz.UploadRejected,
+			"duplicate_of": duplicateOf,
+		}).Error; err != nil {
+		return err
+	}
+	var videos []model.Video
+	if err := r.data.DB.WithContext(ctx).
+		Preload("Tags").
+		Where("video_url = ?", r.uploader.GetURL(objectName)).
+		Find(&videos).Error; err != nil {
+		return err
+	}
+	for _, v := range videos {
+		if err := r.data.DB.WithContext(ctx).Model(&model.Video{}).
+			Where("id = ?", v.ID).
+			Update("is_hidden", true).Error; err != nil {
+			return err
+		}
+		if r.cache != nil {
+			tagIDs := make([]uint64, len(v.Tags))
+			for i, t := range v.Tags {
+				tagIDs[i] = t.ID
+			}
+			r.cache.EvictVideo(ctx, v.ID, tagIDs)
+		}
+	}
+	return nil
+}
+
+func (r *uploadRepo) FindUploadsByHash(ctx context.Context, sha256 string) ([]*biz.Upload, error) {
+	var rows []model.Upload
+	if err := r.data.DB.WithContext(ctx).
+		Where("sha256 = ? AND status IN ?", sha256, []string{biz.UploadPending, biz.UploadAttached}).
+		Order("id").
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	uploads := make([]*biz.Upload, len(rows))
+	for i := range rows {
+		uploads[i] = toBizUpload(&rows[i])
+	}
+	return uploads, nil
+}
+
+func (r *uploadRepo) FindPublishedSource(ctx context.Context, objectNames []string, userID uint64) (string, error) {
+	urls := make([]string, len(objectNames))
+	for i, name := range objectNames {
+		urls[i] = r.uploader.GetURL(name)
+	}
+	var videoURL string
+	err := r.data.DB.WithContext(ctx).Model(&model.Video{}).
+		Where("video_url IN ? AND user_id <> ? AND status = ? AND visibility = ?",
+			urls, userID, biz.VideoReady, biz.VisibilityPublished).
+		Order("id").
+		Limit(1).
+		Pluck("video_url", &videoURL).Error
+	if err != nil || videoURL == "" {
+		return "", err
+	}
+	name, _ := r.uploader.ObjectName(videoURL)
+	return name, nil
+}
+
+func (r *uploadRepo) HasSource(ctx context.Context, userID uint64, videoURL string) (bool, error) {
+	var count int64
+	err := r.data.DB.WithContext(ctx).Model(&model.Video{}).
+		Where("user_id = ? AND (video_url = ? OR pending_video_url = ?)", userID, videoURL, videoURL).
+		Count(&count).Error
+	return count > 0, err
+}
+
+// MarkDuplicate checks for videos in the same statement, so a video
+// created from objectName concurrently is not left without its source.
+func (r *uploadRepo) MarkDuplicate(ctx context.Context, objectName, duplicateOf string) (bool, error) {
+	res := r.data.DB.WithContext(ctx).Exec(
+		"UPDATE uploads SET status = ?, duplicate_of = ? WHERE object_name = ? "+
+			"AND NOT EXISTS (SELECT 1 FROM videos WHERE video_url = ?)",
+		biz.UploadDeleted, duplicateOf, objectName, r.uploader.GetURL(objectName))
+	return res.RowsAffected > 0, res.Error
+}
+
+func toBizUpload(m *model.Upload) *biz.Upload {
+	return &biz.Upload{
+		ID:          m.ID,
+		UserID:      m.UserID,
+		ObjectName:  m.ObjectName,
+		Kind:        m.Kind,
+		ContentType: m.ContentType,
+		Size:        m.Size,
+		SHA256:      m.SHA256,
+		Status:      m.Status,
+		DuplicateOf: m.DuplicateOf,
+		CreatedAt:   m.CreatedAt,
+	}
+}
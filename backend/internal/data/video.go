@@ -2,29 +2,35 @@ package data
 
 import (
 	"context"
+	"errors"
 
 	"backend/internal/biz"
 	"backend/internal/data/model"
+	"backend/internal/pkg/pagination"
+	"backend/internal/pkg/upload"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type videoRepo struct {
-	data  *Data
-	cache *VideoCache
-	log   *log.Helper
+	data     *Data
+	cache    *VideoCache
+	uploader *upload.MinIOUploader
+	log      *log.Helper
 }
 
-func NewVideoRepo(data *Data, cache *VideoCache, logger log.Logger) biz.VideoRepo {
+func NewVideoRepo(data *Data, cache *VideoCache, uploader *upload.MinIOUploader, logger log.Logger) biz.VideoRepo {
 	return &videoRepo{
-		data:  data,
-		cache: cache,
-		log:   log.NewHelper(logger),
+		data:     data,
+		cache:    cache,
+		uploader: uploader,
+		log:      log.NewHelper(logger),
 	}
 }
 
-func (r *videoRepo) Create(ctx context.Context, video *biz.Video) (*biz.Video, error) {
+func (r *videoRepo) Create(ctx context.Context, video *biz.Video, rev *biz.VideoRevision) (*biz.Video, error) {
 	var desc *string
 	if video.Description != "" {
 		desc = &video.Description
@@ -34,49 +40,135 @@ func (r *videoRepo) Create(ctx context.Context, video *biz.Video) (*biz.Video, e
 		thumb = &video.ThumbnailURL
 	}
 	m := &model.Video{
-		UserID:       video.UserID,
-		CategoryID:   video.CategoryID,
-		Title:        video.Title,
-		Description:  desc,
-		VideoURL:     video.VideoURL,
-		ThumbnailURL: thumb,
-		Duration:     video.Duration,
-		AccessTier:   video.AccessTier,
-		IsPublished:  video.IsPublished,
-		IsHidden:     false,
-	}
-	if err := r.data.DB.WithContext(ctx).Create(m).Error; err != nil {
+		UserID:          video.UserID,
+		CategoryID:      video.CategoryID,
+		Title:           video.Title,
+		Description:     desc,
+		VideoURL:        video.VideoURL,
+		ThumbnailURL:    thumb,
+		Duration:        video.Duration,
+		Width:           video.Width,
+		Height:          video.Height,
+		VideoCodec:      video.VideoCodec,
+		AudioCodec:      video.AudioCodec,
+		Bitrate:         video.Bitrate,
+		FileSize:        video.FileSize,
+		Status:          video.Status,
+		Visibility:      video.Visibility,
+		TranscodeStatus: video.TranscodeStatus,
+		AccessTier:      video.AccessTier,
+		IsHidden:        false,
+		PublishAt:       video.PublishAt,
+	}
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(m).Error; err != nil {
+			return err
+		}
+		if len(video.Tags) > 0 {
+			tags := make([]model.Tag, len(video.Tags))
+			for i, t := range video.Tags {
+				tags[i] = model.Tag{ID: t.ID}
+			}
+			if err := tx.Model(m).Association("Tags").Replace(tags); err != nil {
+				return err
+			}
+		}
+		if rev == nil {
+			return nil
+		}
+		rev.VideoID = m.ID
+		return recordRevision(tx, rev)
+	})
+	if err != nil {
 		return nil, err
 	}
 	return r.FindByID(ctx, m.ID)
 }
 
-func (r *videoRepo) Update(ctx context.Context, video *biz.Video) (*biz.Video, error) {
-	updates := map[string]interface{}{}
-	if video.Title != "" {
+func (r *videoRepo) Update(ctx context.Context, update *biz.VideoUpdate, rev *biz.VideoRevision) (bool, error) {
+	video := update.Video
+	updates := map[string]interface{}{
+		"version": gorm.Expr("version + 1"),
+	}
+	if update.Has(biz.VideoFieldTitle) {
 		updates["title"] = video.Title
 	}
-	if video.Description != "" {
-		updates["description"] = video.Description
+	if update.Has(biz.VideoFieldDescription) {
+		updates["description"] = nullString(video.Description)
 	}
-	if video.CategoryID != 0 {
+	if update.Has(biz.VideoFieldCategoryID) {
 		updates["category_id"] = video.CategoryID
 	}
-	if video.ThumbnailURL != "" {
-		updates["thumbnail_url"] = video.ThumbnailURL
+	if update.Has(biz.VideoFieldThumbnailURL) {
+		updates["thumbnail_url"] = nullString(video.ThumbnailURL)
+		// Variants of the old thumbnail are stale; a worker rebuilds them.
+		updates["thumbnail_small_url"] = nil
+		updates["thumbnail_medium_url"] = nil
+		updates["thumbnail_large_url"] = nil
 	}
-	if video.AccessTier >= 0 {
+	if update.Has(biz.VideoFieldAccessTier) {
 		updates["access_tier"] = video.AccessTier
 	}
+	if update.Has(biz.VideoFieldVisibility) {
+		updates["visibility"] = video.Visibility
+		updates["publish_at"] = nil
+	}
+	if update.Has(biz.VideoFieldPublishAt) {
+		updates["publish_at"] = video.PublishAt
+		if video.PublishAt != nil {
+			updates["visibility"] = biz.VisibilityPrivate
+		}
+	}
 
-	if len(updates) > 0 {
-		if err := r.data.DB.WithContext(ctx).Model(&model.Video{}).Where("id = ?", video.ID).Updates(updates).Error; err != nil {
-			return nil, err
+	var oldTagIDs []uint64
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		q := tx.Model(&model.Video{}).Where("id = ?", video.ID)
+		if update.Version != 0 {
+			q = q.Where("version = ?", update.Version)
+		}
+		res := q.Updates(updates)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			return errVersionConflict
 		}
+		if update.Has(biz.VideoFieldTagIDs) {
+			if err := tx.Table("video_tags").Where("video_id = ?", video.ID).Pluck("tag_id", &oldTagIDs).Error; err != nil {
+				return err
+			}
+			tags := make([]model.Tag, 0, len(video.Tags))
+			for _, id := range update.TagIDs() {
+				tags = append(tags, model.Tag{ID: id})
+			}
+			if err := tx.Model(&model.Video{ID: video.ID}).Association("Tags").Replace(tags); err != nil {
+				return err
+			}
+		}
+		if rev == nil {
+			return nil
+		}
+		return recordRevision(tx, rev)
+	})
+	if errors.Is(err, errVersionConflict) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if r.cache != nil && len(oldTagIDs) > 0 {
+		// syncCache only knows the new tags.
+		r.cache.EvictVideo(ctx, video.ID, oldTagIDs)
 	}
-	return r.FindByID(ctx, video.ID)
+	r.syncCache(ctx, video.ID)
+	return true, nil
 }
 
+// errVersionConflict rolls back an update of a video that is no longer at
+// the expected version.
+var errVersionConflict = errors.New("video version changed")
+
 func (r *videoRepo) Delete(ctx context.Context, id uint64) error {
 	return r.data.DB.WithContext(ctx).Delete(&model.Video{}, id).Error
 }
@@ -93,10 +185,10 @@ func (r *videoRepo) FindByID(ctx context.Context, id uint64) (*biz.Video, error)
 	return toBizVideo(&video), nil
 }
 
-func (r *videoRepo) ListByTags(ctx context.Context, tagIDs []uint64, offset, limit int) ([]*biz.Video, int64, error) {
+func (r *videoRepo) ListByTags(ctx context.Context, tagIDs []uint64, page pagination.Request) ([]*biz.Video, int64, error) {
 	// Try cache first (sub-millisecond vs ~50ms MySQL)
 	if r.cache != nil {
-		videos, total, err := r.cache.GetRecommended(ctx, tagIDs, offset, limit)
+		videos, total, err := r.cache.GetRecommended(ctx, tagIDs, page)
 		if err == nil && len(videos) > 0 {
 			return videos, total, nil
 		}
@@ -110,23 +202,24 @@ func (r *videoRepo) ListByTags(ctx context.Context, tagIDs []uint64, offset, lim
 		Model(&model.Video{}).
 		Joins("INNER JOIN video_tags ON video_tags.video_id = videos.id").
 		Where("video_tags.tag_id IN ?", tagIDs).
-		Where("videos.is_published = ? AND videos.is_hidden = ? AND videos.deleted_at IS NULL", true, false).
+		Scopes(listedVideos).
 		Where("videos.access_tier = 0").
 		Group("videos.id")
 
-	if err := baseQuery.Count(&total).Error; err != nil {
-		return nil, 0, err
+	if !page.Keyset() {
+		if err := baseQuery.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
-	if err := r.data.DB.WithContext(ctx).
+	if err := shuffled(r.data.DB.WithContext(ctx), page).
 		Preload("Tags").Preload("Category").Preload("User").
 		Joins("INNER JOIN video_tags ON video_tags.video_id = videos.id").
 		Where("video_tags.tag_id IN ?", tagIDs).
-		Where("videos.is_published = ? AND videos.is_hidden = ? AND videos.deleted_at IS NULL", true, false).
+		Scopes(listedVideos).
 		Where("videos.access_tier = 0").
 		Group("videos.id").
-		Order("RAND()").
-		Offset(offset).Limit(limit).
+		Offset(page.Offset).Limit(page.Limit).
 		Find(&videos).Error; err != nil {
 		return nil, 0, err
 	}
@@ -134,25 +227,26 @@ func (r *videoRepo) ListByTags(ctx context.Context, tagIDs []uint64, offset, lim
 	return toBizVideos(videos), total, nil
 }
 
-func (r *videoRepo) ListRandom(ctx context.Context, offset, limit int) ([]*biz.Video, int64, error) {
+func (r *videoRepo) ListRandom(ctx context.Context, page pagination.Request) ([]*biz.Video, int64, error) {
 	var total int64
 	var videos []model.Video
 
 	baseQuery := r.data.DB.WithContext(ctx).
 		Model(&model.Video{}).
-		Where("is_published = ? AND is_hidden = ? AND deleted_at IS NULL", true, false).
+		Scopes(listedVideos).
 		Where("access_tier = 0")
 
-	if err := baseQuery.Count(&total).Error; err != nil {
-		return nil, 0, err
+	if !page.Keyset() {
+		if err := baseQuery.Count(&total).Error; err != nil {
+			return nil, 0, err
+		}
 	}
 
-	if err := r.data.DB.WithContext(ctx).
+	if err := shuffled(r.data.DB.WithContext(ctx).Model(&model.Video{}), page).
 		Preload("Tags").Preload("Category").Preload("User").
-		Where("is_published = ? AND is_hidden = ? AND deleted_at IS NULL", true, false).
-		Where("access_tier = 0").
-		Order("RAND()").
-		Offset(offset).Limit(limit).
+		Scopes(listedVideos).
+		Where("videos.access_tier = 0").
+		Offset(page.Offset).Limit(page.Limit).
 		Find(&videos).Error; err != nil {
 		return nil, 0, err
 	}
@@ -160,6 +254,31 @@ func (r *videoRepo) ListRandom(ctx context.Context, offset, limit int) ([]*biz.V
 	return toBizVideos(videos), total, nil
 }
 
+// listedVideos limits a videos query to those that may appear in feeds and
+// search: ready, published and not hidden by an admin.
+func listedVideos(db *gorm.DB) *gorm.DB {
+	return db.Where("videos.status = ? AND videos.visibility = ? AND videos.is_hidden = ? AND videos.deleted_at IS NULL",
+		biz.VideoReady, biz.VisibilityPublished, false)
+}
+
+// shuffled orders videos randomly. With a seed the order is
+// CRC32(CONCAT(id, seed)) — the same as pagination.ShuffleKey — so it is
+// stable across requests and page.After can resume it.
+func shuffled(db *gorm.DB, page pagination.Request) *gorm.DB {
+	if page.Seed == 0 {
+		return db.Order("RAND()")
+	}
+	const key = "CRC32(CONCAT(videos.id, ?))"
+	if page.Keyset() {
+		db = db.Where(key+" > ? OR ("+key+" = ? AND videos.id > ?)",
+			page.Seed, page.After.Key, page.Seed, page.After.Key, page.After.ID)
+	}
+	return db.Order(clause.OrderBy{Expression: clause.Expr{
+		SQL:  key + ", videos.id",
+		Vars: []interface{}{page.Seed},
+	}})
+}
+
 func (r *videoRepo) IncrementViews(ctx context.Context, id uint64, isMember bool) error {
 	// Buffer through Redis (flushed to MySQL every 30s by background worker).
 	// Direct MySQL UPDATE would create hot-row contention under load.
@@ -178,11 +297,294 @@ func (r *videoRepo) IncrementViews(ctx context.Context, id uint64, isMember bool
 		Update(col, gorm.Expr(col+" + 1")).Error
 }
 
-func (r *videoRepo) TogglePublish(ctx context.Context, id uint64, published bool) error {
+func (r *videoRepo) SetVisibility(ctx context.Context, id uint64, visibility string, rev *biz.VideoRevision) error {
+	if err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&model.Video{}).
+			Where("id = ?", id).
+			Updates(map[string]interface{}{
+				"visibility": visibility,
+				"publish_at": nil,
+				"version":    gorm.Expr("version + 1"),
+			}).Error; err != nil {
+			return err
+		}
+		if rev == nil {
+			return nil
+		}
+		return recordRevision(tx, rev)
+	}); err != nil {
+		return err
+	}
+	r.syncCache(ctx, id)
+	return nil
+}
+
+func (r *videoRepo) UpdateStatus(ctx context.Context, id uint64, from, to, errMsg string) (bool, error) {
+	updates := map[string]interface{}{
+		"status":           to,
+		"processing_error": errMsg,
+	}
+	if to == biz.VideoProcessing {
+		updates["processing_progress"] = 0
+	}
+	res := r.data.DB.WithContext(ctx).
+		Model(&model.Video{}).
+		Where("id = ? AND status = ?", id, from).
+		Updates(updates)
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+	r.syncCache(ctx, id)
+	return true, nil
+}
+
+func (r *videoRepo) UpdateProgress(ctx context.Context, id uint64, percent uint32) error {
+	return r.data.DB.WithContext(ctx).
+		Model(&model.Video{}).
+		Where("id = ?", id).
+		Update("processing_progress", percent).Error
+}
+
+func (r *videoRepo) SetThumbnail(ctx context.Context, id uint64, expected, url string, variants *biz.ThumbnailVariants, rev *biz.VideoRevision) (bool, error) {
+	if variants == nil {
+		variants = &biz.ThumbnailVariants{}
+	}
+	updates := map[string]interface{}{
+		"thumbnail_url":        nullString(url),
+		"thumbnail_small_url":  nullString(variants.Small),
+		"thumbnail_medium_url": nullString(variants.Medium),
+		"thumbnail_large_url":  nullString(variants.Large),
+	}
+	if url != expected {
+		updates["version"] = gorm.Expr("version + 1")
+	}
+	var updated bool
+	err := r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		db := tx.Model(&model.Video{}).Where("id = ?", id)
+		if expected == "" {
+			db = db.Where("thumbnail_url IS NULL OR thumbnail_url = ''")
+		} else {
+			db = db.Where("thumbnail_url = ?", expected)
+		}
+		res := db.Updates(updates)
+		if res.Error != nil {
+			return res.Error
+		}
+		if res.RowsAffected == 0 {
+			// MySQL reports 0 rows for a no-op update, so check the match.
+			var n int64
+			if err := tx.Model(&model.Video{}).
+				Where("id = ? AND thumbnail_url <=> ?", id, nullString(url)).Count(&n).Error; err != nil {
+				return err
+			}
+			updated = n > 0 && expected == url
+			return nil
+		}
+		updated = true
+		if rev == nil || url == expected {
+			return nil
+		}
+		return recordRevision(tx, rev)
+	})
+	if err != nil || !updated {
+		return false, err
+	}
+	r.syncCache(ctx, id)
+	return true, nil
+}
+
+func (r *videoRepo) SetSeekPreview(ctx context.Context, id uint64, url string) error {
+	return r.data.DB.WithContext(ctx).
+		Model(&model.Video{}).
+		Where("id = ?", id).
+		Update("seek_preview_url", nullString(url)).Error
+}
+
+func (r *videoRepo) SetPendingSource(ctx context.Context, id uint64, url string) (bool, error) {
+	res := r.data.DB.WithContext(ctx).
+		Model(&model.Video{}).
+		Where("id = ? AND status = ?", id, biz.VideoReady).
+		Updates(map[string]interface{}{
+			"pending_video_url":   url,
+			"processing_progress": 0,
+			"processing_error":    "",
+		})
+	return res.RowsAffected > 0, res.Error
+}
+
+func (r *videoRepo) CancelPendingSource(ctx context.Context, id uint64, url, errMsg string) error {
+	return r.data.DB.WithContext(ctx).
+		Model(&model.Video{}).
+		Where("id = ? AND pending_video_url = ?", id, url).
+		Updates(map[string]interface{}{
+			"pending_video_url": nil,
+			"processing_error":  errMsg,
+		}).Error
+}
+
+func (r *videoRepo) ReplaceSource(ctx context.Context, from, to *biz.Video) (bool, error) {
+	res := r.data.DB.WithContext(ctx).
+		Model(&model.Video{}).
+		Where("id = ? AND video_url = ? AND pending_video_url <=> ?", from.ID, from.VideoURL, nullString(from.PendingVideoURL)).
+		Updates(map[string]interface{}{
+			"video_url":         to.VideoURL,
+			"pending_video_url": nil,
+			"duration":          to.Duration,
+			"width":             to.Width,
+			"height":            to.Height,
+			"video_codec":       to.VideoCodec,
+			"audio_codec":       to.AudioCodec,
+			"bitrate":           to.Bitrate,
+			"file_size":         to.FileSize,
+			"transcode_status":  to.TranscodeStatus,
+			"hls_url":           nullString(to.HLSURL),
+			"seek_preview_url":  nullString(to.SeekPreviewURL),
+			"processing_error":  "",
+		})
+	if res.Error != nil {
+		return false, res.Error
+	}
+	if res.RowsAffected == 0 {
+		return false, nil
+	}
+	r.syncCache(ctx, from.ID)
+	purgeSourceMedia(r.data, r.uploader, r.log, from.VideoURL)
+	return true, nil
+}
+
+func (r *videoRepo) ReplaceThumbnailCandidates(ctx context.Context, videoID uint64, candidates []*biz.ThumbnailCandidate) error {
+	return r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("video_id = ?", videoID).Delete(&model.VideoThumbnailCandidate{}).Error; err != nil {
+			return err
+		}
+		if len(candidates) == 0 {
+			return nil
+		}
+		rows := make([]model.VideoThumbnailCandidate, len(candidates))
+		for i, c := range candidates {
+			rows[i] = model.VideoThumbnailCandidate{VideoID: videoID, URL: c.URL, Position: c.Position}
+		}
+		return tx.Create(&rows).Error
+	})
+}
+
+func (r *videoRepo) ListThumbnailCandidates(ctx context.Context, videoID uint64) ([]*biz.ThumbnailCandidate, error) {
+	var rows []model.VideoThumbnailCandidate
+	if err := r.data.DB.WithContext(ctx).
+		Where("video_id = ?", videoID).
+		Order("position").
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	candidates := make([]*biz.ThumbnailCandidate, len(rows))
+	for i, m := range rows {
+		candidates[i] = &biz.ThumbnailCandidate{ID: m.ID, VideoID: m.VideoID, URL: m.URL, Position: m.Position}
+	}
+	return candidates, nil
+}
+
+func (r *videoRepo) ReplaceChapters(ctx context.Context, videoID uint64, chapters []*biz.Chapter) error {
+	return r.data.DB.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("video_id = ?", videoID).Delete(&model.VideoChapter{}).Error; err != nil {
+			return err
+		}
+		if len(chapters) == 0 {
+			return nil
+		}
+		rows := make([]model.VideoChapter, len(chapters))
+		for i, c := range chapters {
+			rows[i] = model.VideoChapter{VideoID: videoID, Start: c.Start, Title: c.Title}
+		}
+		return tx.Create(&rows).Error
+	})
+}
+
+func (r *videoRepo) ListChapters(ctx context.Context, videoID uint64) ([]*biz.Chapter, error) {
+	var rows []model.VideoChapter
+	if err := r.data.DB.WithContext(ctx).
+		Where("video_id = ?", videoID).
+		Order("start").
+		Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	chapters := make([]*biz.Chapter, len(rows))
+	for i, m := range rows {
+		chapters[i] = &biz.Chapter{Start: m.Start, Title: m.Title}
+	}
+	return chapters, nil
+}
+
+func nullString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// ListScheduled returns a user's videos waiting to be published, the
+// soonest first.
+func (r *videoRepo) ListScheduled(ctx context.Context, userID uint64, offset, limit int) ([]*biz.Video, int64, error) {
+	var total int64
+	var videos []model.Video
+
+	db := r.data.DB.WithContext(ctx).Model(&model.Video{}).
+		Where("user_id = ? AND publish_at IS NOT NULL", userID)
+	if err := db.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if err := db.Preload("Tags").Preload("Category").Preload("User").
+		Order("publish_at, id").
+		Offset(offset).Limit(limit).
+		Find(&videos).Error; err != nil {
+		return nil, 0, err
+	}
+	return toBizVideos(videos), total, nil
+}
+
+// syncCache adds a video to the recommendation cache once it may be listed
+// and evicts it when it no longer may, e.g. after being made private.
+func (r *videoRepo) syncCache(ctx context.Context, id uint64) {
+	if r.cache == nil {
+		return
+	}
+	video, err := r.FindByID(ctx, id)
+	if err != nil {
+		r.log.Warnf("sync cache for video %d: %v", id, err)
+		return
+	}
+	tagIDs := make([]uint64, len(video.Tags))
+	for i, t := range video.Tags {
+		tagIDs[i] = t.ID
+	}
+	if video.Listed() && video.AccessTier == 0 {
+		r.cache.CacheVideo(ctx, video, tagIDs)
+	} else {
+		r.cache.EvictVideo(ctx, id, tagIDs)
+	}
+}
+
+func (r *videoRepo) UpdateTranscode(ctx context.Context, id uint64, status, hlsURL string) error {
+	var manifest *string
+	if hlsURL != "" {
+		manifest = &hlsURL
+	}
 	return r.data.DB.WithContext(ctx).
 		Model(&model.Video{}).
 		Where("id = ?", id).
-		Update("is_published", published).Error
+		Updates(map[string]interface{}{
+			"transcode_status": status,
+			"hls_url":          manifest,
+		}).Error
 }
 
 func (r *videoRepo) GetTagIDsByVideo(ctx context.Context, videoID uint64) ([]uint64, error) {
@@ -220,6 +622,10 @@ func toBizVideo(m *model.Video) *biz.Video {
 	}
 	username := ""
 	categoryName := ""
+	hlsURL := ""
+	if m.HLSURL != nil {
+		hlsURL = *m.HLSURL
+	}
 	if m.User.ID != 0 {
 		username = m.User.DisplayName
 	}
@@ -233,21 +639,41 @@ func toBizVideo(m *model.Video) *biz.Video {
 	}
 
 	return &biz.Video{
-		ID:             m.ID,
-		UserID:         m.UserID,
-		Username:       username,
-		CategoryID:     m.CategoryID,
-		CategoryName:   categoryName,
-		Title:          m.Title,
-		Description:    desc,
-		VideoURL:       m.VideoURL,
-		ThumbnailURL:   thumb,
-		Duration:       m.Duration,
+		ID:                 m.ID,
+		UserID:             m.UserID,
+		Username:           username,
+		CategoryID:         m.CategoryID,
+		CategoryName:       categoryName,
+		Title:              m.Title,
+		Description:        desc,
+		VideoURL:           m.VideoURL,
+		ThumbnailURL:       thumb,
+		Duration:           m.Duration,
+		Width:              m.Width,
+		Height:             m.Height,
+		VideoCodec:         m.VideoCodec,
+		AudioCodec:         m.AudioCodec,
+		Bitrate:            m.Bitrate,
+		FileSize:           m.FileSize,
+		Status:             m.Status,
+		Visibility:         m.Visibility,
+		ProcessingProgress: m.ProcessingProgress,
+		ProcessingError:    m.ProcessingError,
+		PendingVideoURL:    derefString(m.PendingVideoURL),
+		TranscodeStatus:    m.TranscodeStatus,
+		HLSURL:             hlsURL,
+		SeekPreviewURL:     derefString(m.SeekPreviewURL),
+		ThumbnailVariants: biz.ThumbnailVariants{
+			Small:  derefString(m.ThumbnailSmallURL),
+			Medium: derefString(m.ThumbnailMediumURL),
+			Large:  derefString(m.ThumbnailLargeURL),
+		},
 		ViewsMember:    m.ViewsMember,
 		ViewsNonMember: m.ViewsNonMember,
 		AccessTier:     m.AccessTier,
-		IsPublished:    m.IsPublished,
 		IsHidden:       m.IsHidden,
+		PublishAt:      m.PublishAt,
+		Version:        m.Version,
 		Tags:           tags,
 		CreatedAt:      m.CreatedAt,
 	}
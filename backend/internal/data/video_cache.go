@@ -2,9 +2,11 @@ package data
 
 import (
 	"backend/internal/biz"
+	"backend/internal/pkg/pagination"
 	"context"
 	"fmt"
 	"math/rand"
+	"sort"
 	"strconv"
 	"time"
 
@@ -45,8 +47,10 @@ func NewVideoCache(data *Data, logger log.Logger) *VideoCache {
 // Algorithm:
 // 1. SUNIONSTORE temp → merge video IDs from all selected tag SETs (OR, not AND)
 // 2. Shuffle the merged IDs → take page_size for variety
+//    (seeded requests sort by pagination.ShuffleKey instead, so the order
+//    matches the MySQL fallback and a cursor can resume it)
 // 3. Pipeline HGETALL for each video ID → return hydrated results
-func (vc *VideoCache) GetRecommended(ctx context.Context, tagIDs []uint64, offset, limit int) ([]*biz.Video, int64, error) {
+func (vc *VideoCache) GetRecommended(ctx context.Context, tagIDs []uint64, req pagination.Request) ([]*biz.Video, int64, error) {
 	if vc.data.Redis == nil || len(tagIDs) == 0 {
 		return nil, 0, nil
 	}
@@ -65,10 +69,15 @@ func (vc *VideoCache) GetRecommended(ctx context.Context, tagIDs []uint64, offse
 
 	total := int64(len(videoIDStrs))
 
-	// Shuffle for randomness, then paginate
-	rand.Shuffle(len(videoIDStrs), func(i, j int) {
-		videoIDStrs[i], videoIDStrs[j] = videoIDStrs[j], videoIDStrs[i]
-	})
+	offset, limit := req.Offset, req.Limit
+	if req.Seed == 0 {
+		// Shuffle for randomness, then paginate
+		rand.Shuffle(len(videoIDStrs), func(i, j int) {
+			videoIDStrs[i], videoIDStrs[j] = videoIDStrs[j], videoIDStrs[i]
+		})
+	} else {
+		videoIDStrs, offset = seededOrder(videoIDStrs, req)
+	}
 
 	// Apply pagination
 	if offset >= len(videoIDStrs) {
@@ -110,6 +119,40 @@ func (vc *VideoCache) GetRecommended(ctx context.Context, tagIDs []uint64, offse
 	return videos, total, nil
 }
 
+// seededOrder sorts IDs by (ShuffleKey, ID) and returns the offset of the
+// first ID after req.After.
+func seededOrder(idStrs []string, req pagination.Request) ([]string, int) {
+	type entry struct {
+		str string
+		id  uint64
+		key int64
+	}
+	entries := make([]entry, 0, len(idStrs))
+	for _, s := range idStrs {
+		id, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			continue
+		}
+		entries = append(entries, entry{s, id, pagination.ShuffleKey(id, req.Seed)})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].key != entries[j].key {
+			return entries[i].key < entries[j].key
+		}
+		return entries[i].id < entries[j].id
+	})
+
+	sorted := make([]string, len(entries))
+	offset := req.Offset
+	for i, e := range entries {
+		sorted[i] = e.str
+		if req.Keyset() && (e.key < req.After.Key || (e.key == req.After.Key && e.id <= req.After.ID)) {
+			offset = i + 1
+		}
+	}
+	return sorted, offset
+}
+
 // CacheVideo writes a video's summary into Redis (both tag SETs and video HASH).
 // Called after video creation or lazy-populate on cache miss.
 func (vc *VideoCache) CacheVideo(ctx context.Context, v *biz.Video, tagIDs []uint64) {
@@ -122,16 +165,19 @@ func (vc *VideoCache) CacheVideo(ctx context.Context, v *biz.Video, tagIDs []uin
 
 	// Video HASH
 	pipe.HSet(ctx, videoKey, map[string]interface{}{
-		"id":          v.ID,
-		"title":       v.Title,
-		"duration":    v.Duration,
-		"views":       v.ViewsMember + v.ViewsNonMember,
-		"thumbnail":   v.ThumbnailURL,
-		"category_id": v.CategoryID,
-		"user_id":     v.UserID,
-		"video_url":   v.VideoURL,
-		"username":    v.Username,
-		"created_at":  v.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		"id":               v.ID,
+		"title":            v.Title,
+		"duration":         v.Duration,
+		"views":            v.ViewsMember + v.ViewsNonMember,
+		"thumbnail":        v.ThumbnailURL,
+		"thumbnail_small":  v.ThumbnailVariants.Small,
+		"thumbnail_medium": v.ThumbnailVariants.Medium,
+		"thumbnail_large":  v.ThumbnailVariants.Large,
+		"category_id":      v.CategoryID,
+		"user_id":          v.UserID,
+		"video_url":        v.VideoURL,
+		"username":         v.Username,
+		"created_at":       v.CreatedAt.Format("2006-01-02T15:04:05Z"),
 	})
 	pipe.Expire(ctx, videoKey, cacheVideoTTL)
 
@@ -206,16 +252,22 @@ func hashToVideo(m map[string]string) *biz.Video {
 	createdAt, _ := time.Parse("2006-01-02T15:04:05Z", m["created_at"])
 
 	return &biz.Video{
-		ID:             id,
-		UserID:         userID,
-		Username:       m["username"],
-		CategoryID:     categoryID,
-		Title:          m["title"],
-		ThumbnailURL:   m["thumbnail"],
+		ID:           id,
+		UserID:       userID,
+		Username:     m["username"],
+		CategoryID:   categoryID,
+		Title:        m["title"],
+		ThumbnailURL: m["thumbnail"],
+		ThumbnailVariants: biz.ThumbnailVariants{
+			Small:  m["thumbnail_small"],
+			Medium: m["thumbnail_medium"],
+			Large:  m["thumbnail_large"],
+		},
 		VideoURL:       m["video_url"],
 		Duration:       uint32(duration),
 		ViewsNonMember: views, // combined in cache, stored in one field
-		IsPublished:    true,
+		Status:         biz.VideoReady,
+		Visibility:     biz.VisibilityPublished,
 		CreatedAt:      createdAt,
 	}
 }
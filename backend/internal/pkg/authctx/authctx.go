@@ -7,6 +7,8 @@ type contextKey string
 const (
 	ContextKeyUserID contextKey = "user_id"
 	ContextKeyRole   contextKey = "role"
+	// ContextKeyShareToken holds a VideoShare token granting one video.
+	ContextKeyShareToken contextKey = "share_token"
 )
 
 // WithUserID sets user_id in context.
@@ -30,3 +32,17 @@ func RoleFromContext(ctx context.Context) (string, bool) {
 	role, ok := ctx.Value(ContextKeyRole).(string)
 	return role, ok
 }
+
+// WithShareToken sets a video share token presented by the caller.
+func WithShareToken(ctx context.Context, token string) context.Context {
+	if token == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, ContextKeyShareToken, token)
+}
+
+// ShareTokenFromContext extracts the share token from context.
+func ShareTokenFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(ContextKeyShareToken).(string)
+	return token, ok
+}
@@ -0,0 +1,280 @@
+// Package caption validates WebVTT and SRT caption files and converts them
+// to WebVTT, the format HTML5 players load.
+package caption
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Formats accepted by Parse.
+const (
+	FormatVTT = "vtt"
+	FormatSRT = "srt"
+)
+
+// ErrInvalid means the file is not a well-formed caption file. Returned
+// errors wrap it with the offending line.
+var ErrInvalid = errors.New("invalid caption file")
+
+// Cue is one timed caption.
+type Cue struct {
+	Start time.Duration
+	End   time.Duration
+	Text  string // payload, possibly with markup such as <i>
+}
+
+// Track is a validated caption file.
+type Track struct {
+	Cues []Cue
+	vtt  string
+}
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// Parse validates data as format (FormatVTT or FormatSRT; detected from the
+// header when empty). The file must be UTF-8, and every cue must end after
+// it starts and start no earlier than the previous one.
+func Parse(data []byte, format string) (*Track, error) {
+	data = bytes.TrimPrefix(data, utf8BOM)
+	if !utf8.Valid(data) || bytes.IndexByte(data, 0) >= 0 {
+		return nil, fmt.Errorf("%w: not UTF-8 text", ErrInvalid)
+	}
+	text := strings.NewReplacer("\r\n", "\n", "\r", "\n").Replace(string(data))
+
+	if format == "" {
+		format = FormatSRT
+		if strings.HasPrefix(text, "WEBVTT") {
+			format = FormatVTT
+		}
+	}
+	blocks := splitBlocks(text)
+	switch format {
+	case FormatVTT:
+		return parseVTT(text, blocks)
+	case FormatSRT:
+		return parseSRT(blocks)
+	default:
+		return nil, fmt.Errorf("%w: unsupported format %q", ErrInvalid, format)
+	}
+}
+
+// WebVTT returns the track as a WebVTT file.
+func (t *Track) WebVTT() []byte {
+	return []byte(t.vtt)
+}
+
+// WebVTT writes cues as a WebVTT file. Cue text is written as is; see
+// Escape.
+func WebVTT(cues []Cue) []byte {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n")
+	for _, c := range cues {
+		fmt.Fprintf(&b, "\n%s --> %s\n%s\n", vttTime(c.Start), vttTime(c.End), c.Text)
+	}
+	return []byte(b.String())
+}
+
+var escaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// Escape makes plain text safe as cue text.
+func Escape(text string) string {
+	return escaper.Replace(text)
+}
+
+// End returns when the last cue ends.
+func (t *Track) End() time.Duration {
+	var end time.Duration
+	for _, c := range t.Cues {
+		if c.End > end {
+			end = c.End
+		}
+	}
+	return end
+}
+
+var markup = regexp.MustCompile(`<[^>]*>`)
+
+// Text returns the spoken text without timings or markup, one cue per line.
+func (t *Track) Text() string {
+	var b strings.Builder
+	for _, c := range t.Cues {
+		line := strings.Join(strings.Fields(html.UnescapeString(markup.ReplaceAllString(c.Text, " "))), " ")
+		if line == "" {
+			continue
+		}
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// block is a run of non-empty lines; line is the 1-based number of its first.
+type block struct {
+	line  int
+	lines []string
+}
+
+func splitBlocks(text string) []block {
+	var blocks []block
+	var cur *block
+	for i, l := range strings.Split(text, "\n") {
+		if strings.TrimSpace(l) == "" {
+			cur = nil
+			continue
+		}
+		if cur == nil {
+			blocks = append(blocks, block{line: i + 1})
+			cur = &blocks[len(blocks)-1]
+		}
+		cur.lines = append(cur.lines, l)
+	}
+	return blocks
+}
+
+func parseVTT(text string, blocks []block) (*Track, error) {
+	if len(blocks) == 0 {
+		return nil, fmt.Errorf("%w: missing WEBVTT header", ErrInvalid)
+	}
+	header := blocks[0].lines[0]
+	if header != "WEBVTT" && !strings.HasPrefix(header, "WEBVTT ") && !strings.HasPrefix(header, "WEBVTT\t") {
+		return nil, fmt.Errorf("%w: missing WEBVTT header", ErrInvalid)
+	}
+
+	t := &Track{}
+	for _, b := range blocks[1:] {
+		first := b.lines[0]
+		if isVTTKeyword(first, "NOTE") || isVTTKeyword(first, "STYLE") || isVTTKeyword(first, "REGION") {
+			continue
+		}
+		lines, line := b.lines, b.line
+		if !strings.Contains(lines[0], "-->") {
+			// Cue identifier
+			lines, line = lines[1:], line+1
+		}
+		cue, err := parseCue(lines, line, '.')
+		if err != nil {
+			return nil, err
+		}
+		if err := t.add(cue, line); err != nil {
+			return nil, err
+		}
+	}
+	t.vtt = strings.TrimRight(text, "\n") + "\n"
+	return t, nil
+}
+
+func isVTTKeyword(line, keyword string) bool {
+	rest, ok := strings.CutPrefix(line, keyword)
+	return ok && (rest == "" || rest[0] == ' ' || rest[0] == '\t')
+}
+
+// srtOverrides are SRT markup with no WebVTT equivalent: <font> tags and
+// ASS-style {\an8} positioning.
+var srtOverrides = regexp.MustCompile(`(?i)</?font[^>]*>|\{\\[^}]*\}`)
+
+func parseSRT(blocks []block) (*Track, error) {
+	t := &Track{}
+	for _, blk := range blocks {
+		lines, line := blk.lines, blk.line
+		if !strings.Contains(lines[0], "-->") {
+			if _, err := strconv.Atoi(strings.TrimSpace(lines[0])); err != nil {
+				return nil, fmt.Errorf("%w: line %d: expected a cue number", ErrInvalid, line)
+			}
+			lines, line = lines[1:], line+1
+		}
+		cue, err := parseCue(lines, line, ',')
+		if err != nil {
+			return nil, err
+		}
+		cue.Text = srtOverrides.ReplaceAllString(cue.Text, "")
+		if err := t.add(cue, line); err != nil {
+			return nil, err
+		}
+	}
+	t.vtt = string(WebVTT(t.Cues))
+	return t, nil
+}
+
+// parseCue parses a timing line followed by the payload. WebVTT cue
+// settings after the end time are not validated; the stored file keeps them.
+func parseCue(lines []string, line int, sep byte) (Cue, error) {
+	if len(lines) == 0 {
+		return Cue{}, fmt.Errorf("%w: line %d: missing cue timing", ErrInvalid, line)
+	}
+	start, rest, ok := strings.Cut(lines[0], "-->")
+	if !ok {
+		return Cue{}, fmt.Errorf("%w: line %d: missing cue timing", ErrInvalid, line)
+	}
+	fields := strings.Fields(rest)
+	if len(fields) == 0 {
+		return Cue{}, fmt.Errorf("%w: line %d: missing cue end time", ErrInvalid, line)
+	}
+	var cue Cue
+	var err error
+	if cue.Start, err = parseTime(strings.TrimSpace(start), sep); err != nil {
+		return Cue{}, fmt.Errorf("%w: line %d: %v", ErrInvalid, line, err)
+	}
+	if cue.End, err = parseTime(fields[0], sep); err != nil {
+		return Cue{}, fmt.Errorf("%w: line %d: %v", ErrInvalid, line, err)
+	}
+	if cue.End <= cue.Start {
+		return Cue{}, fmt.Errorf("%w: line %d: cue ends before it starts", ErrInvalid, line)
+	}
+	for i, l := range lines[1:] {
+		if strings.Contains(l, "-->") {
+			return Cue{}, fmt.Errorf("%w: line %d: missing blank line between cues", ErrInvalid, line+1+i)
+		}
+	}
+	cue.Text = strings.Join(lines[1:], "\n")
+	return cue, nil
+}
+
+// add appends cue, requiring cues in start time order.
+func (t *Track) add(cue Cue, line int) error {
+	if n := len(t.Cues); n > 0 && cue.Start < t.Cues[n-1].Start {
+		return fmt.Errorf("%w: line %d: cue starts before the previous one", ErrInvalid, line)
+	}
+	t.Cues = append(t.Cues, cue)
+	return nil
+}
+
+// parseTime parses [hh:]mm:ss{sep}ttt. SRT files often use '.' instead of
+// ',', so that is accepted for SRT too.
+func parseTime(s string, sep byte) (time.Duration, error) {
+	invalid := fmt.Errorf("invalid timestamp %q", s)
+	i := strings.LastIndexAny(s, ".,")
+	if i < 0 || len(s)-i != 4 || (s[i] != sep && s[i] != '.') {
+		return 0, invalid
+	}
+	ms, err := strconv.Atoi(s[i+1:])
+	if err != nil || ms < 0 {
+		return 0, invalid
+	}
+	parts := strings.Split(s[:i], ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, invalid
+	}
+	var units [3]int
+	for j, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 || (j > 0 || len(parts) == 2) && (len(p) != 2 || n > 59) {
+			return 0, invalid
+		}
+		units[3-len(parts)+j] = n
+	}
+	return time.Duration(units[0])*time.Hour + time.Duration(units[1])*time.Minute +
+		time.Duration(units[2])*time.Second + time.Duration(ms)*time.Millisecond, nil
+}
+
+func vttTime(d time.Duration) string {
+	ms := d.Milliseconds()
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", ms/3_600_000, ms/60_000%60, ms/1000%60, ms%1000)
+}
@@ -0,0 +1,154 @@
+// Package clamav is a client for the clamd protocol: it streams data to a
+// ClamAV daemon with the INSTREAM command and reports what it found.
+package clamav
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+// chunkSize is the INSTREAM chunk length; clamd's StreamMaxLength limits
+// the total.
+const chunkSize = 64 << 10
+
+// ErrStreamTooLarge is returned when data exceeds clamd's StreamMaxLength.
+var ErrStreamTooLarge = errors.New("clamav: stream exceeds the daemon's size limit")
+
+// Result is the verdict on one stream.
+type Result struct {
+	Infected bool
+	// Signature names what was found, e.g. "Eicar-Signature".
+	Signature string
+}
+
+// Client talks to clamd over TCP ("tcp", "127.0.0.1:3310") or a Unix
+// socket ("unix", "/run/clamav/clamd.ctl"), one connection per command.
+type Client struct {
+	Network string
+	Address string
+	// Timeout bounds a whole command; 0 means only ctx does.
+	Timeout time.Duration
+}
+
+func New(network, address string, timeout time.Duration) *Client {
+	return &Client{Network: network, Address: address, Timeout: timeout}
+}
+
+// Ping checks that clamd is reachable.
+func (c *Client) Ping(ctx context.Context) error {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err := conn.Write([]byte("zPING\x00")); err != nil {
+		return err
+	}
+	reply, err := readReply(conn)
+	if err != nil {
+		return err
+	}
+	if reply != "PONG" {
+		return fmt.Errorf("clamav: unexpected reply to PING: %q", reply)
+	}
+	return nil
+}
+
+// Scan streams r to clamd and returns its verdict.
+func (c *Client) Scan(ctx context.Context, r io.Reader) (*Result, error) {
+	conn, err := c.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	// Unblock reads and writes when ctx ends before the deadline.
+	stop := context.AfterFunc(ctx, func() { conn.SetDeadline(time.Now()) })
+	defer stop()
+
+	if _, err := conn.Write([]byte("zINSTREAM\x00")); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 4+chunkSize)
+	for {
+		n, rerr := io.ReadFull(r, buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf[:4], uint32(n))
+			if _, err := conn.Write(buf[:4+n]); err != nil {
+				// clamd closes the connection once the limit is hit; its
+				// reply says why.
+				if reply, rerr := readReply(conn); rerr == nil {
+					return parseScanReply(reply)
+				}
+				return nil, err
+			}
+		}
+		if rerr == io.EOF || rerr == io.ErrUnexpectedEOF {
+			break
+		}
+		if rerr != nil {
+			return nil, rerr
+		}
+	}
+	if _, err := conn.Write([]byte{0, 0, 0, 0}); err != nil {
+		return nil, err
+	}
+	reply, err := readReply(conn)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+	return parseScanReply(reply)
+}
+
+func (c *Client) dial(ctx context.Context) (net.Conn, error) {
+	if c.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.Timeout)
+		defer cancel()
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, c.Network, c.Address)
+	if err != nil {
+		return nil, fmt.Errorf("clamav: %w", err)
+	}
+	if c.Timeout > 0 {
+		conn.SetDeadline(time.Now().Add(c.Timeout))
+	}
+	return conn, nil
+}
+
+// readReply reads one NUL-terminated ("z" command) reply.
+func readReply(conn net.Conn) (string, error) {
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && !(err == io.EOF && reply != "") {
+		return "", fmt.Errorf("clamav: read reply: %w", err)
+	}
+	return strings.TrimRight(reply, "\x00\n"), nil
+}
+
+// parseScanReply reads "stream: OK", "stream: <signature> FOUND" or
+// "<message> ERROR".
+func parseScanReply(reply string) (*Result, error) {
+	switch {
+	case strings.HasSuffix(reply, " FOUND"):
+		sig := strings.TrimSuffix(reply, " FOUND")
+		if _, after, ok := strings.Cut(sig, ": "); ok {
+			sig = after
+		}
+		return &Result{Infected: true, Signature: sig}, nil
+	case strings.HasSuffix(reply, ": OK"):
+		return &Result{}, nil
+	case strings.Contains(reply, "size limit exceeded"):
+		return nil, ErrStreamTooLarge
+	}
+	return nil, fmt.Errorf("clamav: %s", reply)
+}
@@ -0,0 +1,147 @@
+package clamav
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// fakeClamd accepts one INSTREAM command, checks its framing and answers
+// reply once limit bytes have arrived or the stream ends. It returns the
+// data it received.
+func fakeClamd(t *testing.T, reply string, limit int) (*Client, <-chan []byte) {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { ln.Close() })
+
+	received := make(chan []byte, 1)
+	go func() {
+		defer close(received)
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		cmd, err := r.ReadString(0)
+		if err != nil || cmd != "zINSTREAM\x00" {
+			t.Errorf("command = %q, %v; want zINSTREAM", cmd, err)
+			return
+		}
+		var data []byte
+		for limit <= 0 || len(data) < limit {
+			var size uint32
+			if err := binary.Read(r, binary.BigEndian, &size); err != nil {
+				t.Errorf("read chunk length: %v", err)
+				return
+			}
+			if size == 0 {
+				break
+			}
+			if size > chunkSize {
+				t.Errorf("chunk of %d bytes, want at most %d", size, chunkSize)
+			}
+			chunk := make([]byte, size)
+			if _, err := io.ReadFull(r, chunk); err != nil {
+				t.Errorf("read chunk: %v", err)
+				return
+			}
+			data = append(data, chunk...)
+		}
+		received <- data
+		conn.Write([]byte(reply + "\x00"))
+		// Drain what the client still sends so closing does not reset the
+		// connection before it has read the reply.
+		io.Copy(io.Discard, r)
+	}()
+	return New("tcp", ln.Addr().String(), 5*time.Second), received
+}
+
+func TestScanFraming(t *testing.T) {
+	c, received := fakeClamd(t, "stream: OK", 0)
+	data := bytes.Repeat([]byte("0123456789"), chunkSize/4) // 2.5 chunks
+
+	res, err := c.Scan(context.Background(), bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Infected {
+		t.Errorf("Infected = true, want false")
+	}
+	if got := <-received; !bytes.Equal(got, data) {
+		t.Errorf("clamd received %d bytes, want the %d sent", len(got), len(data))
+	}
+}
+
+func TestScanEmpty(t *testing.T) {
+	c, received := fakeClamd(t, "stream: OK", 0)
+	if _, err := c.Scan(context.Background(), strings.NewReader("")); err != nil {
+		t.Fatal(err)
+	}
+	if got := <-received; len(got) != 0 {
+		t.Errorf("clamd received %d bytes, want 0", len(got))
+	}
+}
+
+func TestScanFound(t *testing.T) {
+	c, _ := fakeClamd(t, "stream: Eicar-Signature FOUND", 0)
+	res, err := c.Scan(context.Background(), strings.NewReader("X5O!P%@AP"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Infected || res.Signature != "Eicar-Signature" {
+		t.Errorf("Scan = %+v, want infected with Eicar-Signature", res)
+	}
+}
+
+func TestScanSizeLimit(t *testing.T) {
+	c, _ := fakeClamd(t, "INSTREAM size limit exceeded. ERROR", chunkSize)
+	data := bytes.Repeat([]byte{'x'}, 4*chunkSize)
+	_, err := c.Scan(context.Background(), bytes.NewReader(data))
+	if !errors.Is(err, ErrStreamTooLarge) {
+		t.Errorf("Scan error = %v, want ErrStreamTooLarge", err)
+	}
+}
+
+func TestScanError(t *testing.T) {
+	c, _ := fakeClamd(t, "Can't allocate memory ERROR", 0)
+	_, err := c.Scan(context.Background(), strings.NewReader("data"))
+	if err == nil || errors.Is(err, ErrStreamTooLarge) || !strings.Contains(err.Error(), "Can't allocate memory") {
+		t.Errorf("Scan error = %v, want clamd's message", err)
+	}
+}
+
+func TestParseScanReply(t *testing.T) {
+	tests := []struct {
+		reply     string
+		infected  bool
+		signature string
+		wantErr   bool
+	}{
+		{reply: "stream: OK"},
+		{reply: "stream: Win.Test.EICAR_HDB-1 FOUND", infected: true, signature: "Win.Test.EICAR_HDB-1"},
+		{reply: "Eicar-Signature FOUND", infected: true, signature: "Eicar-Signature"},
+		{reply: "INSTREAM size limit exceeded. ERROR", wantErr: true},
+		{reply: "UNKNOWN COMMAND", wantErr: true},
+	}
+	for _, tt := range tests {
+		res, err := parseScanReply(tt.reply)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseScanReply(%q) error = %v, want error %v", tt.reply, err, tt.wantErr)
+			continue
+		}
+		if err == nil && (res.Infected != tt.infected || res.Signature != tt.signature) {
+			t.Errorf("parseScanReply(%q) = %+v", tt.reply, res)
+		}
+	}
+}
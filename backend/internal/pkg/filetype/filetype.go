@@ -0,0 +1,89 @@
+// Package filetype identifies uploads by their leading bytes rather than
+// the Content-Type a client claims.
+package filetype
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// HeadSize is how many leading bytes Detect needs at most.
+const HeadSize = 512
+
+// Detect returns the content type of a file from its first bytes, or ""
+// if it is none of the accepted video and image formats.
+func Detect(head []byte) string {
+	switch {
+	case bytes.HasPrefix(head, []byte{0xFF, 0xD8, 0xFF}):
+		return "image/jpeg"
+	case bytes.HasPrefix(head, []byte("\x89PNG\r\n\x1a\n")):
+		return "image/png"
+	case len(head) >= 12 && string(head[:4]) == "RIFF" && string(head[8:12]) == "WEBP":
+		return "image/webp"
+	case bytes.HasPrefix(head, []byte{0x1A, 0x45, 0xDF, 0xA3}):
+		return detectEBML(head)
+	}
+	return detectISOBMFF(head)
+}
+
+// Matches reports whether head is a file of contentType. MP4 and QuickTime
+// share a container and are often labelled interchangeably, so either
+// label matches either brand.
+func Matches(contentType string, head []byte) bool {
+	detected := Detect(head)
+	if detected == "" {
+		return false
+	}
+	if isISOBMFF(contentType) && isISOBMFF(detected) {
+		return true
+	}
+	return detected == contentType
+}
+
+func isISOBMFF(contentType string) bool {
+	return contentType == "video/mp4" || contentType == "video/quicktime"
+}
+
+// detectEBML tells WebM from other Matroska files by the DocType element
+// in the EBML header.
+func detectEBML(head []byte) string {
+	i := bytes.Index(head, []byte{0x42, 0x82}) // DocType
+	if i < 0 || i+3 > len(head) {
+		return ""
+	}
+	n := int(head[i+2] & 0x7F) // one-byte size, with the length marker bit
+	if head[i+2]&0x80 == 0 || i+3+n > len(head) {
+		return ""
+	}
+	if string(head[i+3:i+3+n]) == "webm" {
+		return "video/webm"
+	}
+	return ""
+}
+
+// detectISOBMFF recognizes MP4 ("ftyp" with an ISO brand) and QuickTime
+// ("ftyp" with the "qt  " brand, or older files starting with another
+// top-level atom).
+func detectISOBMFF(head []byte) string {
+	if len(head) < 12 {
+		return ""
+	}
+	size := binary.BigEndian.Uint32(head[:4])
+	switch string(head[4:8]) {
+	case "ftyp":
+		if size < 16 {
+			return ""
+		}
+		if string(head[8:12]) == "qt  " {
+			return "video/quicktime"
+		}
+		return "video/mp4"
+	case "moov", "mdat", "wide", "free", "skip":
+		// 0 runs to the end of the file, 1 means a 64-bit size follows.
+		if size > 1 && size < 8 {
+			return ""
+		}
+		return "video/quicktime"
+	}
+	return ""
+}
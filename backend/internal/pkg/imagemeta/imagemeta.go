@@ -0,0 +1,214 @@
+// Package imagemeta removes EXIF, XMP and text metadata (camera details,
+// GPS position, timestamps) from uploaded images without re-encoding them.
+package imagemeta
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// ErrInvalid reports an image whose structure cannot be parsed.
+var ErrInvalid = errors.New("malformed image")
+
+// Strip returns data without metadata. Supported types are image/jpeg,
+// image/png and image/webp; other data is returned unchanged.
+func Strip(data []byte, contentType string) ([]byte, error) {
+	switch contentType {
+	case "image/jpeg":
+		return stripJPEG(data)
+	case "image/png":
+		return stripPNG(data)
+	case "image/webp":
+		return stripWebP(data)
+	}
+	return data, nil
+}
+
+func invalid(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalid, fmt.Sprintf(format, args...))
+}
+
+// stripJPEG drops APP1 (EXIF, XMP), APP13 (IPTC) and comment segments
+// before the image data. The EXIF orientation is kept in a minimal APP1
+// segment of its own, since viewers rotate photos by it.
+func stripJPEG(data []byte) ([]byte, error) {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return nil, invalid("missing JPEG start of image")
+	}
+	out := make([]byte, 0, len(data))
+	out = append(out, 0xFF, 0xD8)
+	var orientation uint16
+	// EXIF goes right after the start of image, or after a JFIF APP0.
+	exifAt := len(out)
+
+	i := 2
+	for {
+		if i+4 > len(data) || data[i] != 0xFF {
+			return nil, invalid("bad JPEG marker at %d", i)
+		}
+		marker := data[i+1]
+		if marker == 0xFF { // fill byte
+			i++
+			continue
+		}
+		if marker == 0xDA { // start of scan: the rest is image data
+			out = append(out, data[i:]...)
+			if orientation > 1 {
+				seg := orientationSegment(orientation)
+				out = append(out[:exifAt], append(seg, out[exifAt:]...)...)
+			}
+			return out, nil
+		}
+		n := int(binary.BigEndian.Uint16(data[i+2 : i+4]))
+		end := i + 2 + n
+		if n < 2 || end > len(data) {
+			return nil, invalid("JPEG segment overruns the file")
+		}
+		switch {
+		case marker == 0xE1:
+			if o := exifOrientation(data[i+4 : end]); o != 0 {
+				orientation = o
+			}
+		case marker == 0xED, marker == 0xFE:
+		default:
+			if marker == 0xE0 && len(out) == 2 {
+				exifAt = 2 + end - i
+			}
+			out = append(out, data[i:end]...)
+		}
+		i = end
+	}
+}
+
+var exifHeader = []byte("Exif\x00\x00")
+
+// exifOrientation reads the Orientation tag (0x0112) of IFD0 from an APP1
+// payload, or returns 0.
+func exifOrientation(app1 []byte) uint16 {
+	if !bytes.HasPrefix(app1, exifHeader) {
+		return 0
+	}
+	tiff := app1[len(exifHeader):]
+	if len(tiff) < 8 {
+		return 0
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0
+	}
+	ifd := int(order.Uint32(tiff[4:8]))
+	if ifd+2 > len(tiff) {
+		return 0
+	}
+	count := int(order.Uint16(tiff[ifd : ifd+2]))
+	for e := 0; e < count; e++ {
+		off := ifd + 2 + e*12
+		if off+12 > len(tiff) {
+			return 0
+		}
+		if order.Uint16(tiff[off:off+2]) == 0x0112 {
+			if o := order.Uint16(tiff[off+8 : off+10]); o >= 1 && o <= 8 {
+				return o
+			}
+			return 0
+		}
+	}
+	return 0
+}
+
+// orientationSegment is an APP1 segment whose EXIF holds only an
+// Orientation tag.
+func orientationSegment(orientation uint16) []byte {
+	tiff := []byte{
+		'M', 'M', 0x00, 0x2A, 0x00, 0x00, 0x00, 0x08, // big endian, IFD0 at 8
+		0x00, 0x01, // one entry
+		0x01, 0x12, 0x00, 0x03, 0x00, 0x00, 0x00, 0x01, // Orientation, SHORT, 1 value
+		byte(orientation >> 8), byte(orientation), 0x00, 0x00,
+		0x00, 0x00, 0x00, 0x00, // no next IFD
+	}
+	n := 2 + len(exifHeader) + len(tiff)
+	seg := []byte{0xFF, 0xE1, byte(n >> 8), byte(n)}
+	seg = append(seg, exifHeader...)
+	return append(seg, tiff...)
+}
+
+// pngMetadata are the ancillary chunks removed from PNGs.
+var pngMetadata = map[string]bool{"eXIf": true, "tEXt": true, "iTXt": true, "zTXt": true, "tIME": true}
+
+func stripPNG(data []byte) ([]byte, error) {
+	const sig = "\x89PNG\r\n\x1a\n"
+	if !bytes.HasPrefix(data, []byte(sig)) {
+		return nil, invalid("missing PNG signature")
+	}
+	out := make([]byte, 0, len(data))
+	out = append(out, sig...)
+	for i := len(sig); i < len(data); {
+		if i+8 > len(data) {
+			return nil, invalid("truncated PNG chunk")
+		}
+		n := int(binary.BigEndian.Uint32(data[i : i+4]))
+		typ := string(data[i+4 : i+8])
+		end := i + 12 + n // length, type, data, CRC
+		if n < 0 || end > len(data) {
+			return nil, invalid("PNG chunk overruns the file")
+		}
+		if !pngMetadata[typ] {
+			out = append(out, data[i:end]...)
+		}
+		i = end
+		if typ == "IEND" {
+			break
+		}
+	}
+	return out, nil
+}
+
+// VP8X flags announcing EXIF and XMP chunks.
+const (
+	webpFlagXMP  = 0x04
+	webpFlagEXIF = 0x08
+)
+
+func stripWebP(data []byte) ([]byte, error) {
+	if len(data) < 12 || string(data[:4]) != "RIFF" || string(data[8:12]) != "WEBP" {
+		return nil, invalid("missing WebP RIFF header")
+	}
+	riffEnd := 8 + int(binary.LittleEndian.Uint32(data[4:8]))
+	if riffEnd < 12 || riffEnd > len(data) {
+		return nil, invalid("WebP RIFF size overruns the file")
+	}
+	out := make([]byte, 12, len(data))
+	copy(out, data[:12])
+	for i := 12; i < riffEnd; {
+		if i+8 > riffEnd {
+			return nil, invalid("truncated WebP chunk")
+		}
+		typ := string(data[i : i+4])
+		n := int(binary.LittleEndian.Uint32(data[i+4 : i+8]))
+		end := i + 8 + n + n&1 // chunks are padded to even sizes
+		if n < 0 || end > riffEnd {
+			return nil, invalid("WebP chunk overruns the file")
+		}
+		switch typ {
+		case "EXIF", "XMP ":
+		case "VP8X":
+			start := len(out)
+			out = append(out, data[i:end]...)
+			if n > 0 {
+				out[start+8] &^= webpFlagEXIF | webpFlagXMP
+			}
+		default:
+			out = append(out, data[i:end]...)
+		}
+		i = end
+	}
+	binary.LittleEndian.PutUint32(out[4:8], uint32(len(out)-8))
+	return out, nil
+}
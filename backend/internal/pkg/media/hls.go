@@ -0,0 +1,181 @@
+package media
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// HLS file names inside a transcode output directory:
+//
+//	master.m3u8
+//	360p/index.m3u8, 360p/seg_000.ts, ...
+//	720p/index.m3u8, ...
+const (
+	MasterPlaylist  = "master.m3u8"
+	variantPlaylist = "index.m3u8"
+	segmentPattern  = "seg_%03d.ts"
+	segmentSeconds  = 6
+)
+
+// Rendition is one rung of the adaptive bitrate ladder.
+type Rendition struct {
+	Name         string // also the sub-directory, e.g. "720p"
+	Height       uint32
+	VideoBitrate uint64 // bits per second
+	AudioBitrate uint64 // bits per second
+}
+
+// DefaultRenditions is the ladder produced for every upload, smallest first.
+var DefaultRenditions = []Rendition{
+	{Name: "360p", Height: 360, VideoBitrate: 800_000, AudioBitrate: 96_000},
+	{Name: "720p", Height: 720, VideoBitrate: 2_800_000, AudioBitrate: 128_000},
+	{Name: "1080p", Height: 1080, VideoBitrate: 5_000_000, AudioBitrate: 192_000},
+}
+
+// SelectRenditions drops renditions taller than the source, since upscaling
+// only wastes bandwidth. The smallest rendition is always kept.
+func SelectRenditions(ladder []Rendition, sourceHeight uint32) []Rendition {
+	var out []Rendition
+	for _, r := range ladder {
+		if r.Height <= sourceHeight {
+			out = append(out, r)
+		}
+	}
+	if len(out) == 0 && len(ladder) > 0 {
+		out = ladder[:1]
+	}
+	return out
+}
+
+// ContentType returns the MIME type of a file written by FFmpeg.
+func ContentType(name string) string {
+	switch filepath.Ext(name) {
+	case ".m3u8":
+		return "application/vnd.apple.mpegurl"
+	case ".ts":
+		return "video/mp2t"
+	case ".vtt":
+		return "text/vtt"
+	case ".jpg":
+		return "image/jpeg"
+	case ".webp":
+		return "image/webp"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// FFmpeg runs a local ffmpeg binary.
+type FFmpeg struct {
+	Path string
+}
+
+func NewFFmpeg(path string) *FFmpeg {
+	return &FFmpeg{Path: path}
+}
+
+// TranscodeHLS encodes input (a URL or local path) into one H.264/AAC HLS
+// rendition per entry of renditions under outDir, then writes the master
+// playlist. src describes the input: its dimensions advertise each
+// rendition's resolution and its duration drives progress, which, if not
+// nil, is called with the fraction of the whole job done.
+func (f *FFmpeg) TranscodeHLS(ctx context.Context, input, outDir string, src *Info, renditions []Rendition, progress func(done float64)) error {
+	for i, r := range renditions {
+		dir := filepath.Join(outDir, r.Name)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return err
+		}
+		var onTime func(float64)
+		if progress != nil && src.DurationSec > 0 {
+			onTime = func(sec float64) {
+				progress((float64(i) + min(sec/src.DurationSec, 1)) / float64(len(renditions)))
+			}
+		}
+		if err := f.encode(ctx, input, dir, r, onTime); err != nil {
+			return fmt.Errorf("rendition %s: %w", r.Name, err)
+		}
+	}
+	return writeMasterPlaylist(filepath.Join(outDir, MasterPlaylist), src.Width, src.Height, renditions)
+}
+
+// encode runs ffmpeg for one rendition, passing the encoded position in
+// seconds to onTime as ffmpeg reports it.
+func (f *FFmpeg) encode(ctx context.Context, input, dir string, r Rendition, onTime func(sec float64)) error {
+	vb := strconv.FormatUint(r.VideoBitrate, 10)
+	cmd := exec.CommandContext(ctx, f.Path,
+		"-hide_banner", "-loglevel", "error", "-nostats", "-y",
+		"-progress", "pipe:1",
+		"-i", input,
+		"-map", "0:v:0", "-map", "0:a:0?",
+		"-vf", fmt.Sprintf("scale=-2:%d", r.Height),
+		"-c:v", "libx264", "-preset", "veryfast", "-profile:v", "main",
+		"-b:v", vb, "-maxrate", vb, "-bufsize", strconv.FormatUint(2*r.VideoBitrate, 10),
+		// Keyframe every segment so renditions switch cleanly.
+		"-force_key_frames", fmt.Sprintf("expr:gte(t,n_forced*%d)", segmentSeconds),
+		"-c:a", "aac", "-b:a", strconv.FormatUint(r.AudioBitrate, 10), "-ac", "2",
+		"-f", "hls",
+		"-hls_time", strconv.Itoa(segmentSeconds),
+		"-hls_playlist_type", "vod",
+		"-hls_segment_filename", segmentPattern,
+		variantPlaylist,
+	)
+	// Relative output names keep segment URIs in the playlist relative.
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("run ffmpeg: %w", err)
+	}
+	// -progress writes key=value blocks; out_time_us is the position.
+	sc := bufio.NewScanner(stdout)
+	for sc.Scan() {
+		v, ok := strings.CutPrefix(sc.Text(), "out_time_us=")
+		if !ok || onTime == nil {
+			continue
+		}
+		if us, err := strconv.ParseInt(v, 10, 64); err == nil && us >= 0 {
+			onTime(float64(us) / 1e6)
+		}
+	}
+	if err := cmd.Wait(); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return toolError("ffmpeg", err, stderr.String())
+	}
+	return nil
+}
+
+func writeMasterPlaylist(path string, width, height uint32, renditions []Rendition) error {
+	var b strings.Builder
+	b.WriteString("#EXTM3U\n#EXT-X-VERSION:3\n")
+	for _, r := range renditions {
+		fmt.Fprintf(&b, "#EXT-X-STREAM-INF:BANDWIDTH=%d", r.VideoBitrate+r.AudioBitrate)
+		if width > 0 && height > 0 {
+			// Matches ffmpeg's scale=-2:h, which rounds the width to even.
+			w := (uint64(width)*uint64(r.Height)/uint64(height) + 1) &^ 1
+			fmt.Fprintf(&b, ",RESOLUTION=%dx%d", w, r.Height)
+		}
+		fmt.Fprintf(&b, "\n%s/%s\n", r.Name, variantPlaylist)
+	}
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
+
+func lastLine(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.LastIndexByte(s, '\n'); i >= 0 {
+		return s[i+1:]
+	}
+	return s
+}
@@ -0,0 +1,61 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+	"strconv"
+)
+
+// ImageVariant is one resized rendition of a thumbnail.
+type ImageVariant struct {
+	Name  string // suffix of the stored object, e.g. "small"
+	Width int
+}
+
+// ThumbnailVariants are the WebP sizes generated for every thumbnail.
+var ThumbnailVariants = []ImageVariant{
+	{Name: "small", Width: 320},
+	{Name: "medium", Width: 640},
+	{Name: "large", Width: 1280},
+}
+
+// ExtractFrame writes the frame at offset sec of input to out as a JPEG.
+func (f *FFmpeg) ExtractFrame(ctx context.Context, input string, sec float64, out string) error {
+	return f.run(ctx,
+		// -ss before -i seeks on the input, which is fast over HTTP.
+		"-ss", strconv.FormatFloat(sec, 'f', 3, 64),
+		"-i", input,
+		"-frames:v", "1",
+		"-q:v", "2",
+		out,
+	)
+}
+
+// ResizeWebP writes input scaled to width (never upscaled, aspect kept) to
+// out as WebP.
+func (f *FFmpeg) ResizeWebP(ctx context.Context, input string, width int, out string) error {
+	return f.run(ctx,
+		"-i", input,
+		"-frames:v", "1",
+		"-vf", fmt.Sprintf("scale='min(%d,iw)':-2", width),
+		"-c:v", "libwebp", "-quality", "80",
+		out,
+	)
+}
+
+// run runs ffmpeg with args, overwriting outputs and keeping only errors.
+func (f *FFmpeg) run(ctx context.Context, args ...string) error {
+	args = append([]string{"-hide_banner", "-loglevel", "error", "-nostats", "-y"}, args...)
+	cmd := exec.CommandContext(ctx, f.Path, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		return toolError("ffmpeg", err, stderr.String())
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// ErrNotVideo means the input could not be decoded as a video.
+var ErrNotVideo = errors.New("not a decodable video")
+
+// invalidInputErrors are what ffmpeg and ffprobe print when the input
+// itself cannot be read as media.
+var invalidInputErrors = []string{
+	"invalid data found when processing input",
+	"moov atom not found",
+	"could not find codec parameters",
+	"does not contain any stream",
+}
+
+// toolError describes a failed ffmpeg or ffprobe run. Only a complaint
+// about the input wraps ErrNotVideo; anything else, such as a missing
+// encoder or a full disk, is our failure rather than the uploader's.
+func toolError(tool string, err error, stderr string) error {
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return fmt.Errorf("run %s: %w", tool, err)
+	}
+	lower := strings.ToLower(stderr)
+	for _, msg := range invalidInputErrors {
+		if strings.Contains(lower, msg) {
+			return fmt.Errorf("%w: %s", ErrNotVideo, lastLine(stderr))
+		}
+	}
+	return fmt.Errorf("%s: %w: %s", tool, err, lastLine(stderr))
+}
+
+// Info is what a probe learns about a media file.
+type Info struct {
+	DurationSec float64
+	Width       uint32
+	Height      uint32
+	VideoCodec  string
+	AudioCodec  string // empty if there is no audio stream
+	Bitrate     uint64 // bits per second
+	Size        uint64 // bytes
+	FormatName  string
+}
+
+// Prober inspects a media file by URL or local path.
+type Prober interface {
+	Probe(ctx context.Context, input string) (*Info, error)
+}
+
+// FFprobe runs a local ffprobe binary.
+type FFprobe struct {
+	Path string
+}
+
+func NewFFprobe(path string) *FFprobe {
+	return &FFprobe{Path: path}
+}
+
+type ffprobeOutput struct {
+	Streams []struct {
+		CodecType string `json:"codec_type"`
+		CodecName string `json:"codec_name"`
+		Width     uint32 `json:"width"`
+		Height    uint32 `json:"height"`
+	} `json:"streams"`
+	Format struct {
+		FormatName string `json:"format_name"`
+		Duration   string `json:"duration"`
+		Size       string `json:"size"`
+		BitRate    string `json:"bit_rate"`
+	} `json:"format"`
+}
+
+func (p *FFprobe) Probe(ctx context.Context, input string) (*Info, error) {
+	cmd := exec.CommandContext(ctx, p.Path,
+		"-v", "error",
+		"-print_format", "json",
+		"-show_format", "-show_streams",
+		input,
+	)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, toolError("ffprobe", err, stderr.String())
+	}
+
+	var out ffprobeOutput
+	if err := json.Unmarshal(stdout.Bytes(), &out); err != nil {
+		return nil, fmt.Errorf("parse ffprobe output: %w", err)
+	}
+
+	info := &Info{FormatName: out.Format.FormatName}
+	for _, s := range out.Streams {
+		switch s.CodecType {
+		case "video":
+			if info.VideoCodec == "" {
+				info.VideoCodec = s.CodecName
+				info.Width, info.Height = s.Width, s.Height
+			}
+		case "audio":
+			if info.AudioCodec == "" {
+				info.AudioCodec = s.CodecName
+			}
+		}
+	}
+	info.DurationSec, _ = strconv.ParseFloat(out.Format.Duration, 64)
+	info.Size, _ = strconv.ParseUint(out.Format.Size, 10, 64)
+	info.Bitrate, _ = strconv.ParseUint(out.Format.BitRate, 10, 64)
+
+	// Still images (mjpeg/png "video" streams) have no duration.
+	if info.VideoCodec == "" || info.Width == 0 || info.Height == 0 || info.DurationSec <= 0 {
+		return nil, ErrNotVideo
+	}
+	return info, nil
+}
@@ -0,0 +1,88 @@
+package media
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// SpriteLayout describes seek-preview sprite sheets: one frame every
+// Interval, scaled to TileWidth×TileHeight and packed Columns×Rows per
+// sheet, left to right then top to bottom.
+type SpriteLayout struct {
+	Interval   time.Duration
+	TileWidth  int
+	TileHeight int
+	Columns    int
+	Rows       int
+}
+
+// NewSpriteLayout returns the default layout (160px wide tiles, 10×10 per
+// sheet) for a source of width×height taking a frame every interval.
+func NewSpriteLayout(width, height uint32, interval time.Duration) SpriteLayout {
+	l := SpriteLayout{Interval: interval, TileWidth: 160, Columns: 10, Rows: 10}
+	l.TileHeight = 90
+	if width > 0 && height > 0 {
+		// Even, like ffmpeg's scale=w:-2.
+		l.TileHeight = (l.TileWidth*int(height)/int(width) + 1) &^ 1
+	}
+	return l
+}
+
+// SpriteSheets writes sprite sheets of input into outDir as
+// sprite_001.jpg, sprite_002.jpg, ... and returns their file names in order.
+func (f *FFmpeg) SpriteSheets(ctx context.Context, input, outDir string, l SpriteLayout) ([]string, error) {
+	err := f.run(ctx,
+		"-i", input,
+		"-an", "-sn",
+		"-vf", fmt.Sprintf("fps=1/%g,scale=%d:%d,tile=%dx%d",
+			l.Interval.Seconds(), l.TileWidth, l.TileHeight, l.Columns, l.Rows),
+		"-q:v", "4",
+		filepath.Join(outDir, "sprite_%03d.jpg"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	names, err := filepath.Glob(filepath.Join(outDir, "sprite_*.jpg"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(names)
+	for i, n := range names {
+		names[i] = filepath.Base(n)
+	}
+	return names, nil
+}
+
+// WriteThumbnailsVTT writes a WebVTT thumbnails track mapping each interval
+// of a durationSec long video to its tile, as players such as Video.js
+// expect:
+//
+//	00:00:05.000 --> 00:00:10.000
+//	sprite_001.jpg#xywh=160,0,160,90
+func WriteThumbnailsVTT(path string, durationSec float64, l SpriteLayout, sheets []string) error {
+	var b strings.Builder
+	b.WriteString("WEBVTT\n")
+	perSheet := l.Columns * l.Rows
+	step := l.Interval.Seconds()
+	frames := int(math.Ceil(durationSec / step))
+	for i := 0; i < frames && i/perSheet < len(sheets); i++ {
+		start := float64(i) * step
+		end := math.Min(start+step, durationSec)
+		tile := i % perSheet
+		fmt.Fprintf(&b, "\n%s --> %s\n%s#xywh=%d,%d,%d,%d\n",
+			vttTime(start), vttTime(end), sheets[i/perSheet],
+			tile%l.Columns*l.TileWidth, tile/l.Columns*l.TileHeight, l.TileWidth, l.TileHeight)
+	}
+	return os.WriteFile(path, []byte(b.String()), 0o644)
+}
+
+func vttTime(sec float64) string {
+	ms := int64(math.Round(sec * 1000))
+	return fmt.Sprintf("%02d:%02d:%02d.%03d", ms/3_600_000, ms/60_000%60, ms/1000%60, ms%1000)
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"path"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -28,7 +29,7 @@ func (u *MinIOUploader) Upload(ctx context.Context, reader io.Reader, size int64
 		return "", fmt.Errorf("MinIO client not initialized")
 	}
 
-	objectName := fmt.Sprintf("%s/%s-%s%s", dir, time.Now().Format("20060102"), uuid.New().String(), ext)
+	objectName := NewObjectName(dir, ext)
 
 	_, err := u.client.PutObject(ctx, u.bucket, objectName, reader, size, minio.PutObjectOptions{
 		ContentType: contentType,
@@ -40,6 +41,176 @@ func (u *MinIOUploader) Upload(ctx context.Context, reader io.Reader, size int64
 	return objectName, nil
 }
 
+// PutFile uploads the local file at filePath as objectName, replacing any
+// existing object. Used for server-generated media such as HLS renditions.
+func (u *MinIOUploader) PutFile(ctx context.Context, objectName, filePath, contentType string) error {
+	if u.client == nil {
+		return fmt.Errorf("MinIO client not initialized")
+	}
+	_, err := u.client.FPutObject(ctx, u.bucket, objectName, filePath, minio.PutObjectOptions{
+		ContentType: contentType,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload to MinIO: %w", err)
+	}
+	return nil
+}
+
+// Put uploads reader as objectName, replacing any existing object.
+func (u *MinIOUploader) Put(ctx context.Context, objectName string, reader io.Reader, size int64, contentType string) error {
+	return u.PutWithMetadata(ctx, objectName, reader, size, contentType, nil)
+}
+
+// PutWithMetadata is Put with user metadata, e.g. to keep that of the
+// object it replaces.
+func (u *MinIOUploader) PutWithMetadata(ctx context.Context, objectName string, reader io.Reader, size int64, contentType string, meta map[string]string) error {
+	if u.client == nil {
+		return fmt.Errorf("MinIO client not initialized")
+	}
+	_, err := u.client.PutObject(ctx, u.bucket, objectName, reader, size, minio.PutObjectOptions{
+		ContentType:  contentType,
+		UserMetadata: meta,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload to MinIO: %w", err)
+	}
+	return nil
+}
+
+// NewObjectName returns a unique object name such as
+// "videos/20240131-<uuid>.mp4".
+func NewObjectName(dir, ext string) string {
+	return fmt.Sprintf("%s/%s-%s%s", dir, time.Now().Format("20060102"), uuid.New().String(), ext)
+}
+
+// MinPartSize is the smallest size S3 accepts for any multipart part but
+// the last.
+const MinPartSize = 5 << 20
+
+// NewMultipart starts a multipart upload for objectName and returns its ID.
+func (u *MinIOUploader) NewMultipart(ctx context.Context, objectName, contentType string) (string, error) {
+	if u.client == nil {
+		return "", fmt.Errorf("MinIO client not initialized")
+	}
+	core := minio.Core{Client: u.client}
+	return core.NewMultipartUpload(ctx, u.bucket, objectName, minio.PutObjectOptions{ContentType: contentType})
+}
+
+// PutPart uploads one part of a multipart upload.
+func (u *MinIOUploader) PutPart(ctx context.Context, objectName, uploadID string, partNumber int, reader io.Reader, size int64) (minio.CompletePart, error) {
+	if u.client == nil {
+		return minio.CompletePart{}, fmt.Errorf("MinIO client not initialized")
+	}
+	core := minio.Core{Client: u.client}
+	part, err := core.PutObjectPart(ctx, u.bucket, objectName, uploadID, partNumber, reader, size, minio.PutObjectPartOptions{})
+	if err != nil {
+		return minio.CompletePart{}, fmt.Errorf("failed to upload part %d: %w", partNumber, err)
+	}
+	return minio.CompletePart{PartNumber: part.PartNumber, ETag: part.ETag}, nil
+}
+
+// CompleteMultipart assembles the uploaded parts into the final object.
+func (u *MinIOUploader) CompleteMultipart(ctx context.Context, objectName, uploadID string, parts []minio.CompletePart) error {
+	if u.client == nil {
+		return fmt.Errorf("MinIO client not initialized")
+	}
+	core := minio.Core{Client: u.client}
+	_, err := core.CompleteMultipartUpload(ctx, u.bucket, objectName, uploadID, parts, minio.PutObjectOptions{})
+	return err
+}
+
+// AbortMultipart discards a multipart upload and its parts.
+func (u *MinIOUploader) AbortMultipart(ctx context.Context, objectName, uploadID string) error {
+	if u.client == nil {
+		return fmt.Errorf("MinIO client not initialized")
+	}
+	core := minio.Core{Client: u.client}
+	return core.AbortMultipartUpload(ctx, u.bucket, objectName, uploadID)
+}
+
+// PresignPost returns a browser POST policy for objectName that only
+// accepts contentType and at most maxSize bytes. meta is stored as user
+// metadata (x-amz-meta-*) on the object and cannot be altered by the client.
+func (u *MinIOUploader) PresignPost(ctx context.Context, objectName, contentType string, maxSize int64, expires time.Time, meta map[string]string) (string, map[string]string, error) {
+	if u.client == nil {
+		return "", nil, fmt.Errorf("MinIO client not initialized")
+	}
+	policy := minio.NewPostPolicy()
+	for _, err := range []error{
+		policy.SetBucket(u.bucket),
+		policy.SetKey(objectName),
+		policy.SetContentType(contentType),
+		policy.SetContentLengthRange(1, maxSize),
+		policy.SetExpires(expires),
+	} {
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	for k, v := range meta {
+		if err := policy.SetUserMetadata(k, v); err != nil {
+			return "", nil, err
+		}
+	}
+	url, formData, err := u.client.PresignedPostPolicy(ctx, policy)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to presign upload: %w", err)
+	}
+	return url.String(), formData, nil
+}
+
+// PresignGet returns a time-limited GET URL for objectName, for server-side
+// tools such as ffprobe that read the object over HTTP.
+func (u *MinIOUploader) PresignGet(ctx context.Context, objectName string, expires time.Duration) (string, error) {
+	if u.client == nil {
+		return "", fmt.Errorf("MinIO client not initialized")
+	}
+	url, err := u.client.PresignedGetObject(ctx, u.bucket, objectName, expires, nil)
+	if err != nil {
+		return "", err
+	}
+	return url.String(), nil
+}
+
+// ObjectInfo is the object metadata needed to validate an upload.
+type ObjectInfo struct {
+	Size        int64
+	ContentType string
+	ETag        string
+	// UserMetadata keys are canonicalized, e.g. "Uploader".
+	UserMetadata map[string]string
+}
+
+// Stat returns metadata of objectName.
+func (u *MinIOUploader) Stat(ctx context.Context, objectName string) (*ObjectInfo, error) {
+	if u.client == nil {
+		return nil, fmt.Errorf("MinIO client not initialized")
+	}
+	info, err := u.client.StatObject(ctx, u.bucket, objectName, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return &ObjectInfo{Size: info.Size, ContentType: info.ContentType, ETag: info.ETag, UserMetadata: info.UserMetadata}, nil
+}
+
+// Copy copies src to dst with its metadata, failing if src no longer has
+// etag because it was overwritten meanwhile. Objects over 5GB are copied
+// in parts.
+func (u *MinIOUploader) Copy(ctx context.Context, src, dst, etag string) error {
+	if u.client == nil {
+		return fmt.Errorf("MinIO client not initialized")
+	}
+	_, err := u.client.ComposeObject(ctx,
+		minio.CopyDestOptions{Bucket: u.bucket, Object: dst},
+		minio.CopySrcOptions{Bucket: u.bucket, Object: src, MatchETag: etag})
+	return err
+}
+
+// IsNotFound reports whether err means the object does not exist.
+func IsNotFound(err error) bool {
+	return minio.ToErrorResponse(err).Code == "NoSuchKey"
+}
+
 func (u *MinIOUploader) Delete(ctx context.Context, objectName string) error {
 	if u.client == nil {
 		return fmt.Errorf("MinIO client not initialized")
@@ -47,10 +218,93 @@ func (u *MinIOUploader) Delete(ctx context.Context, objectName string) error {
 	return u.client.RemoveObject(ctx, u.bucket, objectName, minio.RemoveObjectOptions{})
 }
 
+// ListedObject is an object found by Walk.
+type ListedObject struct {
+	Name         string
+	Size         int64
+	LastModified time.Time
+}
+
+// Walk calls fn for every object whose name starts with prefix, in name
+// order, stopping at the first error fn returns.
+func (u *MinIOUploader) Walk(ctx context.Context, prefix string, fn func(ListedObject) error) error {
+	if u.client == nil {
+		return fmt.Errorf("MinIO client not initialized")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel() // stops the listing if fn fails
+	for obj := range u.client.ListObjects(ctx, u.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if obj.Err != nil {
+			return obj.Err
+		}
+		if err := fn(ListedObject{Name: obj.Key, Size: obj.Size, LastModified: obj.LastModified}); err != nil {
+			return err
+		}
+	}
+	return ctx.Err()
+}
+
+// DeleteMany removes objects in bulk, returning the first failure.
+func (u *MinIOUploader) DeleteMany(ctx context.Context, objectNames []string) error {
+	if u.client == nil {
+		return fmt.Errorf("MinIO client not initialized")
+	}
+	objects := make(chan minio.ObjectInfo, len(objectNames))
+	for _, name := range objectNames {
+		objects <- minio.ObjectInfo{Key: name}
+	}
+	close(objects)
+	var first error
+	// Drain the results so the remover does not block.
+	for e := range u.client.RemoveObjects(ctx, u.bucket, objects, minio.RemoveObjectsOptions{}) {
+		if first == nil {
+			first = fmt.Errorf("remove %s: %w", e.ObjectName, e.Err)
+		}
+	}
+	return first
+}
+
+// DeletePrefix removes every object whose name starts with prefix.
+func (u *MinIOUploader) DeletePrefix(ctx context.Context, prefix string) error {
+	var names []string
+	err := u.Walk(ctx, prefix, func(obj ListedObject) error {
+		names = append(names, obj.Name)
+		return nil
+	})
+	if err != nil || len(names) == 0 {
+		return err
+	}
+	return u.DeleteMany(ctx, names)
+}
+
+// Open returns a seekable reader for objectName along with its metadata.
+func (u *MinIOUploader) Open(ctx context.Context, objectName string) (*minio.Object, minio.ObjectInfo, error) {
+	if u.client == nil {
+		return nil, minio.ObjectInfo{}, fmt.Errorf("MinIO client not initialized")
+	}
+	obj, err := u.client.GetObject(ctx, u.bucket, objectName, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, minio.ObjectInfo{}, err
+	}
+	info, err := obj.Stat()
+	if err != nil {
+		obj.Close()
+		return nil, minio.ObjectInfo{}, err
+	}
+	return obj, info, nil
+}
+
 func (u *MinIOUploader) GetURL(objectName string) string {
 	return fmt.Sprintf("/%s/%s", u.bucket, objectName)
 }
 
+// ObjectName is the inverse of GetURL. It reports false for URLs that do not
+// point into this bucket.
+func (u *MinIOUploader) ObjectName(url string) (string, bool) {
+	name, ok := strings.CutPrefix(url, "/"+u.bucket+"/")
+	return name, ok && name != ""
+}
+
 func ExtFromContentType(contentType string) string {
 	switch contentType {
 	case "video/mp4":
@@ -22,6 +22,8 @@ func NewGRPCServer(
 	searchSvc *service.SearchService,
 	channelSvc *service.ChannelService,
 	adminSvc *service.AdminService,
+	uploadSvc *service.UploadService,
+	captionSvc *service.CaptionService,
 ) *grpc.Server {
 	var opts = []grpc.ServerOption{
 		grpc.Middleware(
@@ -29,6 +31,7 @@ func NewGRPCServer(
 			JWTAuthMiddleware(ac.JwtSecret),
 			AdminGuardMiddleware(),
 		),
+		grpc.StreamInterceptor(JWTStreamInterceptor(ac.JwtSecret)),
 	}
 	if c.Grpc.Network != "" {
 		opts = append(opts, grpc.Network(c.Grpc.Network))
@@ -49,6 +52,8 @@ func NewGRPCServer(
 	v1.RegisterSearchServiceServer(srv, searchSvc)
 	v1.RegisterChannelServiceServer(srv, channelSvc)
 	v1.RegisterAdminServiceServer(srv, adminSvc)
+	v1.RegisterUploadServiceServer(srv, uploadSvc)
+	v1.RegisterCaptionServiceServer(srv, captionSvc)
 
 	return srv
 }
@@ -3,14 +3,16 @@ package server
 import (
 	"context"
 	"fmt"
-	"io"
 	"net/http"
 
 	v1 "backend/api/fenzvideo/v1"
 	"backend/internal/conf"
+	"backend/internal/pkg/authctx"
+	"backend/internal/pkg/playback"
 	"backend/internal/pkg/upload"
 	"backend/internal/service"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
 	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
@@ -28,7 +30,10 @@ func NewHTTPServer(
 	searchSvc *service.SearchService,
 	channelSvc *service.ChannelService,
 	adminSvc *service.AdminService,
+	uploadSvc *service.UploadService,
+	captionSvc *service.CaptionService,
 	uploader *upload.MinIOUploader,
+	signer *playback.Signer,
 ) *kratoshttp.Server {
 	var opts = []kratoshttp.ServerOption{
 		kratoshttp.Middleware(
@@ -56,29 +61,49 @@ func NewHTTPServer(
 	v1.RegisterSearchServiceHTTPServer(srv, searchSvc)
 	v1.RegisterChannelServiceHTTPServer(srv, channelSvc)
 	v1.RegisterAdminServiceHTTPServer(srv, adminSvc)
+	v1.RegisterUploadServiceHTTPServer(srv, uploadSvc)
+	v1.RegisterCaptionServiceHTTPServer(srv, captionSvc)
 
-	// Two-step file upload endpoints (not proto-generated, since gRPC doesn't support multipart)
+	// Two-step file upload endpoints (not proto-generated, since gRPC doesn't support
+	// multipart; gRPC clients stream to UploadService.UploadVideo instead)
 	route := srv.Route("/")
-	route.POST("/api/v1/upload/video", handleUpload(uploader, "videos", []string{
-		"video/mp4", "video/webm", "video/quicktime",
-	}, 500<<20, logger)) // 500MB max
-	route.POST("/api/v1/upload/thumbnail", handleUpload(uploader, "thumbnails", []string{
-		"image/jpeg", "image/png", "image/webp",
-	}, 10<<20, logger)) // 10MB max
+	route.POST("/api/v1/upload/video", handleUpload(uploadSvc, "video", 500<<20, ac.JwtSecret, logger))        // 500MB max
+	route.POST("/api/v1/upload/thumbnail", handleUpload(uploadSvc, "thumbnail", 10<<20, ac.JwtSecret, logger)) // 10MB max
+
+	// Resumable (tus) uploads for large videos
+	registerTusRoutes(route, uploadSvc, ac.JwtSecret, logger)
+
+	// Signed playback URLs issued by GetVideo (the bucket itself is private)
+	route.GET(playback.MediaPath+"{object:.+}", handleMedia(uploader, signer, ac.JwtSecret, logger))
+	// Signed HLS playlists and segments of transcoded videos
+	route.GET(playback.HLSPath+"{uid}/{exp}/{sig}/{object:.+}", handleHLS(uploader, signer, ac.JwtSecret, logger))
+	// Authorized byte-range streaming by video ID
+	route.GET("/api/v1/stream/{video_id}", handleStream(videoSvc, uploader, ac.JwtSecret, logger))
+	// WebVTT chapters track, for a <track> element
+	route.GET(service.ChaptersPath, handleChapters(videoSvc, ac.JwtSecret))
 
 	return srv
 }
 
-// handleUpload creates an HTTP handler for file uploads to MinIO.
-// dir: MinIO subdirectory (e.g. "videos", "thumbnails")
-// allowedTypes: permitted Content-Types
-// maxSize: maximum file size in bytes
-func handleUpload(uploader *upload.MinIOUploader, dir string, allowedTypes []string, maxSize int64, logger log.Logger) kratoshttp.HandlerFunc {
+// handleUpload creates an HTTP handler for multipart file uploads to MinIO,
+// recorded as the caller's uploads until a video uses them.
+// kind: upload kind (e.g. "video", "thumbnail"), which sets the accepted
+// Content-Types
+// maxSize: maximum request size in bytes
+func handleUpload(uploadSvc *service.UploadService, kind string, maxSize int64, jwtSecret string, logger log.Logger) kratoshttp.HandlerFunc {
+	l := log.NewHelper(logger)
 	return func(ctx kratoshttp.Context) error {
-		l := log.NewHelper(logger)
-
 		r := ctx.Request()
 		w := ctx.Response()
+		w.Header().Set("Content-Type", "application/json")
+
+		// Custom routes bypass the auth middleware.
+		vctx := viewerContext(r, jwtSecret)
+		if _, ok := authctx.UserIDFromContext(vctx); !ok {
+			w.WriteHeader(http.StatusUnauthorized)
+			fmt.Fprintf(w, `{"error":"login required"}`)
+			return nil
+		}
 
 		// Enforce max upload size
 		r.Body = http.MaxBytesReader(w, r.Body, maxSize)
@@ -91,32 +116,19 @@ func handleUpload(uploader *upload.MinIOUploader, dir string, allowedTypes []str
 		}
 		defer file.Close()
 
-		ct := header.Header.Get("Content-Type")
-		allowed := false
-		for _, t := range allowedTypes {
-			if ct == t {
-				allowed = true
-				break
-			}
-		}
-		if !allowed {
-			w.WriteHeader(http.StatusBadRequest)
-			fmt.Fprintf(w, `{"error":"unsupported content type: %s"}`, ct)
-			return nil
-		}
-
-		ext := upload.ExtFromContentType(ct)
-		objectPath, err := uploader.Upload(context.Background(), io.Reader(file), header.Size, ct, dir, ext)
+		// Storing a large file outlives the server timeout.
+		u, err := uploadSvc.UploadFile(context.WithoutCancel(vctx), kind, header.Header.Get("Content-Type"), header.Size, file)
 		if err != nil {
-			l.Errorf("upload failed: %v", err)
-			w.WriteHeader(http.StatusInternalServerError)
-			fmt.Fprintf(w, `{"error":"upload failed"}`)
+			e := errors.FromError(err)
+			if e.Code >= http.StatusInternalServerError {
+				l.Errorf("upload failed: %v", err)
+			}
+			w.WriteHeader(int(e.Code))
+			fmt.Fprintf(w, `{"error":"%s"}`, e.Message)
 			return nil
 		}
 
-		url := uploader.GetURL(objectPath)
-		w.Header().Set("Content-Type", "application/json")
-		fmt.Fprintf(w, `{"url":"%s","path":"%s"}`, url, objectPath)
+		fmt.Fprintf(w, `{"url":"%s","path":"%s"}`, u.URL, u.ObjectName)
 		return nil
 	}
 }
@@ -0,0 +1,183 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"backend/internal/pkg/authctx"
+	pjwt "backend/internal/pkg/jwt"
+	"backend/internal/pkg/playback"
+	"backend/internal/pkg/upload"
+	"backend/internal/service"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// handleMedia serves a video object after verifying its signed playback URL
+// against the requesting viewer.
+func handleMedia(uploader *upload.MinIOUploader, signer *playback.Signer, jwtSecret string, logger log.Logger) kratoshttp.HandlerFunc {
+	return func(ctx kratoshttp.Context) error {
+		r := ctx.Request()
+		w := ctx.Response()
+
+		object := ctx.Vars().Get("object")
+		if err := signer.Verify(object, r.URL.Query(), viewerID(r, jwtSecret)); err != nil {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, `{"error":"%s"}`, err.Error())
+			return nil
+		}
+
+		serveObject(w, r, uploader, object, log.NewHelper(logger))
+		return nil
+	}
+}
+
+// handleHLS serves playlists and segments under a SignHLSURL signature.
+// The viewer is identified as for handleMedia; players fetching segments
+// of a signed-in viewer's playlist must send the Authorization header.
+func handleHLS(uploader *upload.MinIOUploader, signer *playback.Signer, jwtSecret string, logger log.Logger) kratoshttp.HandlerFunc {
+	return func(ctx kratoshttp.Context) error {
+		r := ctx.Request()
+		w := ctx.Response()
+
+		vars := ctx.Vars()
+		object := vars.Get("object")
+		if err := signer.VerifyHLS(object, vars.Get("uid"), vars.Get("exp"), vars.Get("sig"), viewerID(r, jwtSecret)); err != nil {
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprintf(w, `{"error":"%s"}`, err.Error())
+			return nil
+		}
+
+		serveObject(w, r, uploader, object, log.NewHelper(logger))
+		return nil
+	}
+}
+
+// handleStream streams a video by ID after the same access checks as
+// GetVideo. It is the single enforcement point for paid content: the
+// viewer is identified by the Authorization header or, since <video>
+// elements cannot set headers, an access_token query parameter.
+func handleStream(videoSvc *service.VideoService, uploader *upload.MinIOUploader, jwtSecret string, logger log.Logger) kratoshttp.HandlerFunc {
+	return func(ctx kratoshttp.Context) error {
+		l := log.NewHelper(logger)
+
+		r := ctx.Request()
+		w := ctx.Response()
+
+		videoID, err := strconv.ParseUint(ctx.Vars().Get("video_id"), 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error":"invalid video_id"}`)
+			return nil
+		}
+
+		video, err := videoSvc.StreamVideo(viewerContext(r, jwtSecret), videoID)
+		if err != nil {
+			e := errors.FromError(err)
+			w.WriteHeader(int(e.Code))
+			fmt.Fprintf(w, `{"error":"%s"}`, e.Message)
+			return nil
+		}
+
+		object, ok := uploader.ObjectName(video.VideoURL)
+		if !ok {
+			l.Warnf("video %d has no stored object: %s", videoID, video.VideoURL)
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, `{"error":"not found"}`)
+			return nil
+		}
+
+		serveObject(w, r, uploader, object, l)
+		return nil
+	}
+}
+
+// handleChapters serves a video's chapters as WebVTT after the same access
+// checks as GetVideo.
+func handleChapters(videoSvc *service.VideoService, jwtSecret string) kratoshttp.HandlerFunc {
+	return func(ctx kratoshttp.Context) error {
+		r := ctx.Request()
+		w := ctx.Response()
+
+		videoID, err := strconv.ParseUint(ctx.Vars().Get("video_id"), 10, 64)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, `{"error":"invalid video_id"}`)
+			return nil
+		}
+
+		vtt, err := videoSvc.ChaptersVTT(viewerContext(r, jwtSecret), videoID)
+		if err != nil {
+			e := errors.FromError(err)
+			w.WriteHeader(int(e.Code))
+			fmt.Fprintf(w, `{"error":"%s"}`, e.Message)
+			return nil
+		}
+
+		w.Header().Set("Content-Type", "text/vtt; charset=utf-8")
+		w.Header().Set("Cache-Control", "private, no-cache")
+		w.Write(vtt)
+		return nil
+	}
+}
+
+// viewerContext attaches the caller's identity to the request context when
+// it carries a valid token. Invalid or missing tokens yield a guest viewer.
+// A share_token query parameter is passed on for the access checks.
+func viewerContext(r *http.Request, jwtSecret string) context.Context {
+	ctx := authctx.WithShareToken(r.Context(), r.URL.Query().Get("share_token"))
+	tokenStr := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if tokenStr == "" {
+		tokenStr = r.URL.Query().Get("access_token")
+	}
+	if tokenStr == "" {
+		return ctx
+	}
+	claims, err := pjwt.ParseToken(jwtSecret, tokenStr)
+	if err != nil {
+		return ctx
+	}
+	ctx = authctx.WithUserID(ctx, claims.UserID)
+	return authctx.WithRole(ctx, claims.Role)
+}
+
+// viewerID returns the ID of the viewer identified as by viewerContext, or
+// 0 for guests.
+func viewerID(r *http.Request, jwtSecret string) uint64 {
+	uid, _ := authctx.UserIDFromContext(viewerContext(r, jwtSecret))
+	return uid
+}
+
+// serveObject streams a MinIO object with Content-Type, ETag and
+// Last-Modified set; http.ServeContent then handles Range, If-Range and
+// conditional requests so the player can seek.
+func serveObject(w http.ResponseWriter, r *http.Request, uploader *upload.MinIOUploader, object string, l *log.Helper) {
+	// Detach from the server timeout (1s by default): a video response
+	// outlives it. The read still stops when the client goes away.
+	obj, info, err := uploader.Open(context.WithoutCancel(r.Context()), object)
+	if err != nil {
+		if upload.IsNotFound(err) {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintf(w, `{"error":"not found"}`)
+			return
+		}
+		l.Errorf("open media %s failed: %v", object, err)
+		w.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprintf(w, `{"error":"failed to open media"}`)
+		return
+	}
+	defer obj.Close()
+
+	w.Header().Set("Content-Type", info.ContentType)
+	w.Header().Set("Cache-Control", "private, no-cache")
+	if info.ETag != "" {
+		w.Header().Set("ETag", `"`+strings.Trim(info.ETag, `"`)+`"`)
+	}
+	http.ServeContent(w, r, path.Base(object), info.LastModified, obj)
+}
@@ -10,6 +10,8 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 // publicPaths are Kratos operation names that do not require authentication.
@@ -24,6 +26,7 @@ var publicPaths = map[string]bool{
 var publicPrefixes = []string{
 	"/fenzvideo.v1.VideoService/GetRecommended",
 	"/fenzvideo.v1.VideoService/GetVideo",
+	"/fenzvideo.v1.CaptionService/ListCaptions",
 	"/fenzvideo.v1.SearchService/",
 	"/fenzvideo.v1.CategoryService/",
 	"/fenzvideo.v1.ChannelService/GetChannel",
@@ -80,6 +83,41 @@ func JWTAuthMiddleware(jwtSecret string) middleware.Middleware {
 	}
 }
 
+// JWTStreamInterceptor authenticates streaming gRPC calls, which Kratos
+// middleware does not cover. Every streaming RPC requires a token.
+func JWTStreamInterceptor(jwtSecret string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		md, _ := metadata.FromIncomingContext(ss.Context())
+		authHeader := strings.Join(md.Get("authorization"), "")
+		if authHeader == "" {
+			return errors.Unauthorized("UNAUTHORIZED", "missing authorization header")
+		}
+
+		tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
+		if tokenStr == authHeader {
+			return errors.Unauthorized("UNAUTHORIZED", "invalid authorization format")
+		}
+
+		claims, err := pjwt.ParseToken(jwtSecret, tokenStr)
+		if err != nil {
+			return errors.Unauthorized("TOKEN_INVALID", "invalid or expired token")
+		}
+
+		ctx := authctx.WithUserID(ss.Context(), claims.UserID)
+		ctx = authctx.WithRole(ctx, claims.Role)
+		return handler(srv, &authedStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+type authedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authedStream) Context() context.Context {
+	return s.ctx
+}
+
 func AdminGuardMiddleware() middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (interface{}, error) {
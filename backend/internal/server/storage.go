@@ -0,0 +1,52 @@
+package server
+
+import (
+	"context"
+
+	"backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// StorageReconciler periodically garbage-collects the media bucket next to
+// the HTTP and gRPC servers. It does nothing unless an interval is set.
+type StorageReconciler struct {
+	uc      *biz.StorageUsecase
+	ctx     context.Context
+	cancel  context.CancelFunc
+	stopped chan struct{}
+	log     *log.Helper
+}
+
+func NewStorageReconciler(uc *biz.StorageUsecase, logger log.Logger) *StorageReconciler {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &StorageReconciler{
+		uc:      uc,
+		ctx:     ctx,
+		cancel:  cancel,
+		stopped: make(chan struct{}),
+		log:     log.NewHelper(logger),
+	}
+}
+
+func (w *StorageReconciler) Start(context.Context) error {
+	defer close(w.stopped)
+	if !w.uc.Enabled() {
+		return nil
+	}
+	w.log.Info("storage reconciler started")
+	w.uc.Run(w.ctx)
+	return nil
+}
+
+// Stop interrupts a reconciliation in flight; the next run picks up where
+// it left off.
+func (w *StorageReconciler) Stop(ctx context.Context) error {
+	w.cancel()
+	select {
+	case <-w.stopped:
+		w.log.Info("storage reconciler stopped")
+	case <-ctx.Done():
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package server
+
+import (
+	"context"
+
+	"backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TranscodeWorker runs transcode jobs next to the HTTP and gRPC servers.
+// It implements transport.Server so the app starts and stops it with them;
+// an instance without NATS or ffmpeg simply runs no jobs.
+type TranscodeWorker struct {
+	uc      *biz.TranscodeUsecase
+	ctx     context.Context
+	cancel  context.CancelFunc
+	stopped chan struct{}
+	log     *log.Helper
+}
+
+func NewTranscodeWorker(uc *biz.TranscodeUsecase, logger log.Logger) *TranscodeWorker {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &TranscodeWorker{
+		uc:      uc,
+		ctx:     ctx,
+		cancel:  cancel,
+		stopped: make(chan struct{}),
+		log:     log.NewHelper(logger),
+	}
+}
+
+func (w *TranscodeWorker) Start(context.Context) error {
+	defer close(w.stopped)
+	if !w.uc.Enabled() {
+		return nil
+	}
+	w.log.Info("transcode worker started")
+	// Jobs must finish on Stop, not when the start context is canceled.
+	if err := w.uc.Run(w.ctx); err != nil {
+		w.log.Errorf("transcode worker: %v", err)
+	}
+	return nil
+}
+
+// Stop cancels the job in flight, which stays unacknowledged and is
+// redelivered to the next worker.
+func (w *TranscodeWorker) Stop(ctx context.Context) error {
+	w.cancel()
+	select {
+	case <-w.stopped:
+		w.log.Info("transcode worker stopped")
+	case <-ctx.Done():
+	}
+	return nil
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+
+	"backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// TrashPurger purges videos that have been in the trash longer than the
+// retention period, next to the HTTP and gRPC servers.
+type TrashPurger struct {
+	uc      *biz.TrashUsecase
+	ctx     context.Context
+	cancel  context.CancelFunc
+	stopped chan struct{}
+	log     *log.Helper
+}
+
+func NewTrashPurger(uc *biz.TrashUsecase, logger log.Logger) *TrashPurger {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &TrashPurger{
+		uc:      uc,
+		ctx:     ctx,
+		cancel:  cancel,
+		stopped: make(chan struct{}),
+		log:     log.NewHelper(logger),
+	}
+}
+
+func (w *TrashPurger) Start(context.Context) error {
+	defer close(w.stopped)
+	w.log.Info("trash purger started")
+	w.uc.Run(w.ctx)
+	return nil
+}
+
+// Stop interrupts a purge in flight; videos not yet purged are picked up
+// by the next run.
+func (w *TrashPurger) Stop(ctx context.Context) error {
+	w.cancel()
+	select {
+	case <-w.stopped:
+		w.log.Info("trash purger stopped")
+	case <-ctx.Done():
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package server
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"backend/internal/biz"
+	"backend/internal/service"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// Resumable uploads implement tus 1.0 (https://tus.io/protocols/resumable-upload)
+// with the creation, expiration and termination extensions:
+//
+//	OPTIONS /api/v1/uploads        capabilities
+//	POST    /api/v1/uploads        create (Upload-Length, Upload-Metadata filetype)
+//	HEAD    /api/v1/uploads/{id}   current Upload-Offset
+//	PATCH   /api/v1/uploads/{id}   append a chunk at Upload-Offset
+//	DELETE  /api/v1/uploads/{id}   terminate
+//
+// Once Upload-Offset reaches Upload-Length, pass the upload ID to
+// CreateVideo as upload_id.
+const (
+	tusBasePath      = "/api/v1/uploads"
+	tusVersion       = "1.0.0"
+	tusExtensions    = "creation,expiration,termination"
+	tusOffsetContent = "application/offset+octet-stream"
+)
+
+func registerTusRoutes(route *kratoshttp.Router, uploadSvc *service.UploadService, jwtSecret string, logger log.Logger) {
+	h := &tusHandler{svc: uploadSvc, jwtSecret: jwtSecret, log: log.NewHelper(logger)}
+	route.OPTIONS(tusBasePath, h.options)
+	route.POST(tusBasePath, h.create)
+	route.HEAD(tusBasePath+"/{id}", h.head)
+	route.PATCH(tusBasePath+"/{id}", h.patch)
+	route.DELETE(tusBasePath+"/{id}", h.terminate)
+}
+
+type tusHandler struct {
+	svc       *service.UploadService
+	jwtSecret string
+	log       *log.Helper
+}
+
+func (h *tusHandler) options(ctx kratoshttp.Context) error {
+	w := ctx.Response()
+	w.Header().Set("Tus-Resumable", tusVersion)
+	w.Header().Set("Tus-Version", tusVersion)
+	w.Header().Set("Tus-Extension", tusExtensions)
+	w.Header().Set("Tus-Max-Size", strconv.FormatInt(biz.MaxResumableUploadSize, 10))
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (h *tusHandler) create(ctx kratoshttp.Context) error {
+	r, w := ctx.Request(), ctx.Response()
+	if !h.checkVersion(w, r) {
+		return nil
+	}
+
+	length, err := strconv.ParseInt(r.Header.Get("Upload-Length"), 10, 64)
+	if err != nil {
+		h.fail(w, errors.BadRequest("UPLOAD_INVALID", "Upload-Length is required"))
+		return nil
+	}
+	metadata, err := parseTusMetadata(r.Header.Get("Upload-Metadata"))
+	if err != nil {
+		h.fail(w, errors.BadRequest("UPLOAD_INVALID", "malformed Upload-Metadata"))
+		return nil
+	}
+
+	up, err := h.svc.CreateResumable(viewerContext(r, h.jwtSecret), length, metadata)
+	if err != nil {
+		h.fail(w, err)
+		return nil
+	}
+	w.Header().Set("Tus-Resumable", tusVersion)
+	w.Header().Set("Location", tusBasePath+"/"+up.ID)
+	w.Header().Set("Upload-Expires", up.ExpiresAt.UTC().Format(http.TimeFormat))
+	w.WriteHeader(http.StatusCreated)
+	return nil
+}
+
+func (h *tusHandler) head(ctx kratoshttp.Context) error {
+	r, w := ctx.Request(), ctx.Response()
+	if !h.checkVersion(w, r) {
+		return nil
+	}
+
+	up, err := h.svc.GetResumable(viewerContext(r, h.jwtSecret), ctx.Vars().Get("id"))
+	if err != nil {
+		h.fail(w, err)
+		return nil
+	}
+	h.writeState(w, up)
+	w.Header().Set("Upload-Length", strconv.FormatInt(up.Length, 10))
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (h *tusHandler) patch(ctx kratoshttp.Context) error {
+	r, w := ctx.Request(), ctx.Response()
+	if !h.checkVersion(w, r) {
+		return nil
+	}
+	if r.Header.Get("Content-Type") != tusOffsetContent {
+		h.fail(w, errors.New(http.StatusUnsupportedMediaType, "UPLOAD_INVALID", "Content-Type must be "+tusOffsetContent))
+		return nil
+	}
+	offset, err := strconv.ParseInt(r.Header.Get("Upload-Offset"), 10, 64)
+	if err != nil {
+		h.fail(w, errors.BadRequest("UPLOAD_INVALID", "Upload-Offset is required"))
+		return nil
+	}
+
+	// Chunks can take far longer than the server timeout to arrive.
+	reqCtx := context.WithoutCancel(viewerContext(r, h.jwtSecret))
+	up, err := h.svc.AppendResumable(reqCtx, ctx.Vars().Get("id"), offset, r.Body)
+	if err != nil {
+		h.fail(w, err)
+		return nil
+	}
+	h.writeState(w, up)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (h *tusHandler) terminate(ctx kratoshttp.Context) error {
+	r, w := ctx.Request(), ctx.Response()
+	if !h.checkVersion(w, r) {
+		return nil
+	}
+
+	if err := h.svc.TerminateResumable(viewerContext(r, h.jwtSecret), ctx.Vars().Get("id")); err != nil {
+		h.fail(w, err)
+		return nil
+	}
+	w.Header().Set("Tus-Resumable", tusVersion)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (h *tusHandler) checkVersion(w http.ResponseWriter, r *http.Request) bool {
+	if r.Header.Get("Tus-Resumable") == tusVersion {
+		return true
+	}
+	w.Header().Set("Tus-Version", tusVersion)
+	w.WriteHeader(http.StatusPreconditionFailed)
+	return false
+}
+
+func (h *tusHandler) writeState(w http.ResponseWriter, up *biz.ResumableUpload) {
+	w.Header().Set("Tus-Resumable", tusVersion)
+	w.Header().Set("Upload-Offset", strconv.FormatInt(up.Offset, 10))
+	w.Header().Set("Upload-Expires", up.ExpiresAt.UTC().Format(http.TimeFormat))
+}
+
+func (h *tusHandler) fail(w http.ResponseWriter, err error) {
+	e := errors.FromError(err)
+	if e.Code >= http.StatusInternalServerError {
+		h.log.Errorf("tus upload: %v", err)
+	}
+	w.Header().Set("Tus-Resumable", tusVersion)
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(int(e.Code))
+	fmt.Fprintf(w, `{"error":"%s","reason":"%s"}`, e.Message, e.Reason)
+}
+
+// parseTusMetadata decodes "key base64value,key2 base64value2".
+func parseTusMetadata(header string) (map[string]string, error) {
+	md := make(map[string]string)
+	if header == "" {
+		return md, nil
+	}
+	for _, pair := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		if key == "" {
+			return nil, fmt.Errorf("empty metadata key")
+		}
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, err
+		}
+		md[key] = string(decoded)
+	}
+	return md, nil
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"context"
+
+	"backend/internal/biz"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// UploadSweeper discards the parts of expired resumable uploads next to the
+// HTTP and gRPC servers.
+type UploadSweeper struct {
+	uc      *biz.UploadUsecase
+	ctx     context.Context
+	cancel  context.CancelFunc
+	stopped chan struct{}
+	log     *log.Helper
+}
+
+func NewUploadSweeper(uc *biz.UploadUsecase, logger log.Logger) *UploadSweeper {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &UploadSweeper{
+		uc:      uc,
+		ctx:     ctx,
+		cancel:  cancel,
+		stopped: make(chan struct{}),
+		log:     log.NewHelper(logger),
+	}
+}
+
+func (w *UploadSweeper) Start(context.Context) error {
+	defer close(w.stopped)
+	w.log.Info("upload sweeper started")
+	w.uc.Run(w.ctx)
+	return nil
+}
+
+// Stop interrupts a sweep in flight; uploads not yet aborted are picked up
+// by the next run.
+func (w *UploadSweeper) Stop(ctx context.Context) error {
+	w.cancel()
+	select {
+	case <-w.stopped:
+		w.log.Info("upload sweeper stopped")
+	case <-ctx.Done():
+	}
+	return nil
+}
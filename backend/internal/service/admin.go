@@ -6,19 +6,23 @@ import (
 	v1 "backend/api/fenzvideo/v1"
 	"backend/internal/biz"
 	"backend/internal/pkg/authctx"
+
+	"github.com/go-kratos/kratos/v2/errors"
 )
 
 type AdminService struct {
 	v1.UnimplementedAdminServiceServer
-	uc *biz.AdminUsecase
+	uc      *biz.AdminUsecase
+	storage *biz.StorageUsecase
+	trash   *biz.TrashUsecase
 }
 
-func NewAdminService(uc *biz.AdminUsecase) *AdminService {
-	return &AdminService{uc: uc}
+func NewAdminService(uc *biz.AdminUsecase, storage *biz.StorageUsecase, trash *biz.TrashUsecase) *AdminService {
+	return &AdminService{uc: uc, storage: storage, trash: trash}
 }
 
 func (s *AdminService) AdminListUsers(ctx context.Context, req *v1.AdminListUsersRequest) (*v1.AdminListUsersReply, error) {
-	users, total, err := s.uc.ListUsers(ctx, req.Page, req.PageSize)
+	users, total, next, err := s.uc.ListUsers(ctx, req.Page, req.PageSize, req.Cursor)
 	if err != nil {
 		return nil, err
 	}
@@ -36,8 +40,9 @@ func (s *AdminService) AdminListUsers(ctx context.Context, req *v1.AdminListUser
 	}
 
 	return &v1.AdminListUsersReply{
-		Users: items,
-		Total: total,
+		Users:      items,
+		Total:      pageTotal(req.Cursor, total),
+		NextCursor: next,
 	}, nil
 }
 
@@ -50,7 +55,7 @@ func (s *AdminService) AdminDeleteUser(ctx context.Context, req *v1.AdminDeleteU
 }
 
 func (s *AdminService) AdminListVideos(ctx context.Context, req *v1.AdminListVideosRequest) (*v1.AdminListVideosReply, error) {
-	videos, total, err := s.uc.ListAllVideos(ctx, req.Page, req.PageSize)
+	videos, total, next, err := s.uc.ListAllVideos(ctx, req.Page, req.PageSize, req.Cursor)
 	if err != nil {
 		return nil, err
 	}
@@ -64,17 +69,20 @@ func (s *AdminService) AdminListVideos(ctx context.Context, req *v1.AdminListVid
 			UserId:         v.UserID,
 			CategoryName:   v.CategoryName,
 			AccessTier:     int32(v.AccessTier),
-			IsPublished:    v.IsPublished,
+			IsPublished:    v.Visibility == biz.VisibilityPublished,
 			IsHidden:       v.IsHidden,
 			ViewsMember:    v.ViewsMember,
 			ViewsNonMember: v.ViewsNonMember,
 			CreatedAt:      v.CreatedAt.Format("2006-01-02T15:04:05Z"),
+			Status:         v.Status,
+			Visibility:     v.Visibility,
 		}
 	}
 
 	return &v1.AdminListVideosReply{
-		Videos: items,
-		Total:  total,
+		Videos:     items,
+		Total:      pageTotal(req.Cursor, total),
+		NextCursor: next,
 	}, nil
 }
 
@@ -85,6 +93,22 @@ func (s *AdminService) AdminDeleteVideo(ctx context.Context, req *v1.AdminDelete
 	return &v1.AdminDeleteVideoReply{}, nil
 }
 
+func (s *AdminService) AdminListDeletedVideos(ctx context.Context, req *v1.AdminListDeletedVideosRequest) (*v1.ListDeletedVideosReply, error) {
+	videos, total, err := s.trash.ListDeletedVideos(ctx, 0, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	return toListDeletedVideosReply(videos, total), nil
+}
+
+func (s *AdminService) AdminRestoreVideo(ctx context.Context, req *v1.AdminRestoreVideoRequest) (*v1.VideoReply, error) {
+	video, err := s.trash.RestoreVideo(ctx, 0, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toVideoReply(video), nil
+}
+
 func (s *AdminService) AdminCreateTag(ctx context.Context, req *v1.AdminCreateTagRequest) (*v1.AdminCreateTagReply, error) {
 	tag, err := s.uc.CreateTag(ctx, &biz.AdminTag{
 		Name: req.Name,
@@ -118,3 +142,197 @@ func (s *AdminService) AdminDeleteTag(ctx context.Context, req *v1.AdminDeleteTa
 	}
 	return &v1.AdminDeleteTagReply{}, nil
 }
+
+func (s *AdminService) AdminTopSearchQueries(ctx context.Context, req *v1.AdminTopSearchQueriesRequest) (*v1.AdminTopSearchQueriesReply, error) {
+	stats, err := s.uc.TopSearchQueries(ctx, req.Days, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AdminTopSearchQueriesReply{Queries: toAdminSearchQueryStats(stats)}, nil
+}
+
+func (s *AdminService) AdminZeroResultQueries(ctx context.Context, req *v1.AdminZeroResultQueriesRequest) (*v1.AdminZeroResultQueriesReply, error) {
+	stats, err := s.uc.TopZeroResultQueries(ctx, req.Days, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AdminZeroResultQueriesReply{Queries: toAdminSearchQueryStats(stats)}, nil
+}
+
+func (s *AdminService) AdminSearchCTR(ctx context.Context, req *v1.AdminSearchCTRRequest) (*v1.AdminSearchCTRReply, error) {
+	stats, err := s.uc.SearchQueryCTR(ctx, req.Days, req.Limit, req.GetQuery())
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AdminSearchCTRReply{Queries: toAdminSearchQueryStats(stats)}, nil
+}
+
+func toAdminSearchQueryStats(stats []*biz.AdminSearchQueryStat) []*v1.AdminSearchQueryStat {
+	items := make([]*v1.AdminSearchQueryStat, len(stats))
+	for i, st := range stats {
+		item := &v1.AdminSearchQueryStat{
+			Query:           st.Query,
+			Searches:        st.Searches,
+			ZeroResults:     st.ZeroResults,
+			ClickedSearches: st.ClickedSearches,
+		}
+		if st.Searches > 0 {
+			item.Ctr = float64(st.ClickedSearches) / float64(st.Searches)
+			item.AvgLatencyMs = float64(st.TotalLatencyMs) / float64(st.Searches)
+		}
+		items[i] = item
+	}
+	return items
+}
+
+func (s *AdminService) AdminListSynonymGroups(ctx context.Context, req *v1.AdminListSynonymGroupsRequest) (*v1.AdminListSynonymGroupsReply, error) {
+	groups, total, err := s.uc.ListSynonymGroups(ctx, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*v1.AdminSynonymGroupInfo, len(groups))
+	for i, g := range groups {
+		items[i] = &v1.AdminSynonymGroupInfo{Id: g.ID, Terms: g.Terms}
+	}
+	return &v1.AdminListSynonymGroupsReply{
+		Groups: items,
+		Total:  total,
+	}, nil
+}
+
+func (s *AdminService) AdminCreateSynonymGroup(ctx context.Context, req *v1.AdminCreateSynonymGroupRequest) (*v1.AdminCreateSynonymGroupReply, error) {
+	group, err := s.uc.CreateSynonymGroup(ctx, req.Terms)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AdminCreateSynonymGroupReply{
+		Group: &v1.AdminSynonymGroupInfo{Id: group.ID, Terms: group.Terms},
+	}, nil
+}
+
+func (s *AdminService) AdminUpdateSynonymGroup(ctx context.Context, req *v1.AdminUpdateSynonymGroupRequest) (*v1.AdminUpdateSynonymGroupReply, error) {
+	group, err := s.uc.UpdateSynonymGroup(ctx, req.Id, req.Terms)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AdminUpdateSynonymGroupReply{
+		Group: &v1.AdminSynonymGroupInfo{Id: group.ID, Terms: group.Terms},
+	}, nil
+}
+
+func (s *AdminService) AdminDeleteSynonymGroup(ctx context.Context, req *v1.AdminDeleteSynonymGroupRequest) (*v1.AdminDeleteSynonymGroupReply, error) {
+	if err := s.uc.DeleteSynonymGroup(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &v1.AdminDeleteSynonymGroupReply{}, nil
+}
+
+func (s *AdminService) AdminListSpellingCorrections(ctx context.Context, req *v1.AdminListSpellingCorrectionsRequest) (*v1.AdminListSpellingCorrectionsReply, error) {
+	corrections, total, err := s.uc.ListSpellingCorrections(ctx, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*v1.AdminSpellingCorrectionInfo, len(corrections))
+	for i, c := range corrections {
+		items[i] = toAdminSpellingCorrectionInfo(c)
+	}
+	return &v1.AdminListSpellingCorrectionsReply{
+		Corrections: items,
+		Total:       total,
+	}, nil
+}
+
+func (s *AdminService) AdminCreateSpellingCorrection(ctx context.Context, req *v1.AdminCreateSpellingCorrectionRequest) (*v1.AdminCreateSpellingCorrectionReply, error) {
+	c, err := s.uc.CreateSpellingCorrection(ctx, &biz.AdminSpellingCorrection{
+		Misspelling: req.Misspelling,
+		Correction:  req.Correction,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AdminCreateSpellingCorrectionReply{Correction: toAdminSpellingCorrectionInfo(c)}, nil
+}
+
+func (s *AdminService) AdminUpdateSpellingCorrection(ctx context.Context, req *v1.AdminUpdateSpellingCorrectionRequest) (*v1.AdminUpdateSpellingCorrectionReply, error) {
+	c, err := s.uc.UpdateSpellingCorrection(ctx, &biz.AdminSpellingCorrection{
+		ID:          req.Id,
+		Misspelling: req.Misspelling,
+		Correction:  req.Correction,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &v1.AdminUpdateSpellingCorrectionReply{Correction: toAdminSpellingCorrectionInfo(c)}, nil
+}
+
+func (s *AdminService) AdminDeleteSpellingCorrection(ctx context.Context, req *v1.AdminDeleteSpellingCorrectionRequest) (*v1.AdminDeleteSpellingCorrectionReply, error) {
+	if err := s.uc.DeleteSpellingCorrection(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &v1.AdminDeleteSpellingCorrectionReply{}, nil
+}
+
+func toAdminSpellingCorrectionInfo(c *biz.AdminSpellingCorrection) *v1.AdminSpellingCorrectionInfo {
+	return &v1.AdminSpellingCorrectionInfo{
+		Id:          c.ID,
+		Misspelling: c.Misspelling,
+		Correction:  c.Correction,
+	}
+}
+
+func (s *AdminService) AdminReconcileStorage(ctx context.Context, req *v1.AdminReconcileStorageRequest) (*v1.AdminReconcileStorageReply, error) {
+	// Listing the bucket outlives the server timeout.
+	report, err := s.storage.Reconcile(context.WithoutCancel(ctx), req.DryRun)
+	if err != nil {
+		return nil, errors.InternalServer("INTERNAL", "failed to reconcile storage")
+	}
+
+	orphans := make([]*v1.AdminStorageObject, len(report.Orphans))
+	for i, o := range report.Orphans {
+		orphans[i] = &v1.AdminStorageObject{
+			Name:         o.Name,
+			Size:         o.Size,
+			LastModified: o.LastModified.UTC().Format("2006-01-02T15:04:05Z"),
+		}
+	}
+	return &v1.AdminReconcileStorageReply{
+		DryRun:       report.DryRun,
+		Scanned:      report.Scanned,
+		ScannedBytes: report.ScannedBytes,
+		OrphanCount:  report.OrphanCount,
+		OrphanBytes:  report.OrphanBytes,
+		Deleted:      report.Deleted,
+		Recent:       report.Recent,
+		Orphans:      orphans,
+		StartedAt:    report.StartedAt.UTC().Format("2006-01-02T15:04:05Z"),
+		FinishedAt:   report.FinishedAt.UTC().Format("2006-01-02T15:04:05Z"),
+	}, nil
+}
+
+func (s *AdminService) AdminListDuplicateContent(ctx context.Context, req *v1.AdminListDuplicateContentRequest) (*v1.AdminListDuplicateContentReply, error) {
+	groups, total, err := s.uc.ListDuplicateContent(ctx, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*v1.AdminDuplicateGroup, len(groups))
+	for i, g := range groups {
+		uploads := make([]*v1.AdminDuplicateUpload, len(g.Uploads))
+		for j, u := range g.Uploads {
+			uploads[j] = &v1.AdminDuplicateUpload{
+				UploadId:    u.UploadID,
+				UserId:      u.UserID,
+				Username:    u.Username,
+				ObjectName:  u.ObjectName,
+				Status:      u.Status,
+				DuplicateOf: u.DuplicateOf,
+				VideoIds:    u.VideoIDs,
+				CreatedAt:   u.CreatedAt.UTC().Format("2006-01-02T15:04:05Z"),
+			}
+		}
+		items[i] = &v1.AdminDuplicateGroup{Sha256: g.SHA256, Size: g.Size, Uploads: uploads}
+	}
+	return &v1.AdminListDuplicateContentReply{Groups: items, Total: total}, nil
+}
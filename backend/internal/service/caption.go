@@ -0,0 +1,83 @@
+package service
+
+import (
+	"context"
+
+	v1 "backend/api/fenzvideo/v1"
+	"backend/internal/biz"
+	"backend/internal/pkg/authctx"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+type CaptionService struct {
+	v1.UnimplementedCaptionServiceServer
+	uc *biz.CaptionUsecase
+}
+
+func NewCaptionService(uc *biz.CaptionUsecase) *CaptionService {
+	return &CaptionService{uc: uc}
+}
+
+func (s *CaptionService) ListCaptions(ctx context.Context, req *v1.ListCaptionsRequest) (*v1.ListCaptionsReply, error) {
+	var viewerID *uint64
+	uid, ok := authctx.UserIDFromContext(ctx)
+	if ok {
+		viewerID = &uid
+	}
+	role, _ := authctx.RoleFromContext(ctx)
+
+	ctx = authctx.WithShareToken(ctx, req.ShareToken)
+	captions, err := s.uc.ListCaptions(ctx, req.VideoId, viewerID, role)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.ListCaptionsReply{Captions: toCaptionTracks(captions)}, nil
+}
+
+func (s *CaptionService) UploadCaption(ctx context.Context, req *v1.UploadCaptionRequest) (*v1.CaptionTrack, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	c, err := s.uc.UploadCaption(ctx, userID, &biz.Caption{
+		VideoID:  req.VideoId,
+		Language: req.Language,
+		Label:    req.Label,
+	}, req.Format, req.Content)
+	if err != nil {
+		return nil, err
+	}
+	return toCaptionTrack(c), nil
+}
+
+func (s *CaptionService) DeleteCaption(ctx context.Context, req *v1.DeleteCaptionRequest) (*v1.DeleteCaptionReply, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	if err := s.uc.DeleteCaption(ctx, userID, req.VideoId, req.Language); err != nil {
+		return nil, err
+	}
+	return &v1.DeleteCaptionReply{Success: true}, nil
+}
+
+func toCaptionTrack(c *biz.Caption) *v1.CaptionTrack {
+	return &v1.CaptionTrack{
+		Id:        c.ID,
+		Language:  c.Language,
+		Label:     c.Label,
+		Url:       c.URL,
+		UpdatedAt: c.UpdatedAt.Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+func toCaptionTracks(captions []*biz.Caption) []*v1.CaptionTrack {
+	tracks := make([]*v1.CaptionTrack, len(captions))
+	for i, c := range captions {
+		tracks[i] = toCaptionTrack(c)
+	}
+	return tracks
+}
@@ -6,6 +6,7 @@ import (
 
 	v1 "backend/api/fenzvideo/v1"
 	"backend/internal/biz"
+	"backend/internal/pkg/authctx"
 )
 
 type SearchService struct {
@@ -17,11 +18,16 @@ func NewSearchService(uc *biz.SearchUsecase) *SearchService {
 	return &SearchService{uc: uc}
 }
 
-func (s *SearchService) Search(ctx context.Context, req *v1.SearchRequest) (*v1.VideoListReply, error) {
+func (s *SearchService) Search(ctx context.Context, req *v1.SearchRequest) (*v1.SearchReply, error) {
 	params := &biz.SearchParams{
-		Query:    req.Query,
-		Page:     req.Page,
-		PageSize: req.PageSize,
+		Query:     req.Query,
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		Cursor:    req.Cursor,
+		SessionID: req.SessionId,
+	}
+	if uid, ok := authctx.UserIDFromContext(ctx); ok {
+		params.ViewerID = &uid
 	}
 
 	if req.CategoryId != nil {
@@ -50,14 +56,31 @@ func (s *SearchService) Search(ctx context.Context, req *v1.SearchRequest) (*v1.
 		params.AccessType = *req.AccessType
 	}
 
-	videos, total, err := s.uc.Search(ctx, params)
+	result, err := s.uc.Search(ctx, params)
 	if err != nil {
 		return nil, err
 	}
 
-	items := make([]*v1.VideoReply, len(videos))
-	for i, v := range videos {
+	items := make([]*v1.VideoReply, len(result.Videos))
+	for i, v := range result.Videos {
 		items[i] = toVideoReply(v)
 	}
-	return &v1.VideoListReply{Videos: items, Total: total}, nil
+	return &v1.SearchReply{
+		Videos:     items,
+		Total:      pageTotal(req.Cursor, result.Total),
+		SearchId:   result.SearchID,
+		DidYouMean: result.DidYouMean,
+		NextCursor: result.NextCursor,
+	}, nil
+}
+
+func (s *SearchService) RecordSearchClick(ctx context.Context, req *v1.RecordSearchClickRequest) (*v1.RecordSearchClickReply, error) {
+	if err := s.uc.RecordClick(ctx, &biz.SearchClick{
+		SearchID: req.SearchId,
+		VideoID:  req.VideoId,
+		Position: req.Position,
+	}); err != nil {
+		return nil, err
+	}
+	return &v1.RecordSearchClickReply{}, nil
 }
@@ -11,4 +11,6 @@ var ProviderSet = wire.NewSet(
 	NewSearchService,
 	NewChannelService,
 	NewAdminService,
+	NewUploadService,
+	NewCaptionService,
 )
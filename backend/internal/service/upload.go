@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"io"
+
+	v1 "backend/api/fenzvideo/v1"
+	"backend/internal/biz"
+	"backend/internal/pkg/authctx"
+
+	"github.com/go-kratos/kratos/v2/errors"
+)
+
+// UploadService serves presigned uploads and backs the resumable (tus)
+// upload endpoints, which are plain HTTP handlers rather than
+// proto-generated routes.
+type UploadService struct {
+	v1.UnimplementedUploadServiceServer
+	uc     *biz.UploadUsecase
+	quotas *biz.QuotaUsecase
+}
+
+func NewUploadService(uc *biz.UploadUsecase, quotas *biz.QuotaUsecase) *UploadService {
+	return &UploadService{uc: uc, quotas: quotas}
+}
+
+func (s *UploadService) CreatePresignedUpload(ctx context.Context, req *v1.CreatePresignedUploadRequest) (*v1.CreatePresignedUploadReply, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	p, err := s.uc.CreatePresigned(ctx, userID, req.Kind, req.ContentType, req.Size)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CreatePresignedUploadReply{
+		Url:       p.URL,
+		FormData:  p.FormData,
+		Path:      p.ObjectName,
+		ExpiresAt: p.ExpiresAt.UTC().Format("2006-01-02T15:04:05Z"),
+	}, nil
+}
+
+func (s *UploadService) CompleteUpload(ctx context.Context, req *v1.CompleteUploadRequest) (*v1.CompleteUploadReply, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	u, err := s.uc.CompleteUpload(ctx, userID, req.Path)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.CompleteUploadReply{
+		Url:         u.URL,
+		Path:        u.ObjectName,
+		Size:        u.Size,
+		ContentType: u.ContentType,
+	}, nil
+}
+
+func (s *UploadService) UploadVideo(stream v1.UploadService_UploadVideoServer) error {
+	ctx := stream.Context()
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	first, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+	header := first.GetHeader()
+	if header == nil {
+		return errors.BadRequest("UPLOAD_INVALID", "first message must be the header")
+	}
+
+	u, err := s.uc.UploadStream(ctx, userID, header.ContentType, header.Size, &chunkReader{stream: stream})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(&v1.CompleteUploadReply{
+		Url:         u.URL,
+		Path:        u.ObjectName,
+		Size:        u.Size,
+		ContentType: u.ContentType,
+	})
+}
+
+// chunkReader reads the chunk messages of an UploadVideo stream as bytes.
+type chunkReader struct {
+	stream v1.UploadService_UploadVideoServer
+	buf    []byte
+}
+
+func (r *chunkReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err // io.EOF once the client closes the stream
+		}
+		if msg.GetHeader() != nil {
+			return 0, errors.BadRequest("UPLOAD_INVALID", "header sent twice")
+		}
+		r.buf = msg.GetChunk()
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (s *UploadService) GetMyStorageUsage(ctx context.Context, req *v1.GetMyStorageUsageRequest) (*v1.StorageUsageReply, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	usage, err := s.quotas.Usage(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.StorageUsageReply{
+		UsedBytes:        usage.UsedBytes,
+		QuotaBytes:       usage.Quota.StorageBytes,
+		UploadsToday:     usage.Uploads,
+		DailyUploadLimit: usage.Quota.DailyUploads,
+	}, nil
+}
+
+// UploadFile stores a file posted to the multipart upload endpoints.
+func (s *UploadService) UploadFile(ctx context.Context, kind, contentType string, size int64, r io.Reader) (*biz.Upload, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+	return s.uc.UploadFile(ctx, userID, kind, contentType, size, r)
+}
+
+func (s *UploadService) CreateResumable(ctx context.Context, length int64, metadata map[string]string) (*biz.ResumableUpload, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+	return s.uc.CreateResumable(ctx, userID, length, metadata)
+}
+
+func (s *UploadService) GetResumable(ctx context.Context, id string) (*biz.ResumableUpload, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+	return s.uc.GetResumable(ctx, userID, id)
+}
+
+func (s *UploadService) AppendResumable(ctx context.Context, id string, offset int64, body io.Reader) (*biz.ResumableUpload, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+	return s.uc.AppendResumable(ctx, userID, id, offset, body)
+}
+
+func (s *UploadService) TerminateResumable(ctx context.Context, id string) error {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+	return s.uc.TerminateResumable(ctx, userID, id)
+}
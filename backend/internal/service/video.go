@@ -2,21 +2,28 @@ package service
 
 import (
 	"context"
+	"strconv"
+	"strings"
+	"time"
 
 	v1 "backend/api/fenzvideo/v1"
 	"backend/internal/biz"
 	"backend/internal/pkg/authctx"
+	"backend/internal/pkg/caption"
 
 	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport"
 )
 
 type VideoService struct {
 	v1.UnimplementedVideoServiceServer
-	uc *biz.VideoUsecase
+	uc      *biz.VideoUsecase
+	uploads *biz.UploadUsecase
+	trash   *biz.TrashUsecase
 }
 
-func NewVideoService(uc *biz.VideoUsecase) *VideoService {
-	return &VideoService{uc: uc}
+func NewVideoService(uc *biz.VideoUsecase, uploads *biz.UploadUsecase, trash *biz.TrashUsecase) *VideoService {
+	return &VideoService{uc: uc, uploads: uploads, trash: trash}
 }
 
 func (s *VideoService) CreateVideo(ctx context.Context, req *v1.CreateVideoRequest) (*v1.VideoReply, error) {
@@ -34,6 +41,28 @@ func (s *VideoService) CreateVideo(ctx context.Context, req *v1.CreateVideoReque
 		thumb = *req.ThumbnailUrl
 	}
 
+	videoURL := req.VideoUrl
+	if req.UploadId != "" {
+		url, err := s.uploads.ClaimResumable(ctx, userID, req.UploadId)
+		if err != nil {
+			return nil, err
+		}
+		videoURL = url
+	}
+	videoURL, err := s.uploads.ResolveSource(ctx, userID, videoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	var publishAt *time.Time
+	if req.PublishAt != nil {
+		t, err := parsePublishAt(*req.PublishAt)
+		if err != nil {
+			return nil, err
+		}
+		publishAt = &t
+	}
+
 	tags := make([]*biz.Tag, len(req.TagIds))
 	for i, id := range req.TagIds {
 		tags[i] = &biz.Tag{ID: id}
@@ -43,10 +72,12 @@ func (s *VideoService) CreateVideo(ctx context.Context, req *v1.CreateVideoReque
 		Title:        req.Title,
 		Description:  desc,
 		CategoryID:   req.CategoryId,
-		VideoURL:     req.VideoUrl,
+		VideoURL:     videoURL,
 		ThumbnailURL: thumb,
 		Duration:     req.Duration,
 		AccessTier:   int8(req.AccessTier),
+		Visibility:   req.Visibility,
+		PublishAt:    publishAt,
 		Tags:         tags,
 	})
 	if err != nil {
@@ -63,50 +94,121 @@ func (s *VideoService) GetVideo(ctx context.Context, req *v1.GetVideoRequest) (*
 	}
 	role, _ := authctx.RoleFromContext(ctx)
 
+	ctx = authctx.WithShareToken(ctx, req.ShareToken)
 	video, err := s.uc.GetVideo(ctx, req.Id, viewerID, role)
 	if err != nil {
 		return nil, err
 	}
+	setVideoETag(ctx, video)
 	return toVideoReply(video), nil
 }
 
+// StreamVideo authorizes the viewer in ctx to stream a video and returns it
+// with its stored (unsigned) video URL. Used by the HTTP stream proxy.
+func (s *VideoService) StreamVideo(ctx context.Context, videoID uint64) (*biz.Video, error) {
+	var viewerID *uint64
+	uid, ok := authctx.UserIDFromContext(ctx)
+	if ok {
+		viewerID = &uid
+	}
+	role, _ := authctx.RoleFromContext(ctx)
+
+	return s.uc.CheckAccess(ctx, videoID, viewerID, role)
+}
+
 func (s *VideoService) UpdateVideo(ctx context.Context, req *v1.UpdateVideoRequest) (*v1.VideoReply, error) {
 	userID, ok := authctx.UserIDFromContext(ctx)
 	if !ok {
 		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
 	}
 
-	v := &biz.Video{ID: req.Id}
-	if req.Title != nil {
-		v.Title = *req.Title
+	v := &biz.Video{
+		ID:           req.Id,
+		Title:        req.GetTitle(),
+		Description:  req.GetDescription(),
+		CategoryID:   req.GetCategoryId(),
+		ThumbnailURL: req.GetThumbnailUrl(),
+		AccessTier:   int8(req.GetAccessTier()),
+		Visibility:   req.GetVisibility(),
 	}
-	if req.Description != nil {
-		v.Description = *req.Description
-	}
-	if req.CategoryId != nil {
-		v.CategoryID = *req.CategoryId
+	if req.GetPublishAt() != "" {
+		t, err := parsePublishAt(req.GetPublishAt())
+		if err != nil {
+			return nil, err
+		}
+		v.PublishAt = &t
 	}
-	if req.ThumbnailUrl != nil {
-		v.ThumbnailURL = *req.ThumbnailUrl
+	v.Tags = make([]*biz.Tag, len(req.TagIds))
+	for i, id := range req.TagIds {
+		v.Tags[i] = &biz.Tag{ID: id}
 	}
-	if req.AccessTier != nil {
-		v.AccessTier = int8(*req.AccessTier)
+
+	fields := req.GetUpdateMask().GetPaths()
+	if len(fields) == 0 {
+		fields = presentVideoFields(req)
 	}
-	if len(req.TagIds) > 0 {
-		tags := make([]*biz.Tag, len(req.TagIds))
-		for i, id := range req.TagIds {
-			tags[i] = &biz.Tag{ID: id}
+	version := req.Version
+	if version == 0 {
+		var err error
+		if version, err = ifMatchVersion(ctx); err != nil {
+			return nil, err
 		}
-		v.Tags = tags
 	}
 
-	video, err := s.uc.UpdateVideo(ctx, userID, v)
+	video, err := s.uc.UpdateVideo(ctx, userID, &biz.VideoUpdate{Video: v, Fields: fields, Version: version})
 	if err != nil {
 		return nil, err
 	}
+	setVideoETag(ctx, video)
 	return toVideoReply(video), nil
 }
 
+// presentVideoFields is the update mask of a request without one: the
+// fields it sets, and tag_ids if non-empty.
+func presentVideoFields(req *v1.UpdateVideoRequest) []string {
+	var fields []string
+	add := func(set bool, field string) {
+		if set {
+			fields = append(fields, field)
+		}
+	}
+	add(req.Title != nil, biz.VideoFieldTitle)
+	add(req.Description != nil, biz.VideoFieldDescription)
+	add(req.CategoryId != nil, biz.VideoFieldCategoryID)
+	add(len(req.TagIds) > 0, biz.VideoFieldTagIDs)
+	add(req.AccessTier != nil, biz.VideoFieldAccessTier)
+	add(req.ThumbnailUrl != nil, biz.VideoFieldThumbnailURL)
+	add(req.Visibility != nil, biz.VideoFieldVisibility)
+	add(req.PublishAt != nil, biz.VideoFieldPublishAt)
+	return fields
+}
+
+// setVideoETag sends the video's version as the ETag, for If-Match on
+// UpdateVideo.
+func setVideoETag(ctx context.Context, v *biz.Video) {
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		tr.ReplyHeader().Set("ETag", strconv.Quote(strconv.FormatUint(v.Version, 10)))
+	}
+}
+
+// ifMatchVersion reads the version from an If-Match header, or 0 if there
+// is none (or it is "*").
+func ifMatchVersion(ctx context.Context) (uint64, error) {
+	tr, ok := transport.FromServerContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	etag := strings.TrimPrefix(strings.TrimSpace(tr.RequestHeader().Get("If-Match")), "W/")
+	if etag == "" || etag == "*" {
+		return 0, nil
+	}
+	version, err := strconv.ParseUint(strings.Trim(etag, `"`), 10, 64)
+	if err != nil || version == 0 {
+		return 0, errors.BadRequest("VIDEO_INVALID_UPDATE", "If-Match must be an ETag from this API")
+	}
+	return version, nil
+}
+
 func (s *VideoService) DeleteVideo(ctx context.Context, req *v1.DeleteVideoRequest) (*v1.DeleteVideoReply, error) {
 	userID, ok := authctx.UserIDFromContext(ctx)
 	if !ok {
@@ -132,6 +234,298 @@ func (s *VideoService) TogglePublish(ctx context.Context, req *v1.TogglePublishR
 	return toVideoReply(video), nil
 }
 
+func (s *VideoService) CreateVideoShare(ctx context.Context, req *v1.CreateVideoShareRequest) (*v1.VideoShare, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		t, err := time.Parse(time.RFC3339, *req.ExpiresAt)
+		if err != nil {
+			return nil, errors.BadRequest("VIDEO_SHARE_INVALID", "expires_at must be an RFC 3339 time")
+		}
+		expiresAt = &t
+	}
+	share, err := s.uc.CreateShare(ctx, userID, req.VideoId, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	return toVideoShare(share), nil
+}
+
+func (s *VideoService) ListVideoShares(ctx context.Context, req *v1.ListVideoSharesRequest) (*v1.ListVideoSharesReply, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	shares, err := s.uc.ListShares(ctx, userID, req.VideoId)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v1.VideoShare, len(shares))
+	for i, share := range shares {
+		items[i] = toVideoShare(share)
+	}
+	return &v1.ListVideoSharesReply{Shares: items}, nil
+}
+
+func (s *VideoService) RevokeVideoShare(ctx context.Context, req *v1.RevokeVideoShareRequest) (*v1.RevokeVideoShareReply, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	if err := s.uc.RevokeShare(ctx, userID, req.VideoId, req.Id); err != nil {
+		return nil, err
+	}
+	return &v1.RevokeVideoShareReply{}, nil
+}
+
+func toVideoShare(share *biz.VideoShare) *v1.VideoShare {
+	return &v1.VideoShare{
+		Id:        share.ID,
+		VideoId:   share.VideoID,
+		Token:     share.Token,
+		ExpiresAt: formatOptionalTime(share.ExpiresAt),
+		CreatedAt: share.CreatedAt.UTC().Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+func (s *VideoService) ReplaceVideoSource(ctx context.Context, req *v1.ReplaceVideoSourceRequest) (*v1.VideoReply, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	videoURL := req.VideoUrl
+	if req.UploadId != "" {
+		url, err := s.uploads.ClaimResumable(ctx, userID, req.UploadId)
+		if err != nil {
+			return nil, err
+		}
+		videoURL = url
+	}
+	videoURL, err := s.uploads.ResolveSource(ctx, userID, videoURL)
+	if err != nil {
+		return nil, err
+	}
+
+	video, err := s.uc.ReplaceSource(ctx, userID, req.Id, videoURL)
+	if err != nil {
+		return nil, err
+	}
+	return toVideoReply(video), nil
+}
+
+func (s *VideoService) ListDeletedVideos(ctx context.Context, req *v1.ListDeletedVideosRequest) (*v1.ListDeletedVideosReply, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	videos, total, err := s.trash.ListDeletedVideos(ctx, userID, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	return toListDeletedVideosReply(videos, total), nil
+}
+
+func (s *VideoService) RestoreVideo(ctx context.Context, req *v1.RestoreVideoRequest) (*v1.VideoReply, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	video, err := s.trash.RestoreVideo(ctx, userID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return toVideoReply(video), nil
+}
+
+func toListDeletedVideosReply(videos []*biz.DeletedVideo, total int64) *v1.ListDeletedVideosReply {
+	items := make([]*v1.DeletedVideo, len(videos))
+	for i, v := range videos {
+		items[i] = &v1.DeletedVideo{
+			Video:     toVideoReply(v.Video),
+			DeletedAt: v.DeletedAt.UTC().Format("2006-01-02T15:04:05Z"),
+			PurgeAt:   v.PurgeAt.UTC().Format("2006-01-02T15:04:05Z"),
+		}
+	}
+	return &v1.ListDeletedVideosReply{Videos: items, Total: total}
+}
+
+func (s *VideoService) ListScheduledVideos(ctx context.Context, req *v1.ListScheduledVideosRequest) (*v1.VideoListReply, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	videos, total, err := s.uc.ListScheduledVideos(ctx, userID, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v1.VideoReply, len(videos))
+	for i, v := range videos {
+		items[i] = toVideoReply(v)
+	}
+	return &v1.VideoListReply{Videos: items, Total: &total}, nil
+}
+
+func (s *VideoService) ListVideoRevisions(ctx context.Context, req *v1.ListVideoRevisionsRequest) (*v1.ListVideoRevisionsReply, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+	role, _ := authctx.RoleFromContext(ctx)
+
+	revisions, total, err := s.uc.ListRevisions(ctx, userID, role, req.VideoId, req.Page, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v1.VideoRevision, len(revisions))
+	for i, rev := range revisions {
+		items[i] = toVideoRevision(rev)
+	}
+	return &v1.ListVideoRevisionsReply{Revisions: items, Total: total}, nil
+}
+
+func (s *VideoService) RestoreVideoRevision(ctx context.Context, req *v1.RestoreVideoRevisionRequest) (*v1.VideoReply, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+	role, _ := authctx.RoleFromContext(ctx)
+	version := req.Version
+	if version == 0 {
+		var err error
+		if version, err = ifMatchVersion(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	video, err := s.uc.RestoreRevision(ctx, userID, role, req.VideoId, req.Id, version)
+	if err != nil {
+		return nil, err
+	}
+	setVideoETag(ctx, video)
+	return toVideoReply(video), nil
+}
+
+func toVideoRevision(rev *biz.VideoRevision) *v1.VideoRevision {
+	return &v1.VideoRevision{
+		Id:           rev.ID,
+		VideoId:      rev.VideoID,
+		Version:      rev.Version,
+		ActorId:      rev.ActorID,
+		ActorName:    rev.ActorName,
+		Action:       rev.Action,
+		Fields:       rev.Fields,
+		RestoredFrom: rev.RestoredFrom,
+		Title:        rev.Title,
+		Description:  rev.Description,
+		CategoryId:   rev.CategoryID,
+		TagIds:       rev.TagIDs,
+		AccessTier:   int32(rev.AccessTier),
+		ThumbnailUrl: rev.ThumbnailURL,
+		Visibility:   rev.Visibility,
+		CreatedAt:    rev.CreatedAt.UTC().Format("2006-01-02T15:04:05Z"),
+	}
+}
+
+func parsePublishAt(s string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, errors.BadRequest("VIDEO_INVALID_SCHEDULE", "publish_at must be an RFC 3339 time")
+	}
+	return t, nil
+}
+
+func (s *VideoService) ListThumbnailCandidates(ctx context.Context, req *v1.ListThumbnailCandidatesRequest) (*v1.ListThumbnailCandidatesReply, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	candidates, err := s.uc.ListThumbnailCandidates(ctx, userID, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v1.ThumbnailCandidate, len(candidates))
+	for i, c := range candidates {
+		items[i] = &v1.ThumbnailCandidate{Id: c.ID, Url: c.URL, Position: c.Position}
+	}
+	return &v1.ListThumbnailCandidatesReply{Candidates: items}, nil
+}
+
+func (s *VideoService) SetThumbnail(ctx context.Context, req *v1.SetThumbnailRequest) (*v1.VideoReply, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	video, err := s.uc.SetThumbnail(ctx, userID, req.Id, req.GetCandidateId(), req.GetThumbnailUrl())
+	if err != nil {
+		return nil, err
+	}
+	return toVideoReply(video), nil
+}
+
+func (s *VideoService) SetChapters(ctx context.Context, req *v1.SetChaptersRequest) (*v1.VideoReply, error) {
+	userID, ok := authctx.UserIDFromContext(ctx)
+	if !ok {
+		return nil, errors.Unauthorized("UNAUTHORIZED", "login required")
+	}
+
+	chapters := make([]*biz.Chapter, len(req.Chapters))
+	for i, c := range req.Chapters {
+		chapters[i] = &biz.Chapter{Start: c.Start, Title: c.Title}
+	}
+	video, err := s.uc.SetChapters(ctx, userID, req.Id, chapters)
+	if err != nil {
+		return nil, err
+	}
+	return toVideoReply(video), nil
+}
+
+// ChaptersPath is the route of a video's WebVTT chapters track.
+const ChaptersPath = "/api/v1/videos/{video_id}/chapters.vtt"
+
+// ChaptersVTT authorizes the viewer in ctx to watch a video and returns its
+// chapters as a WebVTT track. Used by the HTTP chapters route.
+func (s *VideoService) ChaptersVTT(ctx context.Context, videoID uint64) ([]byte, error) {
+	var viewerID *uint64
+	uid, ok := authctx.UserIDFromContext(ctx)
+	if ok {
+		viewerID = &uid
+	}
+	role, _ := authctx.RoleFromContext(ctx)
+
+	video, err := s.uc.GetChapters(ctx, videoID, viewerID, role)
+	if err != nil {
+		return nil, err
+	}
+	cues := make([]caption.Cue, len(video.Chapters))
+	for i, c := range video.Chapters {
+		end := video.Duration
+		if i+1 < len(video.Chapters) {
+			end = video.Chapters[i+1].Start
+		}
+		if end <= c.Start { // duration unknown
+			end = c.Start + 1
+		}
+		cues[i] = caption.Cue{
+			Start: time.Duration(c.Start) * time.Second,
+			End:   time.Duration(end) * time.Second,
+			Text:  caption.Escape(c.Title),
+		}
+	}
+	return caption.WebVTT(cues), nil
+}
+
 func (s *VideoService) GetRecommended(ctx context.Context, req *v1.GetRecommendedRequest) (*v1.VideoListReply, error) {
 	var userID *uint64
 	uid, ok := authctx.UserIDFromContext(ctx)
@@ -139,7 +533,7 @@ func (s *VideoService) GetRecommended(ctx context.Context, req *v1.GetRecommende
 		userID = &uid
 	}
 
-	videos, total, err := s.uc.GetRecommended(ctx, userID, req.SessionId, req.Page, req.PageSize)
+	videos, total, next, err := s.uc.GetRecommended(ctx, userID, req.SessionId, req.Page, req.PageSize, req.Cursor)
 	if err != nil {
 		return nil, err
 	}
@@ -148,7 +542,7 @@ func (s *VideoService) GetRecommended(ctx context.Context, req *v1.GetRecommende
 	for i, v := range videos {
 		items[i] = toVideoReply(v)
 	}
-	return &v1.VideoListReply{Videos: items, Total: total}, nil
+	return &v1.VideoListReply{Videos: items, Total: pageTotal(req.Cursor, total), NextCursor: next}, nil
 }
 
 func toVideoReply(v *biz.Video) *v1.VideoReply {
@@ -160,20 +554,74 @@ func toVideoReply(v *biz.Video) *v1.VideoReply {
 		tags[i] = &v1.TagItem{Id: t.ID, Name: t.Name, Slug: t.Slug}
 	}
 	return &v1.VideoReply{
-		Id:           v.ID,
-		UserId:       v.UserID,
-		Username:     v.Username,
-		CategoryId:   v.CategoryID,
-		CategoryName: v.CategoryName,
-		Title:        v.Title,
-		Description:  v.Description,
-		VideoUrl:     v.VideoURL,
-		ThumbnailUrl: v.ThumbnailURL,
-		Duration:     v.Duration,
-		Views:        v.ViewsMember + v.ViewsNonMember,
-		AccessTier:   int32(v.AccessTier),
-		IsPublished:  v.IsPublished,
-		Tags:         tags,
-		CreatedAt:    v.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		Id:                 v.ID,
+		UserId:             v.UserID,
+		Username:           v.Username,
+		CategoryId:         v.CategoryID,
+		CategoryName:       v.CategoryName,
+		Title:              v.Title,
+		Description:        v.Description,
+		VideoUrl:           v.VideoURL,
+		ThumbnailUrl:       v.ThumbnailURL,
+		Duration:           v.Duration,
+		Views:              v.ViewsMember + v.ViewsNonMember,
+		AccessTier:         int32(v.AccessTier),
+		IsPublished:        v.Visibility == biz.VisibilityPublished,
+		Tags:               tags,
+		CreatedAt:          v.CreatedAt.Format("2006-01-02T15:04:05Z"),
+		Width:              v.Width,
+		Height:             v.Height,
+		VideoCodec:         v.VideoCodec,
+		AudioCodec:         v.AudioCodec,
+		Bitrate:            v.Bitrate,
+		FileSize:           v.FileSize,
+		TranscodeStatus:    v.TranscodeStatus,
+		Status:             v.Status,
+		Visibility:         v.Visibility,
+		ProcessingProgress: v.ProcessingProgress,
+		ProcessingError:    v.ProcessingError,
+		SeekPreviewUrl:     v.SeekPreviewURL,
+		Captions:           toCaptionTracks(v.Captions),
+		Chapters:           toChapters(v.Chapters),
+		ChaptersUrl:        chaptersURL(v),
+		PublishAt:          formatOptionalTime(v.PublishAt),
+		Version:            v.Version,
+		Replacing:          v.PendingVideoURL != "",
+		ThumbnailVariants: &v1.ThumbnailVariants{
+			Small:  v.ThumbnailVariants.Small,
+			Medium: v.ThumbnailVariants.Medium,
+			Large:  v.ThumbnailVariants.Large,
+		},
+	}
+}
+
+func toChapters(chapters []*biz.Chapter) []*v1.Chapter {
+	items := make([]*v1.Chapter, len(chapters))
+	for i, c := range chapters {
+		items[i] = &v1.Chapter{Start: c.Start, Title: c.Title}
+	}
+	return items
+}
+
+func chaptersURL(v *biz.Video) string {
+	if len(v.Chapters) == 0 {
+		return ""
+	}
+	return strings.Replace(ChaptersPath, "{video_id}", strconv.FormatUint(v.ID, 10), 1)
+}
+
+// pageTotal reports the total only for offset-paged requests;
+// cursor-paged requests skip the COUNT and leave it unset.
+func pageTotal(cursor string, total int64) *int64 {
+	if cursor != "" {
+		return nil
+	}
+	return &total
+}
+
+func formatOptionalTime(t *time.Time) string {
+	if t == nil {
+		return ""
 	}
+	return t.UTC().Format("2006-01-02T15:04:05Z")
 }